@@ -95,6 +95,9 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 		cloudBck = bck.RemoteBck()
 	)
 	svc, region, errC = newClient(sessConf{bck: cloudBck}, "")
+	if svc == nil {
+		return nil, http.StatusBadRequest, errC
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Infoln("[head_bucket]", cloudBck.Name, errC)
 	}
@@ -124,8 +127,11 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 	}
 	versioned, errV := getBucketVersioning(svc, cloudBck)
 	if errV != nil {
-		errCode, err = awsErrorToAISError(errV, cloudBck, "")
-		return
+		if !compatNotImpl(bck.Props, errV) {
+			errCode, err = awsErrorToAISError(errV, cloudBck, "")
+			return
+		}
+		versioned = false
 	}
 	bckProps[apc.HdrBucketVerEnabled] = strconv.FormatBool(versioned)
 	return
@@ -146,6 +152,9 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 		versioning bool
 	)
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[list_objects]")
+	if svc == nil {
+		return http.StatusBadRequest, err
+	}
 	if err != nil && cmn.Rom.FastV(4, cos.SmoduleBackend) {
 		nlog.Warningln(err)
 	}
@@ -283,6 +292,9 @@ func (*awsProvider) HeadObj(_ ctx, lom *core.LOM) (oa *cmn.ObjAttrs, errCode int
 		cloudBck   = lom.Bck().RemoteBck()
 	)
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[head_object]")
+	if svc == nil {
+		return nil, http.StatusBadRequest, err
+	}
 	if err != nil && cmn.Rom.FastV(4, cos.SmoduleBackend) {
 		nlog.Warningln(err)
 	}
@@ -364,6 +376,10 @@ func (*awsProvider) GetObjReader(ctx context.Context, lom *core.LOM) (res core.G
 		cloudBck = lom.Bck().RemoteBck()
 	)
 	svc, _, err := newClient(sessConf{bck: cloudBck}, "[get_object]")
+	if svc == nil {
+		res.ErrCode, res.Err = http.StatusBadRequest, err
+		return
+	}
 	if err != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(err)
 	}
@@ -429,6 +445,10 @@ func (*awsProvider) PutObj(r io.ReadCloser, lom *core.LOM) (errCode int, err err
 	)
 
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[put_object]")
+	if svc == nil {
+		cos.Close(r)
+		return http.StatusBadRequest, err
+	}
 	if err != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(err)
 	}
@@ -477,6 +497,9 @@ func (*awsProvider) DeleteObj(lom *core.LOM) (errCode int, err error) {
 		cloudBck = lom.Bck().RemoteBck()
 	)
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[delete_object]")
+	if svc == nil {
+		return http.StatusBadRequest, err
+	}
 	if err != nil && cmn.Rom.FastV(4, cos.SmoduleBackend) {
 		nlog.Warningln(err)
 	}
@@ -501,6 +524,7 @@ func (*awsProvider) DeleteObj(lom *core.LOM) (errCode int, err error) {
 // newClient creates new S3 client on a per-region basis or, more precisely,
 // per (region, endpoint) pair - and note that s3 endpoint is per-bucket configurable.
 // If the client already exists newClient simply returns it.
+// Returns nil client (and error) if it fails to configure access to S3-compatible storage.
 //
 // From S3 SDK:
 // "S3 methods are safe to use concurrently. It is not safe to modify mutate
@@ -509,21 +533,32 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 	var (
 		endpoint = s3Endpoint
 		profile  = awsProfile
+		compat   *cmn.ExtraPropsAWS
 	)
 	region = conf.region
 
 	if conf.bck != nil && conf.bck.Props != nil {
+		extra := &conf.bck.Props.Extra.AWS
 		if region == "" {
-			region = conf.bck.Props.Extra.AWS.CloudRegion
+			region = extra.CloudRegion
+		}
+		if extra.Endpoint != "" {
+			endpoint = extra.Endpoint
 		}
-		if conf.bck.Props.Extra.AWS.Endpoint != "" {
-			endpoint = conf.bck.Props.Extra.AWS.Endpoint
+		if extra.Profile != "" {
+			profile = extra.Profile
 		}
-		if conf.bck.Props.Extra.AWS.Profile != "" {
-			profile = conf.bck.Props.Extra.AWS.Profile
+		if extra.S3Compat {
+			compat = extra
+			if region == "" {
+				region = endpoints.UsEast1RegionID // (no region discovery)
+			}
 		}
 	}
 	cid := _cid(profile, region, endpoint)
+	if compat != nil {
+		cid += _compatCid(compat)
+	}
 
 	// reuse
 	cmu.RLock()
@@ -534,7 +569,10 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 	}
 
 	// create
-	sess, config := _session(endpoint, profile)
+	sess, config, err := _session(endpoint, profile, compat)
+	if err != nil {
+		return nil, region, err
+	}
 	if region == "" {
 		if tag != "" {
 			err = fmt.Errorf("%s: unknown region for bucket %s -- proceeding with default", tag, conf.bck)
@@ -546,6 +584,9 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 	config.Region = aws.String(region)
 	svc = s3.New(sess, config)
 	debug.Assertf(region == *svc.Config.Region, "%s != %s", region, *svc.Config.Region)
+	if compat != nil {
+		compatSigner(svc, compat)
+	}
 
 	cmu.Lock()
 	clients[cid] = svc
@@ -569,8 +610,9 @@ func _cid(profile, region, endpoint string) string {
 	return sb.String()
 }
 
-// Create session using default creds from ~/.aws/credentials and environment variables.
-func _session(endpoint, profile string) (*session.Session, *aws.Config) {
+// Create session using default creds from ~/.aws/credentials and environment variables
+// (or, for S3-compatible storage, static credentials - see s3compat.go)
+func _session(endpoint, profile string, compat *cmn.ExtraPropsAWS) (*session.Session, *aws.Config, error) {
 	config := aws.Config{
 		HTTPClient:          cmn.NewClient(cmn.TransportArgs{}),
 		LowerCaseHeaderMaps: apc.Bool(true),
@@ -581,10 +623,15 @@ func _session(endpoint, profile string) (*session.Session, *aws.Config) {
 
 	opts := session.Options{
 		SharedConfigState: session.SharedConfigEnable,
-		Config:            config,
 		Profile:           profile,
 	}
-	return session.Must(session.NewSessionWithOptions(opts)), &config
+	if compat != nil {
+		if err := compatOpts(&config, &opts, compat); err != nil {
+			return nil, nil, err
+		}
+	}
+	opts.Config = config
+	return session.Must(session.NewSessionWithOptions(opts)), &config, nil
 }
 
 func getBucketVersioning(svc *s3.S3, bck *cmn.Bck) (enabled bool, errV error) {
@@ -0,0 +1,448 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// file:// backend: local or shared (e.g., NFS) filesystem under the configured root
+// (see cmn.BackendConfFile), whereby:
+// - bucket file://abc is the directory <root>/abc, and object "a/b/c" is the file <root>/abc/a/b/c;
+// - object version is the file's modification time (in nanoseconds);
+// - PUT writes through via a temporary file that gets atomically renamed.
+
+const fileTmpSuffix = ".ais.tmp"
+
+type fileProvider struct {
+	t core.TargetPut
+}
+
+// interface guard
+var _ core.BackendProvider = (*fileProvider)(nil)
+
+func NewFile(t core.TargetPut) (core.BackendProvider, error) { return &fileProvider{t: t}, nil }
+
+func (*fileProvider) Provider() string  { return apc.File }
+func (*fileProvider) MaxPageSize() uint { return 10000 }
+
+// (the root may change at runtime along with the cluster config)
+func fileRoot() (string, error) {
+	conf := cmn.GCO.Get().Backend.Get(apc.File)
+	if conf == nil {
+		return "", &cmn.ErrMissingBackend{Provider: apc.File}
+	}
+	return conf.(cmn.BackendConfFile).Root, nil
+}
+
+func fileBckDir(bck *cmn.Bck) (string, error) {
+	root, err := fileRoot()
+	if err != nil {
+		return "", err
+	}
+	if strings.ContainsRune(bck.Name, filepath.Separator) || bck.Name == "." || bck.Name == ".." {
+		return "", cmn.NewErrUnsupp("map to directory", bck.Cname(""))
+	}
+	return filepath.Join(root, bck.Name), nil
+}
+
+// object's path must stay inside the bucket's directory -
+// both lexically and after resolving symlinks (if any)
+func fileObjPath(bck *cmn.Bck, objName string) (string, error) {
+	dir, err := fileBckDir(bck)
+	if err != nil {
+		return "", err
+	}
+	fqn := filepath.Join(dir, objName)
+	if !strings.HasPrefix(fqn, dir+string(filepath.Separator)) {
+		return "", cmn.NewErrUnsupp("map to file", bck.Cname(objName))
+	}
+	rdir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fqn, nil // (nothing to resolve)
+		}
+		return "", err
+	}
+	// resolve the longest existing part of the path
+	for path := fqn; path != dir; path = filepath.Dir(path) {
+		rpath, err := filepath.EvalSymlinks(path)
+		if err == nil {
+			if !strings.HasPrefix(rpath, rdir+string(filepath.Separator)) {
+				return "", cmn.NewErrUnsupp("map to file", bck.Cname(objName))
+			}
+			break
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return fqn, nil
+}
+
+func fileVersion(finfo os.FileInfo) string { return strconv.FormatInt(finfo.ModTime().UnixNano(), 10) }
+
+func fileErrorToAISError(err error, bck *cmn.Bck, objName string) (int, error) {
+	var errMissing *cmn.ErrMissingBackend
+	switch {
+	case errors.As(err, &errMissing):
+		return http.StatusNotFound, err
+	case os.IsNotExist(err):
+		if objName == "" {
+			return http.StatusNotFound, cmn.NewErrRemoteBckNotFound(bck)
+		}
+		return http.StatusNotFound, cos.NewErrNotFound(nil, bck.Cname(objName))
+	case os.IsPermission(err):
+		return http.StatusForbidden, err
+	default:
+		return http.StatusInternalServerError, err
+	}
+}
+
+//
+// CREATE BUCKET
+//
+
+func (fp *fileProvider) CreateBucket(*meta.Bck) (int, error) {
+	return http.StatusNotImplemented, cmn.NewErrNotImpl("create", fp.Provider()+" bucket")
+}
+
+//
+// HEAD BUCKET
+//
+
+func (*fileProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCode int, err error) {
+	var (
+		dir      string
+		finfo    os.FileInfo
+		cloudBck = bck.RemoteBck()
+	)
+	if dir, err = fileBckDir(cloudBck); err == nil {
+		finfo, err = os.Stat(dir)
+	}
+	if err != nil {
+		errCode, err = fileErrorToAISError(err, cloudBck, "")
+		return
+	}
+	if !finfo.IsDir() {
+		return nil, http.StatusNotFound, cmn.NewErrRemoteBckNotFound(cloudBck)
+	}
+	bckProps = make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.File
+	bckProps[apc.HdrBucketVerEnabled] = "true" // (modification time)
+	return
+}
+
+//
+// LIST OBJECTS
+//
+
+// lists in lexicographical order (that differs from the (per-directory) order of filepath.Walk),
+// whereby the continuation token is the last listed object name
+func (fp *fileProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	cloudBck := bck.RemoteBck()
+	dir, err := fileBckDir(cloudBck)
+	if err != nil {
+		return fileErrorToAISError(err, cloudBck, "")
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, fp.MaxPageSize())
+	w := &fileWalk{
+		msg:   msg,
+		lst:   lst,
+		after: max(msg.ContinuationToken, msg.StartAfter),
+	}
+	if err := w.do(dir, ""); err != nil && err != errPageFull {
+		return fileErrorToAISError(err, cloudBck, "")
+	}
+	lst.Entries = lst.Entries[:w.idx]
+	lst.ContinuationToken = ""
+	if w.idx > 0 && uint(w.idx) >= msg.PageSize {
+		lst.ContinuationToken = lst.Entries[w.idx-1].Name
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", cloudBck.Cname(""), w.idx)
+	}
+	return 0, nil
+}
+
+var errPageFull = errors.New("page full")
+
+type fileWalk struct {
+	msg   *apc.LsoMsg
+	lst   *cmn.LsoResult
+	after string // list names greater than
+	idx   int
+}
+
+func (w *fileWalk) do(dir, prefix string) error {
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	// sort directory "a" as "a/"
+	names := make([]string, 0, len(des))
+	isDir := make(map[string]bool, len(des))
+	for _, de := range des {
+		name := prefix + de.Name()
+		if de.IsDir() {
+			name += "/"
+			isDir[name] = true
+		} else if !de.Type().IsRegular() || strings.HasSuffix(name, fileTmpSuffix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if isDir[name] {
+			// skip the entire subtree if all its names are <= w.after
+			if name < w.after && !strings.HasPrefix(w.after, name) {
+				continue
+			}
+			if w.msg.Prefix != "" && !cmn.DirHasOrIsPrefix(name, w.msg.Prefix) {
+				continue
+			}
+			if err := w.do(filepath.Join(dir, filepath.Base(name)), name); err != nil {
+				return err
+			}
+			continue
+		}
+		if name <= w.after || (w.msg.Prefix != "" && !cmn.ObjHasPrefix(name, w.msg.Prefix)) {
+			continue
+		}
+		if err := w.add(dir, name); err != nil {
+			return err
+		}
+		if uint(w.idx) >= w.msg.PageSize {
+			return errPageFull
+		}
+	}
+	return nil
+}
+
+func (w *fileWalk) add(dir, name string) error {
+	finfo, err := os.Stat(filepath.Join(dir, filepath.Base(name)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // removed in the meantime
+		}
+		return err
+	}
+	var entry *cmn.LsoEntry
+	if w.idx < len(w.lst.Entries) {
+		entry = w.lst.Entries[w.idx]
+		*entry = cmn.LsoEntry{}
+	} else {
+		entry = &cmn.LsoEntry{}
+		w.lst.Entries = append(w.lst.Entries, entry)
+	}
+	w.idx++
+	entry.Name = name
+	entry.Size = finfo.Size()
+	if w.msg.IsFlagSet(apc.LsNameOnly) || w.msg.IsFlagSet(apc.LsNameSize) {
+		return nil
+	}
+	if w.msg.WantProp(apc.GetPropsVersion) {
+		entry.Version = fileVersion(finfo)
+	}
+	if w.msg.WantProp(apc.GetPropsCustom) {
+		entry.Custom = cmn.CustomMD2S(cos.StrKVs{cmn.LastModified: fmtTime(finfo.ModTime())})
+	}
+	return nil
+}
+
+//
+// LIST BUCKETS
+//
+
+func (*fileProvider) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error) {
+	root, err := fileRoot()
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	des, err := os.ReadDir(root)
+	if err != nil {
+		errCode, err = fileErrorToAISError(err, &cmn.Bck{Provider: apc.File}, "")
+		return
+	}
+	for _, de := range des {
+		if de.IsDir() {
+			bcks = append(bcks, cmn.Bck{Name: de.Name(), Provider: apc.File})
+		}
+	}
+	return
+}
+
+//
+// HEAD OBJECT
+//
+
+func (*fileProvider) HeadObj(_ ctx, lom *core.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
+	var (
+		fqn      string
+		finfo    os.FileInfo
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	if fqn, err = fileObjPath(cloudBck, lom.ObjName); err == nil {
+		finfo, err = os.Stat(fqn)
+	}
+	if err == nil && !finfo.Mode().IsRegular() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		errCode, err = fileErrorToAISError(err, cloudBck, lom.ObjName)
+		return
+	}
+	oa = &cmn.ObjAttrs{Size: finfo.Size(), Ver: fileVersion(finfo), Atime: finfo.ModTime().UnixNano()}
+	oa.SetCustomKey(cmn.SourceObjMD, apc.File)
+	oa.SetCustomKey(cmn.VersionObjMD, oa.Ver)
+	oa.SetCustomKey(cmn.LastModified, fmtTime(finfo.ModTime()))
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[head_object]", cloudBck.Cname(lom.ObjName))
+	}
+	return
+}
+
+//
+// GET OBJECT
+//
+
+func (fp *fileProvider) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT) (int, error) {
+	res := fp.GetObjReader(ctx, lom)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := fp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (*fileProvider) GetObjReader(_ context.Context, lom *core.LOM) (res core.GetReaderResult) {
+	var (
+		fqn      string
+		fh       *os.File
+		finfo    os.FileInfo
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	fqn, res.Err = fileObjPath(cloudBck, lom.ObjName)
+	if res.Err == nil {
+		fh, res.Err = os.Open(fqn)
+	}
+	if res.Err == nil {
+		if finfo, res.Err = fh.Stat(); res.Err == nil && !finfo.Mode().IsRegular() {
+			res.Err = os.ErrNotExist
+		}
+		if res.Err != nil {
+			cos.Close(fh)
+		}
+	}
+	if res.Err != nil {
+		res.ErrCode, res.Err = fileErrorToAISError(res.Err, cloudBck, lom.ObjName)
+		return
+	}
+	setFileCustom(lom, finfo)
+	res.R = fh
+	res.Size = finfo.Size()
+	return
+}
+
+func setFileCustom(lom *core.LOM, finfo os.FileInfo) {
+	v := fileVersion(finfo)
+	lom.SetCustomKey(cmn.SourceObjMD, apc.File)
+	lom.SetCustomKey(cmn.VersionObjMD, v)
+	lom.SetCustomKey(cmn.LastModified, fmtTime(finfo.ModTime()))
+	lom.SetVersion(v)
+}
+
+//
+// PUT OBJECT
+//
+
+func (*fileProvider) PutObj(r io.ReadCloser, lom *core.LOM) (int, error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		fqn, err = fileObjPath(cloudBck, lom.ObjName)
+	)
+	if err != nil {
+		cos.Close(r)
+		return fileErrorToAISError(err, cloudBck, lom.ObjName)
+	}
+	finfo, err := _filePut(r, fqn)
+	cos.Close(r)
+	if err != nil {
+		return fileErrorToAISError(err, cloudBck, "" /*(not to confuse missing bucket with missing object)*/)
+	}
+	setFileCustom(lom, finfo)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object]", lom.String())
+	}
+	return 0, nil
+}
+
+func _filePut(r io.Reader, fqn string) (os.FileInfo, error) {
+	tmp := fqn + "." + strconv.FormatInt(time.Now().UnixNano(), 36) + fileTmpSuffix
+	fh, err := cos.CreateFile(tmp) // (creates parent directories as needed)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(fh, r); err == nil {
+		err = fh.Sync()
+	}
+	if erc := fh.Close(); err == nil {
+		err = erc
+	}
+	if err == nil {
+		err = os.Rename(tmp, fqn)
+	}
+	if err != nil {
+		if errRm := os.Remove(tmp); errRm != nil && !os.IsNotExist(errRm) {
+			nlog.Errorln("failed to remove", tmp, "err:", errRm)
+		}
+		return nil, err
+	}
+	return os.Stat(fqn)
+}
+
+//
+// DELETE OBJECT
+//
+
+func (*fileProvider) DeleteObj(lom *core.LOM) (int, error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		fqn, err = fileObjPath(cloudBck, lom.ObjName)
+	)
+	if err == nil {
+		err = os.Remove(fqn)
+	}
+	if err != nil {
+		return fileErrorToAISError(err, cloudBck, lom.ObjName)
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[delete_object]", lom.String())
+	}
+	return 0, nil
+}
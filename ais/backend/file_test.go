@@ -0,0 +1,120 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func initFileRoot(t *testing.T) (root string) {
+	root = t.TempDir()
+	oldConfig := cmn.GCO.Get()
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Conf = map[string]any{apc.File: cmn.BackendConfFile{Root: root}}
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		cmn.GCO.BeginUpdate()
+		cmn.GCO.CommitUpdate(oldConfig)
+	})
+	return root
+}
+
+func TestFileBackendBucket(t *testing.T) {
+	var (
+		root = initFileRoot(t)
+		fp   = &fileProvider{}
+		bck  = meta.NewBck("abc", apc.File, cmn.NsGlobal)
+	)
+	_, errCode, err := fp.HeadBucket(context.Background(), bck)
+	tassert.Fatalf(t, err != nil && errCode == http.StatusNotFound, "expecting not found, got %d, %v", errCode, err)
+
+	tassert.CheckFatal(t, os.Mkdir(filepath.Join(root, "abc"), 0o755))
+	_, err = _filePut(strings.NewReader("x"), filepath.Join(root, "not-a-bucket"))
+	tassert.CheckFatal(t, err)
+
+	props, _, err := fp.HeadBucket(context.Background(), bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, props[apc.HdrBackendProvider] == apc.File, "unexpected props %v", props)
+
+	bcks, _, err := fp.ListBuckets(cmn.QueryBcks{Provider: apc.File})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(bcks) == 1 && bcks[0].Name == "abc", "unexpected buckets %v", bcks)
+
+	// object names must not escape the bucket's directory
+	_, err = fileObjPath(bck.Bucket(), "../not-a-bucket")
+	tassert.Errorf(t, err != nil, "expecting error (object outside bucket)")
+	_, err = fileBckDir(&cmn.Bck{Name: "..", Provider: apc.File})
+	tassert.Errorf(t, err != nil, "expecting error (bucket outside root)")
+
+	// nor via symlinks
+	tassert.CheckFatal(t, os.Symlink(filepath.Join(root, "not-a-bucket"), filepath.Join(root, "abc", "file-link")))
+	tassert.CheckFatal(t, os.Symlink(root, filepath.Join(root, "abc", "dir-link")))
+	for _, objName := range []string{"file-link", "dir-link/not-a-bucket", "dir-link/new-obj"} {
+		_, err = fileObjPath(bck.Bucket(), objName)
+		tassert.Errorf(t, err != nil, "expecting error (%q outside bucket)", objName)
+	}
+	_, err = fileObjPath(bck.Bucket(), "new-dir/new-obj")
+	tassert.CheckError(t, err)
+}
+
+func TestFileBackendListObjects(t *testing.T) {
+	var (
+		root  = initFileRoot(t)
+		fp    = &fileProvider{}
+		bck   = meta.NewBck("abc", apc.File, cmn.NsGlobal)
+		names = []string{"a.txt", "a/b", "a/c/d", "a0", "b/x", "b/y", "c"} // (lexicographical order)
+	)
+	for _, name := range names {
+		_, err := _filePut(strings.NewReader(name), filepath.Join(root, "abc", name))
+		tassert.CheckFatal(t, err)
+	}
+
+	// all
+	lst := &cmn.LsoResult{}
+	_, err := fp.ListObjects(bck, &apc.LsoMsg{}, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == len(names), "expecting %d entries, got %d", len(names), len(lst.Entries))
+	for i, entry := range lst.Entries {
+		tassert.Errorf(t, entry.Name == names[i], "entry %d: expecting %q, got %q", i, names[i], entry.Name)
+		tassert.Errorf(t, entry.Size == int64(len(names[i])), "entry %q: unexpected size %d", entry.Name, entry.Size)
+	}
+	tassert.Errorf(t, lst.ContinuationToken == "", "unexpected continuation token %q", lst.ContinuationToken)
+
+	// pages
+	var (
+		listed []string
+		msg    = &apc.LsoMsg{PageSize: 3}
+	)
+	for {
+		lst := &cmn.LsoResult{}
+		_, err := fp.ListObjects(bck, msg, lst)
+		tassert.CheckFatal(t, err)
+		for _, entry := range lst.Entries {
+			listed = append(listed, entry.Name)
+		}
+		if lst.ContinuationToken == "" {
+			break
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+	tassert.Fatalf(t, strings.Join(listed, ",") == strings.Join(names, ","), "paged listing: %v", listed)
+
+	// prefix
+	lst = &cmn.LsoResult{}
+	_, err = fp.ListObjects(bck, &apc.LsoMsg{Prefix: "a/"}, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == 2 && lst.Entries[0].Name == "a/b" && lst.Entries[1].Name == "a/c/d",
+		"prefix listing: %v", lst.Entries)
+}
@@ -0,0 +1,216 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // (required by the signature version 2)
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/s3"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// S3-compatible (non-AWS) storage, e.g. MinIO or Ceph RGW (see cmn.ExtraPropsAWS.S3Compat):
+// - no region discovery: the region is either configured or defaults to us-east-1;
+// - optional path-style addressing and custom CA;
+// - optional static credentials, referenced by ID and stored locally on each target, e.g.:
+//   {"minio1": {"access_key": "...", "secret_key": "..."}, "rgw": {...}}
+//   (the file must not be accessible by group or others);
+// - request signing: AWS Signature Version 4 (default) or the legacy Version 2.
+
+// local credentials file (see above)
+const awsEnvCredsFile = "AIS_S3_CREDS_FILE"
+
+const (
+	signV2Name    = "ais.SignV2"
+	hdrDate       = "Date"
+	hdrContentMD5 = "Content-MD5"
+	hdrSecToken   = "X-Amz-Security-Token"
+)
+
+type s3Creds struct {
+	AccessKey    string `json:"access_key"`
+	SecretKey    string `json:"secret_key"`
+	SessionToken string `json:"session_token,omitempty"`
+}
+
+// signature V2 sub-resources that are included in the string to sign (sorted)
+var v2SubResources = []string{
+	"acl", "cors", "delete", "lifecycle", "location", "logging", "notification", "partNumber", "policy",
+	"requestPayment", "response-cache-control", "response-content-disposition", "response-content-encoding",
+	"response-content-language", "response-content-type", "response-expires", "restore", "tagging",
+	"torrent", "uploadId", "uploads", "versionId", "versioning", "versions", "website",
+}
+
+// in addition to (profile, region, endpoint) - see _cid
+func _compatCid(extra *cmn.ExtraPropsAWS) string {
+	return "#" + strconv.FormatBool(extra.PathStyle) + "#" + extra.CACert + "#" + extra.CredsID + "#" + extra.SigVersion
+}
+
+func compatOpts(config *aws.Config, opts *session.Options, extra *cmn.ExtraPropsAWS) error {
+	if extra.PathStyle {
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+	if extra.CACert != "" {
+		// NOTE: takes precedence over AWS_CA_BUNDLE and shared config
+		b, err := os.ReadFile(extra.CACert)
+		if err != nil {
+			return fmt.Errorf("s3compat: CA %q: %v", extra.CACert, err)
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM(b) {
+			return fmt.Errorf("s3compat: CA %q: failed to parse PEM", extra.CACert)
+		}
+		opts.CustomCABundle = bytes.NewReader(b)
+	}
+	if extra.CredsID != "" {
+		creds, err := loadCreds(extra.CredsID)
+		if err != nil {
+			return err
+		}
+		config.Credentials = credentials.NewStaticCredentials(creds.AccessKey, creds.SecretKey, creds.SessionToken)
+	}
+	return nil
+}
+
+func compatSigner(svc *s3.S3, extra *cmn.ExtraPropsAWS) {
+	if extra.SigVersion == cmn.S3SigV2 {
+		svc.Handlers.Sign.Swap(v4.SignRequestHandler.Name, request.NamedHandler{Name: signV2Name, Fn: signV2})
+	}
+}
+
+func loadCreds(id string) (*s3Creds, error) {
+	fqn := os.Getenv(awsEnvCredsFile)
+	if fqn == "" {
+		return nil, fmt.Errorf("s3compat: credentials %q: %s is not set", id, awsEnvCredsFile)
+	}
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return nil, err
+	}
+	if perm := finfo.Mode().Perm(); perm&0o077 != 0 {
+		return nil, fmt.Errorf("s3compat: credentials file %q must not be accessible by others (permissions %#o)", fqn, perm)
+	}
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]*s3Creds
+	if err := jsoniter.Unmarshal(b, &all); err != nil {
+		return nil, fmt.Errorf("s3compat: credentials file %q: %v", fqn, err)
+	}
+	creds, ok := all[id]
+	if !ok || creds.AccessKey == "" || creds.SecretKey == "" {
+		return nil, cos.NewErrNotFound(nil, "s3compat credentials "+id)
+	}
+	return creds, nil
+}
+
+//
+// AWS Signature Version 2 (https://docs.aws.amazon.com/AmazonS3/latest/userguide/RESTAuthentication.html)
+//
+
+func signV2(r *request.Request) {
+	if r.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+	creds, err := r.Config.Credentials.GetWithContext(r.Context())
+	if err != nil {
+		r.Error = err
+		return
+	}
+	hreq := r.HTTPRequest
+	hreq.Header.Set(hdrDate, time.Now().UTC().Format(http.TimeFormat))
+	if creds.SessionToken != "" {
+		hreq.Header.Set(hdrSecToken, creds.SessionToken)
+	}
+	mac := hmac.New(sha1.New, []byte(creds.SecretAccessKey))
+	mac.Write([]byte(stringToSignV2(hreq)))
+	hreq.Header.Set(apc.HdrAuthorization, "AWS "+creds.AccessKeyID+":"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+}
+
+// NOTE: path-style addressing only (see cmn.ExtraPropsAWS validation)
+func stringToSignV2(hreq *http.Request) string {
+	var (
+		sb  strings.Builder
+		amz = make([]string, 0, 4)
+	)
+	sb.WriteString(hreq.Method)
+	sb.WriteByte('\n')
+	sb.WriteString(hreq.Header.Get(hdrContentMD5))
+	sb.WriteByte('\n')
+	sb.WriteString(hreq.Header.Get(cos.HdrContentType))
+	sb.WriteByte('\n')
+	sb.WriteString(hreq.Header.Get(hdrDate))
+	sb.WriteByte('\n')
+
+	// canonicalized amz headers
+	for k, vals := range hreq.Header {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, "x-amz-") {
+			continue
+		}
+		for i := range vals {
+			vals[i] = strings.TrimSpace(vals[i])
+		}
+		amz = append(amz, k+":"+strings.Join(vals, ","))
+	}
+	sort.Strings(amz)
+	for _, h := range amz {
+		sb.WriteString(h)
+		sb.WriteByte('\n')
+	}
+
+	// canonicalized resource
+	path := hreq.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	sb.WriteString(path)
+	query, sepa := hreq.URL.Query(), byte('?')
+	for _, sub := range v2SubResources {
+		vals, ok := query[sub]
+		if !ok {
+			continue
+		}
+		sb.WriteByte(sepa)
+		sb.WriteString(sub)
+		if len(vals) > 0 && vals[0] != "" {
+			sb.WriteByte('=')
+			sb.WriteString(vals[0])
+		}
+		sepa = '&'
+	}
+	return sb.String()
+}
+
+// S3-compatible storage may not implement certain (optional) APIs, e.g. bucket versioning
+func compatNotImpl(bprops *cmn.Bprops, err error) bool {
+	if bprops == nil || !bprops.Extra.AWS.S3Compat {
+		return false
+	}
+	reqErr, ok := err.(awserr.RequestFailure)
+	return ok && (reqErr.StatusCode() == http.StatusNotImplemented || reqErr.Code() == "NotImplemented")
+}
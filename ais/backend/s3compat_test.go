@@ -0,0 +1,143 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // (signature version 2)
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	testAccessKey = "AKIDTEST"
+	testSecretKey = "secret"
+)
+
+// mock S3-compatible server: path-style HEAD object
+func newMockS3(t *testing.T, tls bool, sigV2 bool) *httptest.Server {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bck/obj" {
+			t.Errorf("expecting path-style request, got %q (host %q)", r.URL.Path, r.Host)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		auth := r.Header.Get(apc.HdrAuthorization)
+		if sigV2 {
+			mac := hmac.New(sha1.New, []byte(testSecretKey))
+			mac.Write([]byte(stringToSignV2(r)))
+			expected := "AWS " + testAccessKey + ":" + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+			if auth != expected {
+				t.Errorf("signature v2 mismatch: %q vs expected %q", auth, expected)
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+		} else if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential="+testAccessKey+"/") ||
+			!strings.Contains(auth, "/us-east-1/s3/") {
+			t.Errorf("unexpected signature v4: %q", auth)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Length", "3")
+		w.Header().Set("ETag", `"b1946ac92492d2347c6235b4d2611184"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.WriteHeader(http.StatusOK)
+	})
+	if tls {
+		return httptest.NewTLSServer(handler)
+	}
+	return httptest.NewServer(handler)
+}
+
+func writeCreds(t *testing.T, perm os.FileMode) {
+	fqn := filepath.Join(t.TempDir(), "creds.json")
+	b := []byte(`{"minio": {"access_key": "` + testAccessKey + `", "secret_key": "` + testSecretKey + `"}}`)
+	tassert.CheckFatal(t, os.WriteFile(fqn, b, perm))
+	t.Setenv(awsEnvCredsFile, fqn)
+}
+
+func compatBck(extra *cmn.ExtraPropsAWS) *cmn.Bck {
+	return &cmn.Bck{Name: "bck", Provider: apc.AWS, Props: &cmn.Bprops{Extra: cmn.ExtraProps{AWS: *extra}}}
+}
+
+func headObj(t *testing.T, bck *cmn.Bck) {
+	svc, region, err := newClient(sessConf{bck: bck}, "")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, region == "us-east-1", "expecting default region, got %q", region)
+	out, err := svc.HeadObject(&s3.HeadObjectInput{Bucket: aws.String(bck.Name), Key: aws.String("obj")})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, aws.Int64Value(out.ContentLength) == 3, "unexpected size %d", aws.Int64Value(out.ContentLength))
+}
+
+func TestS3CompatSigV2(t *testing.T) {
+	_, _ = NewAWS(nil)
+	writeCreds(t, 0o600)
+	srv := newMockS3(t, false, true)
+	defer srv.Close()
+
+	headObj(t, compatBck(&cmn.ExtraPropsAWS{
+		S3Compat:   true,
+		Endpoint:   srv.URL,
+		PathStyle:  true,
+		CredsID:    "minio",
+		SigVersion: cmn.S3SigV2,
+	}))
+}
+
+func TestS3CompatCustomCA(t *testing.T) {
+	_, _ = NewAWS(nil)
+	writeCreds(t, 0o600)
+	srv := newMockS3(t, true, false)
+	defer srv.Close()
+
+	extra := &cmn.ExtraPropsAWS{S3Compat: true, Endpoint: srv.URL, PathStyle: true, CredsID: "minio"}
+
+	// untrusted
+	svc, _, err := newClient(sessConf{bck: compatBck(extra)}, "")
+	tassert.CheckFatal(t, err)
+	_, err = svc.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bck"), Key: aws.String("obj")})
+	tassert.Fatalf(t, err != nil, "expecting certificate verification error")
+
+	// trusted
+	extra.CACert = filepath.Join(t.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	tassert.CheckFatal(t, os.WriteFile(extra.CACert, pemBytes, 0o600))
+	headObj(t, compatBck(extra))
+}
+
+func TestS3CompatCreds(t *testing.T) {
+	_, _ = NewAWS(nil)
+	extra := &cmn.ExtraPropsAWS{S3Compat: true, Endpoint: "http://localhost:9000", PathStyle: true, CredsID: "minio"}
+
+	// not configured
+	t.Setenv(awsEnvCredsFile, "")
+	svc, _, err := newClient(sessConf{bck: compatBck(extra)}, "")
+	tassert.Fatalf(t, svc == nil && err != nil, "expecting error (credentials file not configured)")
+
+	// accessible by others
+	writeCreds(t, 0o644)
+	svc, _, err = newClient(sessConf{bck: compatBck(extra)}, "")
+	tassert.Fatalf(t, svc == nil && err != nil, "expecting error (insecure credentials file)")
+
+	// not found
+	writeCreds(t, 0o600)
+	extra.CredsID = "rgw"
+	svc, _, err = newClient(sessConf{bck: compatBck(extra)}, "")
+	tassert.Fatalf(t, svc == nil && err != nil, "expecting error (credentials not found)")
+}
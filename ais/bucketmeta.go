@@ -89,6 +89,7 @@ type (
 		wait         bool
 		needReMirror bool
 		needReEC     bool
+		needReencode bool // (when changing EC layout of an erasure coded bucket)
 		terminate    bool
 		singleTarget bool
 	}
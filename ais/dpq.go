@@ -32,6 +32,7 @@ type dpq struct {
 	etlName             string // QparamETLName
 	silent              string // QparamSilent
 	latestVer           string // QparamLatestVer
+	version             string // QparamObjVersion (s3.QparamVersionID)
 }
 
 var (
@@ -122,6 +123,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.silent = value
 		case apc.QparamLatestVer:
 			dpq.latestVer = value
+		case apc.QparamObjVersion, s3.QparamVersionID:
+			dpq.version = value
 
 		case s3.QparamMptUploadID, s3.QparamMptUploads, s3.QparamMptPartNo:
 			// TODO: ignore for now
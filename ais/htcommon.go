@@ -46,6 +46,7 @@ const whatRenamedLB = "renamedlb"
 const (
 	fmtErrInsuffMpaths1 = "%s: not enough mountpaths (%d) to configure %s as %d-way mirror"
 	fmtErrInsuffMpaths2 = "%s: not enough mountpaths (%d) to replicate %s (configured) %d times"
+	fmtErrInsuffTargets = "%s: not enough targets (%d) to configure %s as %d-way mirror"
 	fmtErrInvaldAction  = "invalid action %q (expected one of %v)"
 	fmtUnknownQue       = "unexpected query [what=%s]"
 	fmtNested           = "%s: nested (%v): failed to %s %q: %v"
@@ -873,25 +874,28 @@ func _reMirror(bprops, nprops *cmn.Bprops) bool {
 		return true
 	}
 	if bprops.Mirror.Enabled && nprops.Mirror.Enabled {
-		return bprops.Mirror.Copies != nprops.Mirror.Copies
+		return bprops.Mirror.Copies != nprops.Mirror.Copies ||
+			bprops.Mirror.OnTargets() != nprops.Mirror.OnTargets() || bprops.Mirror.Domain != nprops.Mirror.Domain
 	}
 	return false
 }
 
+// returns true when enabling EC or changing EC layout(s) - the latter requires ec-reencode
 func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
 	if !nprops.EC.Enabled {
 		if bprops.EC.Enabled {
-			// abort running ec-encode xaction, if exists
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: bck}
-			xreg.DoAbort(flt, errors.New("ec-disabled"))
+			// abort running ec-encode and ec-reencode xactions, if exist
+			err := errors.New("ec-disabled")
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: bck}, err)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: bck}, err)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECScrub, Bck: bck}, err)
 		}
 		return
 	}
 	if smap != nil {
 		targetCnt = smap.CountActiveTs()
 	}
-	if !bprops.EC.Enabled ||
-		(bprops.EC.DataSlices != nprops.EC.DataSlices || bprops.EC.ParitySlices != nprops.EC.ParitySlices) {
+	if !bprops.EC.Enabled || !bprops.EC.SameLayout(&nprops.EC) {
 		yes = true
 	}
 	return
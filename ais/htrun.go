@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -362,6 +363,34 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		copy(h.si.PubExtra, pubExtra)
 		nlog.Infof("%s (multihome) access: %v and %v", cmn.NetPublic, pubAddr, h.si.PubExtra)
 	}
+	h.si.Labels = nodeLabels(config)
+	nlog.Infoln("node labels:", h.si.Labels)
+}
+
+// node labels (failure domains) that become part of the Smap when the node joins:
+// - built-in meta.LabelHost: K8s node name or, otherwise, hostname;
+// - local config (cmn.LocalConfig.Labels) - may override the former;
+// - environment (env.AIS.NodeLabels) - may override all of the above
+func nodeLabels(config *cmn.Config) cos.StrKVs {
+	labels := make(cos.StrKVs, len(config.Labels)+1)
+	if host := os.Getenv(env.AIS.K8sNode); host != "" {
+		labels[meta.LabelHost] = host
+	} else if host, err := os.Hostname(); err == nil && host != "" {
+		labels[meta.LabelHost] = host
+	}
+	for k, v := range config.Labels {
+		labels[k] = v
+	}
+	if s := os.Getenv(env.AIS.NodeLabels); s != "" {
+		kvs, err := cmn.ParseLabels(s)
+		if err != nil {
+			cos.ExitLogf("invalid %s=%q: %v", env.AIS.NodeLabels, s, err)
+		}
+		for k, v := range kvs {
+			labels[k] = v
+		}
+	}
+	return labels
 }
 
 func mustDiffer(ip1 meta.NetInfo, port1 int, use1 bool, ip2 meta.NetInfo, port2 int, use2 bool, tag string) {
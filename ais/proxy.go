@@ -348,6 +348,9 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 		return
 	}
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
+	if bckArgs.perms == apc.AceObjDELETE && cos.IsParseBool(apireq.query.Get(apc.QparamBypassGovernance)) {
+		bckArgs.perms |= apc.AcePATCH // (deleting object locked in governance mode)
+	}
 	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
 
@@ -536,7 +539,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
-	// switch (I) through (IV) --------------------------
+	// switch (I) through (V) ---------------------------
 
 	// (I) summarize buckets
 	if msg.Action == apc.ActSummaryBck {
@@ -558,13 +561,23 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
-	// (II) invalid action
+	// (II) get-batch
+	if msg.Action == apc.ActGetBatch {
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "bad %s request: %q is not a bucket", msg.Action, qbck)
+			return
+		}
+		p.getBatch(w, r, meta.CloneBck((*cmn.Bck)(qbck)), msg, dpq)
+		return
+	}
+
+	// (III) invalid action
 	if msg.Action != apc.ActList {
 		p.writeErrAct(w, r, msg.Action)
 		return
 	}
 
-	// (III) list buckets
+	// (IV) list buckets
 	if msg.Value == nil {
 		if qbck.Name != "" && qbck.Name != msg.Name {
 			p.writeErrf(w, r, "bad list-buckets request: %q vs %q (%+v, %+v)", qbck.Name, msg.Name, qbck, msg)
@@ -580,7 +593,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
-	// (IV) list objects (NOTE -- TODO: currently, always forwarding)
+	// (V) list objects (NOTE -- TODO: currently, always forwarding)
 	if !qbck.IsBucket() {
 		p.writeErrf(w, r, "bad list-objects request: %q is not a bucket (is a bucket query?)", qbck)
 		return
@@ -619,6 +632,70 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	}
 }
 
+// GET-batch: validate, init all buckets, and redirect to a (random) designated target
+// (see xact/xs/getbatch.go)
+func (p *proxy) getBatch(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg, dpq *dpq) {
+	var gbmsg apc.GetBatchMsg
+	if err := cos.MorphMarshal(msg.Value, &gbmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if len(gbmsg.Entries) == 0 {
+		p.writeErrf(w, r, "bad %s request: no entries", msg.Action)
+		return
+	}
+	if gbmsg.Mime != "" {
+		if _, err := archive.Mime(gbmsg.Mime, ""); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceGET, bck: bck, dpq: dpq}
+	bckArgs.createAIS = false
+	if _, err := bckArgs.initAndTry(); err != nil {
+		return
+	}
+	inited := map[string]struct{}{bck.MakeUname(""): {}}
+	for i := range gbmsg.Entries {
+		e := &gbmsg.Entries[i]
+		if e.ObjName == "" || (e.ArchPath != "" && e.SampleKey != "") {
+			p.writeErrf(w, r, "bad %s request: invalid entry #%d %+v", msg.Action, i, e)
+			return
+		}
+		if e.Bucket == "" {
+			continue
+		}
+		provider, err := cmn.NormalizeProvider(e.Provider)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		b := meta.NewBck(e.Bucket, provider, cmn.NsGlobal)
+		if _, ok := inited[b.MakeUname("")]; ok {
+			continue
+		}
+		bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceGET, bck: b, dpq: dpq}
+		bckArgs.createAIS = false
+		if _, err := bckArgs.initAndTry(); err != nil {
+			return
+		}
+		inited[b.MakeUname("")] = struct{}{}
+	}
+
+	smap := p.owner.smap.get()
+	tsi, err := smap.GetRandTarget()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln(msg.Action, bck.Cname(""), len(gbmsg.Entries), "=>", tsi.StringEx())
+	}
+	// NOTE: 307 to preserve the request body
+	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
 // GET /v1/objects/bucket-name/object-name
 func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ...string) {
 	// 1. request
@@ -1724,6 +1801,20 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		p.objMv(w, r, bck, apireq.items[1], msg)
 		return
+	case apc.ActSetObjLock:
+		perms := apc.AceObjUpdate
+		if olmsg := (&apc.ObjLockMsg{}); cos.MorphMarshal(msg.Value, olmsg) == nil && olmsg.BypassGovernance {
+			perms |= apc.AcePATCH // (bypassing governance requires permission to update bucket props)
+		}
+		if err := p.checkAccess(w, r, bck, perms); err != nil {
+			return
+		}
+		if !bck.Props.ObjLock.Enabled {
+			p.writeErrActf(w, r, msg.Action, "object lock is not enabled (bucket %s)", bck)
+			return
+		}
+		p.redirectObjAct(w, r, bck, apireq.items[1], msg)
+		return
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
@@ -2289,6 +2380,21 @@ func (p *proxy) objMv(w http.ResponseWriter, r *http.Request, bck *meta.Bck, obj
 	p.statsT.Inc(stats.RenameCount)
 }
 
+// redirect object action (that does not require any special handling) to the owning target
+func (p *proxy) redirectObjAct(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, msg *apc.ActMsg) {
+	smap := p.owner.smap.get()
+	si, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infof("%q %s => %s", msg.Action, bck.Cname(objName), si.StringEx())
+	}
+	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
 func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Values) (xid string, err error) {
 	var (
 		smap   = p.owner.smap.get()
@@ -268,7 +268,7 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 		if bck != nil {
 			bucket = bck.Bucket()
 		}
-		if err := tk.CheckPermissions(uid, bucket, ace); err != nil {
+		if err := p.checkPermissions(tk, uid, bck, bucket, ace); err != nil {
 			return err
 		}
 	}
@@ -290,3 +290,23 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 	}
 	return bck.Allow(ace)
 }
+
+// token permissions plus bucket policy, if any:
+// the policy can grant additional (bucket-level) permissions to a given user
+// and can deny them as well - explicit Deny always wins (except superuser)
+func (*proxy) checkPermissions(tk *tok.Token, uid string, bck *meta.Bck, bucket *cmn.Bck, ace apc.AccessAttrs) error {
+	err := tk.CheckPermissions(uid, bucket, ace)
+	if bck == nil || tk.IsAdmin || len(bck.Props.Policy.Statements) == 0 {
+		return err
+	}
+	allow, deny := bck.Props.Policy.Perms(tk.UserID)
+	objPerms := ace &^ apc.AccessCluster
+	if deny&objPerms != 0 {
+		return fmt.Errorf("%v: [%s, bucket %s, denied(%s) by bucket policy]",
+			tok.ErrNoPermissions, tk, bck, (deny & objPerms).Describe(false /*include all*/))
+	}
+	if err != nil && objPerms == ace && allow.Has(objPerms) {
+		err = nil
+	}
+	return err
+}
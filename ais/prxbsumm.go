@@ -127,6 +127,14 @@ func (p *proxy) bsummCollect(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (_ cmn.
 	summaries.Finalize(dsize, cmn.Rom.TestingEnv())
 	freeBcastRes(results)
 
+	// bucket quotas
+	bmd := p.owner.bmd.get()
+	for _, summ := range summaries {
+		if bprops, present := bmd.Get(meta.CloneBck(&summ.Bck)); present {
+			summ.SetQuota(&bprops.Quota)
+		}
+	}
+
 	switch {
 	case numPartial == 0 && numAccepted == 0:
 		status = http.StatusOK
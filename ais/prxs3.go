@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -45,6 +46,18 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.Method == http.MethodOptions {
+		s3Preflight(w, r, p.owner.bmd, apiItems)
+		return
+	}
+	s3CORS(w, r, p.owner.bmd, apiItems)
+
+	// lifecycle is a bucket subresource - not to be confused with object GET, PUT, or DELETE
+	if len(apiItems) > 1 && r.URL.RawQuery != "" && r.URL.Query().Has(s3.QparamLifecycle) {
+		s3.WriteErr(w, r, errS3Req, 0)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodHead:
 		if len(apiItems) == 0 {
@@ -64,16 +77,30 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		var (
-			q            = r.URL.Query()
-			_, lifecycle = q[s3.QparamLifecycle]
-			_, policy    = q[s3.QparamPolicy]
-			_, cors      = q[s3.QparamCORS]
-			_, acl       = q[s3.QparamACL]
+			q      = r.URL.Query()
+			_, acl = q[s3.QparamACL]
 		)
-		if lifecycle || policy || cors || acl {
+		if acl && len(apiItems) > 1 {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
+		switch {
+		case acl:
+			p.getBckACLS3(w, r, apiItems[0])
+			return
+		case q.Has(s3.QparamPolicy):
+			p.getBckPolicyS3(w, r, apiItems[0])
+			return
+		case q.Has(s3.QparamLifecycle):
+			p.getBckLifecycleS3(w, r, apiItems[0])
+			return
+		case q.Has(s3.QparamCORS):
+			p.getBckCORSS3(w, r, apiItems[0])
+			return
+		case q.Has(s3.QparamObjectLock):
+			p.getBckObjLockS3(w, r, apiItems[0])
+			return
+		}
 		listMultipart := q.Has(s3.QparamMptUploads)
 		if len(apiItems) == 1 && !listMultipart {
 			_, versioning := q[s3.QparamVersioning]
@@ -81,6 +108,10 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamVersions) {
+				p.listObjVersionsS3(w, r, apiItems[0], q)
+				return
+			}
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
 		}
@@ -98,6 +129,23 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			switch {
+			case q.Has(s3.QparamLifecycle):
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamACL):
+				p.putBckACLS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamPolicy):
+				p.putBckPolicyS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamCORS):
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamObjectLock):
+				p.putBckObjLockS3(w, r, apiItems[0])
+				return
+			}
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
@@ -129,13 +177,24 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			switch {
+			case q.Has(s3.QparamLifecycle):
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamPolicy):
+				p.delBckPolicyS3(w, r, apiItems[0])
+				return
+			case q.Has(s3.QparamCORS):
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			}
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
 		p.delObjS3(w, r, apiItems)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
-			http.MethodPost, http.MethodPut)
+			http.MethodPost, http.MethodPut, http.MethodOptions)
 	}
 }
 
@@ -168,6 +227,11 @@ func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	// x-amz-bucket-object-lock-enabled
+	if cos.IsParseBool(r.Header.Get(cos.S3HdrBckObjLockEnabled)) {
+		bck.Props = defaultBckProps(bckPropsArgs{bck: bck})
+		bck.Props.ObjLock.Enabled = true
+	}
 	if err := p.createBucket(&msg, bck, nil); err != nil {
 		s3.WriteErr(w, r, err, crerrStatus(err))
 	}
@@ -180,8 +244,8 @@ func (p *proxy) delBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AceDestroyBucket); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.access(r.Header, bck, apc.AceDestroyBucket); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	msg := apc.ActMsg{Action: apc.ActDestroyBck}
@@ -205,8 +269,8 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	smap := p.owner.smap.get()
@@ -229,8 +293,8 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	decoder := xml.NewDecoder(r.Body)
@@ -292,8 +356,8 @@ func (p *proxy) headBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AceBckHEAD); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	// From https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadBucket.html:
@@ -317,6 +381,10 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
+	if err := p.access(r.Header, bck, apc.AceObjLIST); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	amsg := &apc.ActMsg{Action: apc.ActList}
 
 	// currently, always forwarding
@@ -423,8 +491,8 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bckSrc.Allow(apc.AceGET); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.access(r.Header, bckSrc, apc.AceGET); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	// dst
@@ -437,8 +505,8 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		si   *meta.Snode
 		smap = p.owner.smap.get()
 	)
-	if err = bckDst.Allow(apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err = p.access(r.Header, bckDst, apc.AcePUT); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	objName := strings.Trim(parts[1], "/")
@@ -469,8 +537,12 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		si     *meta.Snode
 		smap   = p.owner.smap.get()
 	)
-	if err = bck.Allow(apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	perms := apc.AcePUT
+	if cos.IsParseBool(r.Header.Get(cos.S3HdrBypassGovernance)) {
+		perms |= apc.AcePATCH
+	}
+	if err = p.access(r.Header, bck, perms); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	if len(items) < 2 {
@@ -504,8 +576,8 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 		netPub string
 		smap   = p.owner.smap.get()
 	)
-	if err = bck.Allow(apc.AceGET); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err = p.access(r.Header, bck, apc.AceGET); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	if listMultipart {
@@ -577,6 +649,66 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 	sgl.Free()
 }
 
+// GET /s3/<bucket-name>?versions (ListObjectVersions)
+// bcast to all targets, merge, and paginate
+func (p *proxy) listObjVersionsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceObjLIST); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	var (
+		smap = p.owner.smap.get()
+		all  = s3.NewListVersionsResult(bck.Name)
+	)
+	all.Prefix = q.Get(s3.QparamPrefix)
+	all.KeyMarker, all.VersionIDMarker = q.Get(s3.QparamKeyMarker), q.Get(s3.QparamVersionIDMarker)
+	if s := q.Get(s3.QparamMaxKeys); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			s3.WriteErr(w, r, fmt.Errorf("invalid %s=%q", s3.QparamMaxKeys, s), http.StatusBadRequest)
+			return
+		}
+		all.MaxKeys = n
+	}
+	for _, si := range smap.Tmap {
+		if si.InMaintOrDecomm() {
+			continue
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = si
+			cargs.req = cmn.HreqArgs{Method: http.MethodGet, Base: si.URL(cmn.NetPublic), Path: r.URL.Path, Query: q}
+			cargs.timeout = apc.LongTimeout
+		}
+		res := p.call(cargs, smap)
+		b, err := res.bytes, res.err
+		freeCargs(cargs)
+		freeCR(res)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		results := &s3.ListVersionsResult{}
+		if err := xml.Unmarshal(b, results); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		all.Merge(results)
+	}
+	all.Paginate()
+
+	sgl := p.gmm.NewSGL(0)
+	all.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
 // HEAD /s3/<bucket-name>/<object-name>
 func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	if len(items) < 2 {
@@ -589,8 +721,8 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := bck.Allow(apc.AceObjHEAD); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	if err := p.access(r.Header, bck, apc.AceObjHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	smap := p.owner.smap.get()
@@ -618,8 +750,12 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 		si   *meta.Snode
 		smap = p.owner.smap.get()
 	)
-	if err = bck.Allow(apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
+	perms := apc.AceObjDELETE
+	if cos.IsParseBool(r.Header.Get(cos.S3HdrBypassGovernance)) {
+		perms |= apc.AcePATCH
+	}
+	if err = p.access(r.Header, bck, perms); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
 		return
 	}
 	if len(items) < 2 {
@@ -647,6 +783,10 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	resp := s3.NewVersioningConfiguration(bck.Props.Versioning.Enabled)
 	sgl := p.gmm.NewSGL(0)
 	resp.MustMarshal(sgl)
@@ -655,7 +795,7 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>/<object-name>?acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, errCode)
@@ -675,6 +815,10 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
 	decoder := xml.NewDecoder(r.Body)
 	vconf := &s3.VersioningConfiguration{}
 	if err := decoder.Decode(vconf); err != nil {
@@ -695,3 +839,352 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 		s3.WriteErr(w, r, err, 0)
 	}
 }
+
+// GET /s3/<bucket-name>?lifecycle
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if !bck.Props.Lifecycle.Enabled {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(bucket, s3.NoLifecycle), http.StatusNotFound)
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(&bck.Props.Lifecycle)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?lifecycle
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	lconf := &s3.LifecycleConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(lconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := lconf.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	propsToUpdate := &cmn.BpropsToSet{
+		Lifecycle: &cmn.LifecycleConfToSet{Enabled: &conf.Enabled, Rules: &conf.Rules},
+	}
+	p.setBpropsS3(w, r, msg, bck, propsToUpdate)
+}
+
+// DELETE /s3/<bucket-name>?lifecycle
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	var (
+		enabled       bool
+		rules         = []cmn.LifecycleRule{}
+		propsToUpdate = &cmn.BpropsToSet{
+			Lifecycle: &cmn.LifecycleConfToSet{Enabled: &enabled, Rules: &rules},
+		}
+	)
+	if p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) setBpropsS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck,
+	propsToUpdate *cmn.BpropsToSet) bool {
+	nprops, err := p.makeNewBckProps(bck, propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// GET /s3/<bucket-name>?acl
+func (p *proxy) getBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	resp := s3.NewAccessControlPolicy(p.owner.smap.get().UUID, bck.Props.Access)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?acl
+// (either canned ACL via request header or access control policy in the request body)
+func (p *proxy) putBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	var access apc.AccessAttrs
+	if canned := r.Header.Get(cos.S3HdrACL); canned != "" {
+		access, err = s3.CannedACL(canned, bck.Props.Access)
+	} else {
+		acp := &s3.AccessControlPolicy{}
+		if err = xml.NewDecoder(r.Body).Decode(acp); err == nil {
+			access, err = acp.Access(p.owner.smap.get().UUID)
+		}
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{Access: &access})
+}
+
+// GET /s3/<bucket-name>?policy
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	bp := s3.NewBucketPolicy(bucket, bck.Props.Access, &bck.Props.Policy)
+	if bp == nil {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(bucket, s3.NoPolicy), http.StatusNotFound)
+		return
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Write(bp.MustMarshal())
+}
+
+// PUT /s3/<bucket-name>?policy
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	bp := &s3.BucketPolicy{}
+	if err := jsoniter.NewDecoder(r.Body).Decode(bp); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	access, policy, err := bp.ToProps(bucket, bck.Props.Access)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	propsToUpdate := &cmn.BpropsToSet{
+		Access: &access,
+		Policy: &cmn.BckPolicyToSet{ID: &policy.ID, Statements: &policy.Statements},
+	}
+	if p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// DELETE /s3/<bucket-name>?policy
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	var (
+		access        = s3.PolicyAccess(bck.Props.Access)
+		id            string
+		stmts         = []cmn.BckPolicyStmt{}
+		propsToUpdate = &cmn.BpropsToSet{
+			Access: &access,
+			Policy: &cmn.BckPolicyToSet{ID: &id, Statements: &stmts},
+		}
+	)
+	if p.setBpropsS3(w, r, msg, bck, propsToUpdate) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?cors
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if len(bck.Props.CORS.Rules) == 0 {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(bucket, s3.NoCORS), http.StatusNotFound)
+		return
+	}
+	resp := s3.NewCORSConfiguration(&bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?cors
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	cconf := &s3.CORSConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(cconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf := cconf.ToConf()
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{CORS: &cmn.CORSConfToSet{Rules: &conf.Rules}})
+}
+
+// DELETE /s3/<bucket-name>?cors
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	rules := []cmn.CORSRule{}
+	if p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{CORS: &cmn.CORSConfToSet{Rules: &rules}}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?object-lock
+func (p *proxy) getBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if !bck.Props.ObjLock.Enabled {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(bucket, s3.NoObjLock), http.StatusNotFound)
+		return
+	}
+	resp := s3.NewObjectLockConfiguration(&bck.Props.ObjLock)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?object-lock
+// (enables object lock, if not enabled yet, and sets or clears default retention)
+func (p *proxy) putBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	lconf := &s3.ObjectLockConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(lconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	toSet, err := lconf.ToConfToSet()
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	p.setBpropsS3(w, r, msg, bck, &cmn.BpropsToSet{ObjLock: toSet})
+}
@@ -0,0 +1,97 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("S3 bucket policy", func() {
+	const (
+		bucket     = "s3-policy"
+		aliceToken = "alice-token"
+		bobToken   = "bob-token"
+	)
+	var (
+		p   *proxy
+		bck *meta.Bck
+	)
+
+	newToken := func(uid string) *tok.Token {
+		return &tok.Token{
+			UserID:      uid,
+			Expires:     time.Now().Add(time.Hour),
+			ClusterACLs: []*authn.CluACL{{Access: apc.AccessAll}}, // (default cluster)
+		}
+	}
+	newRequest := func(method, token string) *http.Request {
+		r := httptest.NewRequest(method, "/"+apc.S3+"/"+bucket+"/obj", strings.NewReader(""))
+		r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+token)
+		return r
+	}
+	setAuth := func(enabled bool) {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.Enabled = enabled
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	}
+
+	BeforeEach(func() {
+		p = newPrimary()
+		p.authn = newAuthManager()
+		p.authn.tkList[aliceToken] = newToken("alice")
+		p.authn.tkList[bobToken] = newToken("bob")
+
+		bck = meta.NewBck(bucket, apc.AIS, cmn.NsGlobal)
+		props := defaultBckProps(bckPropsArgs{bck: bck})
+		props.Access = apc.AccessAll
+		props.Policy = cmn.BckPolicy{
+			Statements: []cmn.BckPolicyStmt{
+				{Sid: "deny-rw", Principals: []string{"alice"}, Access: apc.AceGET | apc.AcePUT, Deny: true},
+			},
+		}
+		bmd := newBucketMD()
+		bmd.add(bck, props)
+		o := newBMDOwnerPrx(cmn.GCO.Get())
+		o.put(bmd)
+		p.owner.bmd = o
+
+		setAuth(true)
+	})
+	AfterEach(func() {
+		setAuth(false)
+	})
+
+	It("should deny S3 GET and PUT to the denied principal", func() {
+		for _, method := range []string{http.MethodGet, http.MethodPut} {
+			w := httptest.NewRecorder()
+			p.s3Handler(w, newRequest(method, aliceToken))
+			Expect(w.Code).To(Equal(http.StatusForbidden), method)
+		}
+	})
+
+	It("should allow operations not covered by the Deny statement", func() {
+		r := newRequest(http.MethodHead, aliceToken)
+		Expect(p.access(r.Header, bck, apc.AceObjHEAD)).NotTo(HaveOccurred())
+	})
+
+	It("should not affect other principals", func() {
+		for _, ace := range []apc.AccessAttrs{apc.AceGET, apc.AcePUT} {
+			r := newRequest(http.MethodGet, bobToken)
+			Expect(p.access(r.Header, bck, ace)).NotTo(HaveOccurred())
+		}
+	})
+})
@@ -430,7 +430,9 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 	// NOTE: setting up IC listening prior to committing (and confirming xid) here and elsewhere
 	if ctx.needReMirror || ctx.needReEC {
 		action := apc.ActMakeNCopies
-		if ctx.needReEC {
+		if ctx.needReencode {
+			action = apc.ActECReencode
+		} else if ctx.needReEC {
 			action = apc.ActECEncode
 		}
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
@@ -459,6 +461,7 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 	}
 	ctx.needReMirror = _reMirror(bprops, ctx.setProps)
 	targetCnt, ctx.needReEC = _reEC(bprops, ctx.setProps, bck, p.owner.smap.get())
+	ctx.needReencode = ctx.needReEC && bprops.EC.Enabled
 	debug.Assert(!ctx.needReEC || ctx.setProps.Validate(targetCnt) == nil)
 	clone.set(bck, ctx.setProps)
 	return nil
@@ -1083,14 +1086,12 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet,
 		nprops.Versioning.Enabled = false
 		// TODO: Check if the `RefDirectory` does not overlap with other buckets.
 	}
-	if bprops.EC.Enabled && nprops.EC.Enabled {
-		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
-		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
-		if !sameSlices || (!sameLimit && !propsToUpdate.Force) {
-			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change", p.si)
-			return
-		}
-	} else if nprops.EC.Enabled {
+	if bprops.ObjLock.Enabled && !nprops.ObjLock.Enabled {
+		err = fmt.Errorf("%s: once enabled, object lock cannot be disabled (bucket %s)", p.si, bck)
+		return
+	}
+	// NOTE: changing EC layout of an erasure coded bucket triggers ec-reencode (see _reEC)
+	if !bprops.EC.Enabled && nprops.EC.Enabled {
 		if nprops.EC.DataSlices == 0 {
 			nprops.EC.DataSlices = 1
 		}
@@ -0,0 +1,177 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 bucket ACL <=> bucket access attributes (`Bprops.Access`)
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/acl-overview.html
+// Notes:
+// - AIS bucket access applies to all users (subject to AuthN, if enabled) -
+//   hence, only group grants (AllUsers, AuthenticatedUsers) are supported;
+// - per-user permissions: use bucket policy (see policy.go);
+// - "private" (same as owner-only grant) means no bucket-level restrictions.
+
+// canned ACLs
+const (
+	ACLPrivate          = "private"
+	ACLPublicRead       = "public-read"
+	ACLPublicReadWrite  = "public-read-write"
+	ACLAuthRead         = "authenticated-read"
+	ACLBckOwnerFullCtrl = "bucket-owner-full-control"
+)
+
+// grant permissions
+const (
+	permRead        = "READ"
+	permWrite       = "WRITE"
+	permReadACP     = "READ_ACP"
+	permWriteACP    = "WRITE_ACP"
+	permFullControl = "FULL_CONTROL"
+)
+
+// grantees
+const (
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+	granteeUser  = "CanonicalUser"
+	granteeGroup = "Group"
+
+	groupAllUsers  = "http://acs.amazonaws.com/groups/global/AllUsers"
+	groupAuthUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+const aclOwnerName = "ais"
+
+const aceWrite = apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE | apc.AceObjMOVE
+
+type (
+	AccessControlPolicy struct {
+		Owner  Owner   `xml:"Owner"`
+		Grants []Grant `xml:"AccessControlList>Grant"`
+	}
+	Owner struct {
+		ID          string `xml:"ID"`
+		DisplayName string `xml:"DisplayName,omitempty"`
+	}
+	Grant struct {
+		Grantee    Grantee `xml:"Grantee"`
+		Permission string  `xml:"Permission"`
+	}
+	Grantee struct {
+		// (output only - see grantee type below)
+		XSI  string `xml:"xmlns:xsi,attr,omitempty"`
+		Type string `xml:"xsi:type,attr,omitempty"`
+
+		ID          string `xml:"ID,omitempty"`
+		DisplayName string `xml:"DisplayName,omitempty"`
+		URI         string `xml:"URI,omitempty"`
+	}
+)
+
+var aclPerms = []struct {
+	perm string
+	ace  apc.AccessAttrs
+}{
+	{permRead, apc.AccessRO},
+	{permWrite, aceWrite},
+	{permReadACP, apc.AceBckHEAD},
+	{permWriteACP, apc.AceBckSetACL},
+}
+
+// canned ACL => bucket access
+// read-only ACLs revoke write permissions but retain the rest of the current ones - including
+// the permissions to update bucket props and ACL (the owner must be able to revert)
+func CannedACL(acl string, access apc.AccessAttrs) (apc.AccessAttrs, error) {
+	switch acl {
+	case ACLPrivate, ACLBckOwnerFullCtrl, ACLPublicReadWrite:
+		return apc.AccessAll, nil
+	case ACLPublicRead, ACLAuthRead:
+		return access&^aceWrite | apc.AccessRO | apc.AcePATCH | apc.AceBckSetACL, nil
+	default:
+		return 0, fmt.Errorf("canned ACL %q is not supported", acl)
+	}
+}
+
+func NewAccessControlPolicy(ownerID string, access apc.AccessAttrs) *AccessControlPolicy {
+	owner := Owner{ID: ownerID, DisplayName: aclOwnerName}
+	acp := &AccessControlPolicy{Owner: owner}
+	acp.Grants = append(acp.Grants, Grant{
+		Grantee:    Grantee{XSI: xsiNamespace, Type: granteeUser, ID: owner.ID, DisplayName: owner.DisplayName},
+		Permission: permFullControl,
+	})
+
+	group := Grantee{XSI: xsiNamespace, Type: granteeGroup, URI: groupAllUsers}
+	if cmn.Rom.AuthEnabled() {
+		group.URI = groupAuthUsers
+	}
+	if access == apc.AccessAll {
+		acp.Grants = append(acp.Grants, Grant{Grantee: group, Permission: permFullControl})
+		return acp
+	}
+	for _, p := range aclPerms {
+		if access.Has(p.ace) {
+			acp.Grants = append(acp.Grants, Grant{Grantee: group, Permission: p.perm})
+		}
+	}
+	return acp
+}
+
+func (acp *AccessControlPolicy) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(acp)
+	debug.AssertNoErr(err)
+}
+
+// access control policy => bucket access
+func (acp *AccessControlPolicy) Access(ownerID string) (apc.AccessAttrs, error) {
+	var (
+		access apc.AccessAttrs
+		groups int
+	)
+	for i := range acp.Grants {
+		g := &acp.Grants[i]
+		switch {
+		case g.Grantee.URI == groupAllUsers || g.Grantee.URI == groupAuthUsers:
+			groups++
+		case g.Grantee.ID != "" && g.Grantee.ID == ownerID:
+			if g.Permission != permFullControl {
+				return 0, fmt.Errorf("owner's permission %q is not supported", g.Permission)
+			}
+			continue
+		default:
+			return 0, fmt.Errorf("grantee %+v is not supported (use bucket policy to grant per-user permissions)",
+				g.Grantee)
+		}
+		if g.Permission == permFullControl {
+			access = apc.AccessAll
+			continue
+		}
+		var found bool
+		for _, p := range aclPerms {
+			if p.perm == g.Permission {
+				access |= p.ace
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("invalid grant permission %q", g.Permission)
+		}
+	}
+	if groups == 0 {
+		access = apc.AccessAll // (private)
+	}
+	return access, nil
+}
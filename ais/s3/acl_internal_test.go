@@ -0,0 +1,30 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestCannedACLRevert(t *testing.T) {
+	for _, acl := range []string{ACLPublicRead, ACLAuthRead} {
+		access, err := CannedACL(acl, apc.AccessAll)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if access.Has(apc.AcePUT) || access.Has(apc.AceObjDELETE) || !access.Has(apc.AccessRO) {
+			t.Fatalf("%s: expecting read-only access, got %s", acl, access.Describe(true))
+		}
+		// the owner can still change (and revert) the ACL
+		if !access.Has(apc.AceBckSetACL) || !access.Has(apc.AcePATCH) {
+			t.Fatalf("%s: expecting owner's permissions retained, got %s", acl, access.Describe(true))
+		}
+		if access, err = CannedACL(ACLPrivate, access); err != nil || access != apc.AccessAll {
+			t.Fatalf("%s => %s: expecting full access, got %s (%v)", acl, ACLPrivate, access.Describe(true), err)
+		}
+	}
+}
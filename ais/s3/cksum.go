@@ -0,0 +1,271 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Additional (aka flexible) checksums:
+// - PUT and UploadPart: `x-amz-checksum-<algo>` carries base64-encoded value to validate the content against;
+//   `x-amz-checksum-algorithm` (or `x-amz-sdk-checksum-algorithm`) requests computing the checksum
+//   with no expected value;
+// - on mismatch, the request fails with BadDigest and nothing gets stored;
+// - computed values are stored in object's custom metadata keyed by the respective header name;
+// - GET and HEAD return them when requested via `x-amz-checksum-mode: ENABLED`;
+// - CompleteMultipartUpload: checksum of the concatenated (binary) part checksums, with "-<number of parts>" suffix.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
+
+const cksumModeEnabled = "ENABLED"
+
+// supported checksums (by header name)
+var cksumHdrs = [...]string{cos.S3ChecksumCRC32, cos.S3ChecksumCRC32C, cos.S3ChecksumSHA1, cos.S3ChecksumSHA256}
+
+var errBadDigest = errors.New("BadDigest")
+
+type (
+	cksumS3 struct {
+		h        hash.Hash
+		hdr      string // x-amz-checksum-<algo>
+		expected string // base64
+		computed string // ditto
+	}
+	// computes (and validates, if requested) checksums of the content it reads
+	CksumReader struct {
+		r    io.ReadCloser
+		oa   *cmn.ObjAttrs // when non-nil, stores computed checksums upon successful validation
+		cks  []*cksumS3
+		err  error
+		done bool
+	}
+)
+
+// interface guard
+var _ io.ReadCloser = (*CksumReader)(nil)
+
+func newCksumHash(hdr string) hash.Hash {
+	switch hdr {
+	case cos.S3ChecksumCRC32:
+		return crc32.NewIEEE()
+	case cos.S3ChecksumCRC32C:
+		return cos.NewCRC32C()
+	case cos.S3ChecksumSHA1:
+		return sha1.New()
+	default:
+		debug.Assert(hdr == cos.S3ChecksumSHA256, hdr)
+		return sha256.New()
+	}
+}
+
+// e.g. "CRC32C" => "x-amz-checksum-crc32c"
+func algoToHdr(algo string) (string, error) {
+	hdr := "x-amz-checksum-" + strings.ToLower(algo)
+	for _, name := range cksumHdrs {
+		if hdr == name {
+			return hdr, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported checksum algorithm %q", algo)
+}
+
+func decodeCksum(hdr, value string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(b) != newCksumHash(hdr).Size() {
+		return nil, fmt.Errorf("invalid %s %q", hdr, value)
+	}
+	return b, nil
+}
+
+/////////////////
+// CksumReader //
+/////////////////
+
+// returns nil when no checksums were requested
+func NewCksumReader(r io.ReadCloser, hdr http.Header, oa *cmn.ObjAttrs) (*CksumReader, error) {
+	var cks []*cksumS3
+	for _, name := range cksumHdrs {
+		v := hdr.Get(name)
+		if v == "" {
+			continue
+		}
+		if _, err := decodeCksum(name, v); err != nil {
+			return nil, err
+		}
+		cks = append(cks, &cksumS3{h: newCksumHash(name), hdr: name, expected: v})
+	}
+outer:
+	for _, algo := range []string{hdr.Get(cos.S3ChecksumAlgo), hdr.Get(cos.S3SDKChecksumAlgo)} {
+		if algo == "" {
+			continue
+		}
+		name, err := algoToHdr(algo)
+		if err != nil {
+			return nil, err
+		}
+		for _, ck := range cks {
+			if ck.hdr == name {
+				continue outer
+			}
+		}
+		cks = append(cks, &cksumS3{h: newCksumHash(name), hdr: name})
+	}
+	if len(cks) == 0 {
+		return nil, nil
+	}
+	return &CksumReader{r: r, oa: oa, cks: cks}, nil
+}
+
+// NOTE: validates at EOF, returning BadDigest error instead of io.EOF on mismatch
+func (cr *CksumReader) Read(b []byte) (n int, err error) {
+	n, err = cr.r.Read(b)
+	for _, ck := range cr.cks {
+		ck.h.Write(b[:n])
+	}
+	if err == io.EOF {
+		if errV := cr.validate(); errV != nil {
+			err = errV
+		}
+	}
+	return n, err
+}
+
+func (cr *CksumReader) Close() error { return cr.r.Close() }
+
+// non-nil iff validation failed
+func (cr *CksumReader) Err() error { return cr.err }
+
+// computed (and validated) checksums by header name
+func (cr *CksumReader) Cksums() (out cos.StrKVs) {
+	if !cr.done || cr.err != nil {
+		return nil
+	}
+	out = make(cos.StrKVs, len(cr.cks))
+	for _, ck := range cr.cks {
+		out[ck.hdr] = ck.computed
+	}
+	return out
+}
+
+func (cr *CksumReader) validate() error {
+	if cr.done {
+		return cr.err
+	}
+	cr.done = true
+	for _, ck := range cr.cks {
+		ck.computed = base64.StdEncoding.EncodeToString(ck.h.Sum(nil))
+		if ck.expected != "" && ck.expected != ck.computed {
+			cr.err = fmt.Errorf("%w: %s mismatch (expected %s, computed %s)", errBadDigest, ck.hdr, ck.expected, ck.computed)
+			return cr.err
+		}
+	}
+	if cr.oa != nil {
+		for _, ck := range cr.cks {
+			cr.oa.SetCustomKey(ck.hdr, ck.computed)
+		}
+	}
+	return nil
+}
+
+///////////////
+// Checksums //
+///////////////
+
+func (c *Checksums) get(hdr string) string {
+	switch hdr {
+	case cos.S3ChecksumCRC32:
+		return c.CRC32
+	case cos.S3ChecksumCRC32C:
+		return c.CRC32C
+	case cos.S3ChecksumSHA1:
+		return c.SHA1
+	default:
+		return c.SHA256
+	}
+}
+
+func (c *Checksums) set(hdr, value string) {
+	switch hdr {
+	case cos.S3ChecksumCRC32:
+		c.CRC32 = value
+	case cos.S3ChecksumCRC32C:
+		c.CRC32C = value
+	case cos.S3ChecksumSHA1:
+		c.SHA1 = value
+	default:
+		c.SHA256 = value
+	}
+}
+
+func (c *Checksums) FromCustom(custom cos.StrKVs) {
+	for _, name := range cksumHdrs {
+		if v, ok := custom[name]; ok {
+			c.set(name, v)
+		}
+	}
+}
+
+// multipart upload: given the (sorted) parts from CompleteMultipartUpload request and
+// their respective stored counterparts, validate the former (if specified) and
+// return checksum(s) of checksums common to all parts
+func MptCksums(parts []*PartInfo, nparts []*MptPart) (cos.StrKVs, error) {
+	debug.Assert(len(parts) == len(nparts))
+	for i, part := range parts {
+		for _, name := range cksumHdrs {
+			v := part.get(name)
+			if v == "" {
+				continue
+			}
+			if stored := nparts[i].Cksums.get(name); v != stored {
+				return nil, fmt.Errorf("%w: part %d %s mismatch (expected %s, have %q)",
+					errBadDigest, part.PartNumber, name, v, stored)
+			}
+		}
+	}
+	var out cos.StrKVs
+outer:
+	for _, name := range cksumHdrs {
+		h := newCksumHash(name)
+		for _, npart := range nparts {
+			v := npart.Cksums.get(name)
+			if v == "" {
+				continue outer
+			}
+			b, err := decodeCksum(name, v)
+			debug.AssertNoErr(err)
+			h.Write(b)
+		}
+		if out == nil {
+			out = make(cos.StrKVs, len(cksumHdrs))
+		}
+		out[name] = base64.StdEncoding.EncodeToString(h.Sum(nil)) + cmn.AwsMultipartDelim + strconv.Itoa(len(nparts))
+	}
+	return out, nil
+}
+
+// GET and HEAD response
+func SetCksumHdrs(hdr, reqHdr http.Header, custom cos.StrKVs) {
+	if !strings.EqualFold(reqHdr.Get(cos.S3ChecksumMode), cksumModeEnabled) {
+		return
+	}
+	for _, name := range cksumHdrs {
+		if v, ok := custom[name]; ok {
+			hdr.Set(name, v)
+		}
+	}
+}
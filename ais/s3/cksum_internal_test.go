@@ -0,0 +1,114 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func b64(b []byte) string { return base64.StdEncoding.EncodeToString(b) }
+
+func crc32b64(data string) string {
+	h := crc32.NewIEEE()
+	h.Write([]byte(data))
+	return b64(h.Sum(nil))
+}
+
+func TestCksumReader(t *testing.T) {
+	const data = "the quick brown fox"
+	sha := sha1.Sum([]byte(data))
+
+	// validate CRC32 and compute SHA1
+	hdr := http.Header{}
+	hdr.Set(cos.S3ChecksumCRC32, crc32b64(data))
+	hdr.Set(cos.S3SDKChecksumAlgo, "SHA1")
+	oa := &cmn.ObjAttrs{}
+	cr, err := NewCksumReader(io.NopCloser(strings.NewReader(data)), hdr, oa)
+	if err != nil || cr == nil {
+		t.Fatal(cr, err)
+	}
+	if _, err := io.ReadAll(cr); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := oa.GetCustomKey(cos.S3ChecksumSHA1); v != b64(sha[:]) {
+		t.Fatalf("expected %s, got %s", b64(sha[:]), v)
+	}
+	out := http.Header{}
+	SetCksumHdrs(out, http.Header{}, oa.GetCustomMD())
+	if len(out) != 0 {
+		t.Fatalf("expecting no checksums without %s: %v", cos.S3ChecksumMode, out)
+	}
+	out.Set(cos.S3ChecksumMode, "ENABLED")
+	SetCksumHdrs(out, out, oa.GetCustomMD())
+	if out.Get(cos.S3ChecksumCRC32) != crc32b64(data) {
+		t.Fatalf("unexpected response headers: %v", out)
+	}
+
+	// mismatch
+	hdr.Set(cos.S3ChecksumCRC32, crc32b64(data+"!"))
+	oa = &cmn.ObjAttrs{}
+	cr, _ = NewCksumReader(io.NopCloser(strings.NewReader(data)), hdr, oa)
+	if _, err := io.ReadAll(cr); !errors.Is(err, errBadDigest) || cr.Err() == nil {
+		t.Fatalf("expecting BadDigest, got %v", err)
+	}
+	if len(oa.GetCustomMD()) != 0 || cr.Cksums() != nil {
+		t.Fatalf("expecting nothing stored: %v", oa.GetCustomMD())
+	}
+
+	// invalid requests
+	for _, kv := range [][2]string{{cos.S3ChecksumCRC32, "not-base64"}, {cos.S3ChecksumSHA256, crc32b64(data)}, {cos.S3ChecksumAlgo, "MD5"}} {
+		hdr := http.Header{}
+		hdr.Set(kv[0], kv[1])
+		if _, err := NewCksumReader(io.NopCloser(strings.NewReader(data)), hdr, nil); err == nil {
+			t.Fatalf("expecting error for %s: %s", kv[0], kv[1])
+		}
+	}
+	if cr, err := NewCksumReader(io.NopCloser(strings.NewReader(data)), http.Header{}, nil); cr != nil || err != nil {
+		t.Fatal(cr, err)
+	}
+}
+
+func TestMptCksums(t *testing.T) {
+	var (
+		data   = []string{"part-one", "part-two"}
+		parts  = make([]*PartInfo, 0, len(data))
+		nparts = make([]*MptPart, 0, len(data))
+		concat []byte
+	)
+	for i, d := range data {
+		h := crc32.NewIEEE()
+		h.Write([]byte(d))
+		sum := h.Sum(nil)
+		concat = append(concat, sum...)
+		npart := &MptPart{Num: int64(i + 1)}
+		npart.Cksums.CRC32 = b64(sum)
+		nparts = append(nparts, npart)
+		parts = append(parts, &PartInfo{PartNumber: int64(i + 1), Checksums: Checksums{CRC32: b64(sum)}})
+	}
+	cksums, err := MptCksums(parts, nparts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := crc32.NewIEEE()
+	h.Write(concat)
+	if expected := b64(h.Sum(nil)) + "-2"; cksums[cos.S3ChecksumCRC32] != expected || len(cksums) != 1 {
+		t.Fatalf("expected %s, got %v", expected, cksums)
+	}
+
+	parts[1].CRC32 = parts[0].CRC32
+	if _, err := MptCksums(parts, nparts); !errors.Is(err, errBadDigest) {
+		t.Fatalf("expecting BadDigest, got %v", err)
+	}
+}
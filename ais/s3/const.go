@@ -10,7 +10,11 @@ const (
 	QparamLifecycle         = "lifecycle"
 	QparamCORS              = "cors"
 	QparamPolicy            = "policy"
+	QparamObjectLock        = "object-lock"
+	QparamRetention         = "retention"
+	QparamLegalHold         = "legal-hold"
 	QparamACL               = "acl"
+	QparamTagging           = "tagging"
 	QparamMultiDelete       = "delete"
 	QparamMaxKeys           = "max-keys"
 	QparamPrefix            = "prefix"
@@ -18,6 +22,12 @@ const (
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
 
+	// versions
+	QparamVersions        = "versions"
+	QparamVersionID       = "versionId"
+	QparamKeyMarker       = "key-marker"
+	QparamVersionIDMarker = "version-id-marker"
+
 	// multipart
 	QparamMptUploads        = "uploads"
 	QparamMptUploadID       = "uploadId"
@@ -0,0 +1,153 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 bucket CORS configuration <=> cmn.CORSConf, and CORS response headers
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+// - https://fetch.spec.whatwg.org/#http-cors-protocol
+
+const NoCORS = "CORSConfiguration" // (see ErrNoSuchConfig)
+
+type (
+	CORSConfiguration struct {
+		Rules []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+var (
+	errCORSPreflight = errors.New("invalid CORS preflight request: missing origin or request method")
+	errCORSForbidden = errors.New("CORSResponse: this CORS request is not allowed")
+)
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	r := &CORSConfiguration{Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		in := &conf.Rules[i]
+		r.Rules = append(r.Rules, CORSRule{
+			ID:             in.ID,
+			AllowedHeaders: in.AllowedHeaders,
+			AllowedMethods: in.AllowedMethods,
+			AllowedOrigins: in.AllowedOrigins,
+			ExposeHeaders:  in.ExposeHeaders,
+			MaxAgeSeconds:  in.MaxAgeSeconds,
+		})
+	}
+	return r
+}
+
+func (r *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *CORSConfiguration) ToConf() *cmn.CORSConf {
+	conf := &cmn.CORSConf{Rules: make([]cmn.CORSRule, 0, len(r.Rules))}
+	for i := range r.Rules {
+		in := &r.Rules[i]
+		conf.Rules = append(conf.Rules, cmn.CORSRule{
+			ID:             in.ID,
+			AllowedOrigins: in.AllowedOrigins,
+			AllowedMethods: in.AllowedMethods,
+			AllowedHeaders: in.AllowedHeaders,
+			ExposeHeaders:  in.ExposeHeaders,
+			MaxAgeSeconds:  in.MaxAgeSeconds,
+		})
+	}
+	return conf
+}
+
+// Handle OPTIONS (preflight) request.
+func CORSPreflight(w http.ResponseWriter, r *http.Request, conf *cmn.CORSConf) {
+	var (
+		origin = r.Header.Get(cos.HdrOrigin)
+		method = r.Header.Get(cos.HdrACRequestMethod)
+	)
+	if origin == "" || method == "" {
+		WriteErr(w, r, errCORSPreflight, http.StatusBadRequest)
+		return
+	}
+	reqHeaders := splitHeaderList(r.Header.Get(cos.HdrACRequestHeaders))
+	rule := conf.Match(origin, method, reqHeaders)
+	if rule == nil {
+		WriteErr(w, r, errCORSForbidden, http.StatusForbidden)
+		return
+	}
+	h := w.Header()
+	setAllowOrigin(h, origin, rule)
+	h.Set(cos.HdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(reqHeaders) > 0 {
+		h.Set(cos.HdrACAllowHeaders, strings.Join(reqHeaders, ", "))
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		h.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		h.Set(cos.HdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Given actual (non-preflight) cross-origin request, add CORS response headers if allowed.
+// Otherwise, do nothing - it is the browser that, in the absence of the headers, blocks the response.
+func SetCORSHeaders(w http.ResponseWriter, r *http.Request, conf *cmn.CORSConf) {
+	origin := r.Header.Get(cos.HdrOrigin)
+	if origin == "" || len(conf.Rules) == 0 {
+		return
+	}
+	rule := conf.Match(origin, r.Method, nil)
+	if rule == nil {
+		return
+	}
+	h := w.Header()
+	setAllowOrigin(h, origin, rule)
+	if len(rule.ExposeHeaders) > 0 {
+		h.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
+
+func setAllowOrigin(h http.Header, origin string, rule *cmn.CORSRule) {
+	if rule.AnyOrigin() {
+		h.Set(cos.HdrACAllowOrigin, "*")
+		return
+	}
+	h.Set(cos.HdrACAllowOrigin, origin)
+	h.Set(cos.HdrACAllowCredentials, "true")
+	h.Add(cos.HdrVary, cos.HdrOrigin)
+}
+
+func splitHeaderList(s string) (out []string) {
+	if s == "" {
+		return nil
+	}
+	for _, h := range strings.Split(s, ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			out = append(out, h)
+		}
+	}
+	return out
+}
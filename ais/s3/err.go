@@ -6,6 +6,7 @@ package s3
 
 import (
 	"encoding/xml"
+	"errors"
 	"net/http"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -14,13 +15,31 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 )
 
-type Error struct {
-	Code      string
-	Message   string
-	Resource  string
-	RequestID string `xml:"RequestId"`
+type (
+	Error struct {
+		Code      string
+		Message   string
+		Resource  string
+		RequestID string `xml:"RequestId"`
+	}
+
+	// e.g. "NoSuchLifecycleConfiguration"
+	ErrNoSuchConfig struct {
+		bucket string
+		what   string
+	}
+)
+
+func NewErrNoSuchConfig(bucket, what string) *ErrNoSuchConfig {
+	return &ErrNoSuchConfig{bucket: bucket, what: what}
 }
 
+func (e *ErrNoSuchConfig) Error() string {
+	return "bucket " + e.bucket + ": no " + e.what
+}
+
+func (e *ErrNoSuchConfig) code() string { return "NoSuch" + e.what }
+
 func (e *Error) mustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(e)
@@ -31,9 +50,13 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 	var (
 		out       Error
 		in        *cmn.ErrHTTP
+		nosuch    *ErrNoSuchConfig
 		ok        bool
 		allocated bool
 	)
+	if errCode == 0 && (cmn.IsErrObjLocked(err) || cmn.IsErrQuotaExceeded(err)) {
+		errCode = http.StatusForbidden
+	}
 	if in, ok = err.(*cmn.ErrHTTP); !ok {
 		in = cmn.InitErrHTTP(r, err, errCode)
 		allocated = true
@@ -44,6 +67,16 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case errors.As(err, &nosuch):
+		out.Code = nosuch.code()
+	case errors.Is(err, errInvalidTag):
+		out.Code = errInvalidTag.Error()
+	case errors.Is(err, errBadDigest):
+		out.Code = errBadDigest.Error()
+	case cmn.IsErrObjLocked(err):
+		out.Code = "AccessDenied"
+	case cmn.IsErrQuotaExceeded(err):
+		out.Code = "QuotaExceeded"
 	default:
 		out.Code = in.TypeCode
 	}
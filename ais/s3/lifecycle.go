@@ -0,0 +1,188 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 bucket lifecycle configuration <=> cmn.LifecycleConf
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketLifecycleConfiguration.html
+// Not supported:
+// - noncurrent version expiration/transitions
+// - object size filters
+
+const (
+	lcycleEnabled  = "Enabled"
+	lcycleDisabled = "Disabled"
+
+	NoLifecycle = "LifecycleConfiguration" // (see ErrNoSuchConfig)
+)
+
+type (
+	LifecycleConfiguration struct {
+		Rules []*LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		Expiration  *LcycleExpiration  `xml:"Expiration,omitempty"`
+		AbortMpt    *LcycleAbortMpt    `xml:"AbortIncompleteMultipartUpload,omitempty"`
+		Filter      *LcycleFilter      `xml:"Filter,omitempty"`
+		ID          string             `xml:"ID,omitempty"`
+		Prefix      string             `xml:"Prefix,omitempty"` // (legacy, prior to Filter)
+		Status      string             `xml:"Status"`
+		Transitions []LcycleTransition `xml:"Transition,omitempty"`
+	}
+	LcycleFilter struct {
+		And    *LcycleAnd `xml:"And,omitempty"`
+		Tag    *Tag       `xml:"Tag,omitempty"`
+		Prefix string     `xml:"Prefix,omitempty"`
+	}
+	LcycleAnd struct {
+		Prefix string `xml:"Prefix,omitempty"`
+		Tags   []Tag  `xml:"Tag,omitempty"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+	LcycleExpiration struct {
+		Date string `xml:"Date,omitempty"`
+		Days int    `xml:"Days,omitempty"`
+	}
+	LcycleTransition struct {
+		Date         string `xml:"Date,omitempty"`
+		StorageClass string `xml:"StorageClass"`
+		Days         int    `xml:"Days,omitempty"`
+	}
+	LcycleAbortMpt struct {
+		Days int `xml:"DaysAfterInitiation"`
+	}
+)
+
+func (r *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func NewLifecycleConfiguration(conf *cmn.LifecycleConf) *LifecycleConfiguration {
+	r := &LifecycleConfiguration{Rules: make([]*LifecycleRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		var (
+			in  = &conf.Rules[i]
+			out = &LifecycleRule{ID: in.ID, Status: lcycleEnabled}
+		)
+		if in.Disabled {
+			out.Status = lcycleDisabled
+		}
+		switch len(in.Tags) {
+		case 0:
+			out.Filter = &LcycleFilter{Prefix: in.Prefix}
+		case 1:
+			if in.Prefix == "" {
+				for k, v := range in.Tags {
+					out.Filter = &LcycleFilter{Tag: &Tag{Key: k, Value: v}}
+				}
+				break
+			}
+			fallthrough
+		default:
+			and := &LcycleAnd{Prefix: in.Prefix, Tags: make([]Tag, 0, len(in.Tags))}
+			for k, v := range in.Tags {
+				and.Tags = append(and.Tags, Tag{Key: k, Value: v})
+			}
+			out.Filter = &LcycleFilter{And: and}
+		}
+		switch {
+		case in.ExpirationDays > 0:
+			out.Expiration = &LcycleExpiration{Days: in.ExpirationDays}
+		case in.ExpirationDate > 0:
+			out.Expiration = &LcycleExpiration{Date: cos.FormatNanoTime(in.ExpirationDate, cos.ISO8601)}
+		}
+		if in.AbortMptDays > 0 {
+			out.AbortMpt = &LcycleAbortMpt{Days: in.AbortMptDays}
+		}
+		for _, tr := range in.Transitions {
+			t := LcycleTransition{StorageClass: tr.StorageClass, Days: tr.Days}
+			if tr.Date > 0 {
+				t.Date = cos.FormatNanoTime(tr.Date, cos.ISO8601)
+			}
+			out.Transitions = append(out.Transitions, t)
+		}
+		r.Rules = append(r.Rules, out)
+	}
+	return r
+}
+
+func (r *LifecycleConfiguration) ToConf() (*cmn.LifecycleConf, error) {
+	conf := &cmn.LifecycleConf{Enabled: true, Rules: make([]cmn.LifecycleRule, 0, len(r.Rules))}
+	for _, in := range r.Rules {
+		out := cmn.LifecycleRule{ID: in.ID, Prefix: in.Prefix}
+		switch in.Status {
+		case lcycleEnabled:
+		case lcycleDisabled:
+			out.Disabled = true
+		default:
+			return nil, fmt.Errorf("lifecycle rule %q: invalid status %q", in.ID, in.Status)
+		}
+		if f := in.Filter; f != nil {
+			switch {
+			case f.And != nil:
+				out.Prefix = f.And.Prefix
+				out.Tags = make(cos.StrKVs, len(f.And.Tags))
+				for _, tag := range f.And.Tags {
+					out.Tags[tag.Key] = tag.Value
+				}
+			case f.Tag != nil:
+				out.Tags = cos.StrKVs{f.Tag.Key: f.Tag.Value}
+			default:
+				out.Prefix = f.Prefix
+			}
+		}
+		if exp := in.Expiration; exp != nil {
+			out.ExpirationDays = exp.Days
+			if exp.Date != "" {
+				tm, err := parseDate(exp.Date)
+				if err != nil {
+					return nil, fmt.Errorf("lifecycle rule %q: invalid expiration date: %v", in.ID, err)
+				}
+				out.ExpirationDate = tm.UnixNano()
+			}
+		}
+		if in.AbortMpt != nil {
+			out.AbortMptDays = in.AbortMpt.Days
+		}
+		for _, tr := range in.Transitions {
+			t := cmn.LifecycleTransition{StorageClass: tr.StorageClass, Days: tr.Days}
+			if tr.Date != "" {
+				tm, err := parseDate(tr.Date)
+				if err != nil {
+					return nil, fmt.Errorf("lifecycle rule %q: invalid transition date: %v", in.ID, err)
+				}
+				t.Date = tm.UnixNano()
+			}
+			out.Transitions = append(out.Transitions, t)
+		}
+		conf.Rules = append(conf.Rules, out)
+	}
+	return conf, nil
+}
+
+// ISO 8601 (e.g. "2024-01-01T00:00:00.000Z" or "2024-01-01")
+func parseDate(s string) (time.Time, error) {
+	if tm, err := time.Parse(time.RFC3339, s); err == nil {
+		return tm, nil
+	}
+	return time.Parse(time.DateOnly, s)
+}
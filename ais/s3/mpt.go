@@ -10,9 +10,11 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
@@ -21,13 +23,14 @@ import (
 // NOTE: xattr stores only the (*) marked attributes
 type (
 	MptPart struct {
-		MD5  string // MD5 of the part (*)
-		FQN  string // FQN of the corresponding workfile
-		Size int64  // part size in bytes (*)
-		Num  int64  // part number (*)
+		MD5    string    // MD5 of the part (*)
+		FQN    string    // FQN of the corresponding workfile
+		Size   int64     // part size in bytes (*)
+		Num    int64     // part number (*)
+		Cksums Checksums // additional checksums, if any (see cksum.go)
 	}
 	mpt struct {
-		bckName string
+		bck     cmn.Bck
 		objName string
 		parts   []*MptPart // by part number
 		ctime   time.Time  // InitUpload time
@@ -43,10 +46,10 @@ var (
 func Init() { ups = make(uploads) }
 
 // Start miltipart upload
-func InitUpload(id, bckName, objName string) {
+func InitUpload(id string, bck *cmn.Bck, objName string) {
 	mu.Lock()
 	ups[id] = &mpt{
-		bckName: bckName,
+		bck:     *bck,
 		objName: objName,
 		parts:   make([]*MptPart, 0, iniCapParts),
 		ctime:   time.Now(),
@@ -144,6 +147,28 @@ func FinishUpload(id, fqn string, aborted bool) (exists bool) {
 	return true
 }
 
+// Abort (and cleanup) incomplete uploads that were initiated prior to `olderThan`
+// (see bucket lifecycle: `cmn.LifecycleRule.AbortMptDays`)
+func AbortStale(bck *cmn.Bck, prefix string, olderThan time.Duration) (n int) {
+	var (
+		ids []string
+		now = time.Now()
+	)
+	mu.RLock()
+	for id, mpt := range ups {
+		if mpt.bck.Equal(bck) && strings.HasPrefix(mpt.objName, prefix) && now.Sub(mpt.ctime) > olderThan {
+			ids = append(ids, id)
+		}
+	}
+	mu.RUnlock()
+	for _, id := range ids {
+		if FinishUpload(id, "", true /*aborted*/) {
+			n++
+		}
+	}
+	return n
+}
+
 func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploadsResult) {
 	mu.RLock()
 	results := make([]UploadInfoResult, 0, len(ups))
@@ -185,12 +210,12 @@ func ListParts(id string, lom *core.LOM) (parts []*PartInfo, err error, errCode
 			mu.RUnlock()
 			return
 		}
-		mpt.bckName, mpt.objName = lom.Bck().Name, lom.ObjName
+		mpt.bck, mpt.objName = *lom.Bucket(), lom.ObjName
 		mpt.ctime = lom.Atime()
 	}
 	parts = make([]*PartInfo, 0, len(mpt.parts))
 	for _, part := range mpt.parts {
-		parts = append(parts, &PartInfo{ETag: part.MD5, PartNumber: part.Num, Size: part.Size})
+		parts = append(parts, &PartInfo{ETag: part.MD5, PartNumber: part.Num, Size: part.Size, Checksums: part.Cksums})
 	}
 	mu.RUnlock()
 	return
@@ -0,0 +1,203 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 object lock <=> cmn.ObjLockConf (bucket) and LOM custom metadata (object)
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLockConfiguration.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+
+const (
+	NoObjLock   = "ObjectLockConfiguration" // (see ErrNoSuchConfig)
+	NoRetention = "ObjectRetention"
+
+	objLockEnabled = "Enabled"
+	legalHoldOff   = "OFF"
+)
+
+type (
+	ObjectLockConfiguration struct {
+		XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+		ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+		Rule              *ObjectLockRule `xml:"Rule,omitempty"`
+	}
+	ObjectLockRule struct {
+		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
+	}
+	DefaultRetention struct {
+		Mode  string `xml:"Mode"`
+		Days  int    `xml:"Days,omitempty"`
+		Years int    `xml:"Years,omitempty"`
+	}
+
+	Retention struct {
+		XMLName         xml.Name `xml:"Retention"`
+		Mode            string   `xml:"Mode,omitempty"`
+		RetainUntilDate string   `xml:"RetainUntilDate,omitempty"`
+	}
+	LegalHold struct {
+		XMLName xml.Name `xml:"LegalHold"`
+		Status  string   `xml:"Status"`
+	}
+)
+
+var errObjLockNotEnabled = errors.New("bucket is missing object lock configuration")
+
+//
+// bucket: ?object-lock
+//
+
+func NewObjectLockConfiguration(conf *cmn.ObjLockConf) *ObjectLockConfiguration {
+	r := &ObjectLockConfiguration{ObjectLockEnabled: objLockEnabled}
+	if conf.Days > 0 {
+		r.Rule = &ObjectLockRule{DefaultRetention: DefaultRetention{Mode: conf.Mode, Days: conf.Days}}
+	}
+	return r
+}
+
+func (r *ObjectLockConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *ObjectLockConfiguration) ToConfToSet() (*cmn.ObjLockConfToSet, error) {
+	if r.ObjectLockEnabled != objLockEnabled {
+		return nil, fmt.Errorf("invalid ObjectLockEnabled %q (expecting %q)", r.ObjectLockEnabled, objLockEnabled)
+	}
+	var (
+		enabled = true
+		mode    string
+		days    int
+	)
+	if r.Rule != nil {
+		dr := &r.Rule.DefaultRetention
+		if (dr.Days > 0) == (dr.Years > 0) {
+			return nil, errors.New("default retention requires either Days or Years (but not both)")
+		}
+		mode, days = dr.Mode, dr.Days
+		if dr.Years > 0 {
+			days = dr.Years * 365
+		}
+	}
+	return &cmn.ObjLockConfToSet{Enabled: &enabled, Mode: &mode, Days: &days}, nil
+}
+
+//
+// object: ?retention and ?legal-hold
+//
+
+// returns nil when the object has no retention
+func NewRetention(custom cos.StrKVs) *Retention {
+	mode, ok := custom[cmn.RetentionModeObjMD]
+	if !ok {
+		return nil
+	}
+	return &Retention{Mode: mode, RetainUntilDate: custom[cmn.RetainUntilObjMD]}
+}
+
+func (r *Retention) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *Retention) ToMsg() *apc.ObjRetention {
+	return &apc.ObjRetention{Mode: r.Mode, RetainUntil: r.RetainUntilDate}
+}
+
+func NewLegalHold(custom cos.StrKVs) *LegalHold {
+	if custom[cmn.LegalHoldObjMD] == cmn.LegalHoldOn {
+		return &LegalHold{Status: cmn.LegalHoldOn}
+	}
+	return &LegalHold{Status: legalHoldOff}
+}
+
+func (r *LegalHold) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *LegalHold) IsOn() (bool, error) {
+	return parseLegalHold(r.Status)
+}
+
+func parseLegalHold(s string) (bool, error) {
+	switch strings.ToUpper(s) {
+	case cmn.LegalHoldOn:
+		return true, nil
+	case legalHoldOff:
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid legal hold status %q (expecting %q or %q)", s, cmn.LegalHoldOn, legalHoldOff)
+	}
+}
+
+// PutObject with x-amz-object-lock-* headers
+func ObjLockFromHdr(custom cos.StrKVs, hdr http.Header, enabled bool) (cos.StrKVs, error) {
+	var (
+		mode  = hdr.Get(cos.S3HdrObjLockMode)
+		until = hdr.Get(cos.S3HdrObjLockRetainUntil)
+		hold  = hdr.Get(cos.S3HdrObjLockLegalHold)
+	)
+	if mode == "" && until == "" && hold == "" {
+		return custom, nil
+	}
+	if !enabled {
+		return nil, errObjLockNotEnabled
+	}
+	if custom == nil {
+		custom = make(cos.StrKVs, 3)
+	}
+	if mode != "" || until != "" {
+		if !cmn.IsValidRetentionMode(mode) {
+			return nil, fmt.Errorf("invalid %s %q", cos.S3HdrObjLockMode, mode)
+		}
+		t, err := cmn.ParseRetainUntil(until)
+		if err != nil {
+			return nil, err
+		}
+		custom[cmn.RetentionModeObjMD] = mode
+		custom[cmn.RetainUntilObjMD] = cmn.FormatRetainUntil(t)
+	}
+	if hold != "" {
+		on, err := parseLegalHold(hold)
+		if err != nil {
+			return nil, err
+		}
+		if on {
+			custom[cmn.LegalHoldObjMD] = cmn.LegalHoldOn
+		}
+	}
+	return custom, nil
+}
+
+// GET and HEAD response
+func SetObjLockHdr(hdr http.Header, custom cos.StrKVs) {
+	if mode, ok := custom[cmn.RetentionModeObjMD]; ok {
+		hdr.Set(cos.S3HdrObjLockMode, mode)
+		hdr.Set(cos.S3HdrObjLockRetainUntil, custom[cmn.RetainUntilObjMD])
+	}
+	if custom[cmn.LegalHoldObjMD] == cmn.LegalHoldOn {
+		hdr.Set(cos.S3HdrObjLockLegalHold, cmn.LegalHoldOn)
+	}
+}
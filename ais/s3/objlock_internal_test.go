@@ -0,0 +1,84 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestObjLockConfiguration(t *testing.T) {
+	const body = `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>` +
+		`<Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>`
+	lconf := &ObjectLockConfiguration{}
+	if err := xml.Unmarshal([]byte(body), lconf); err != nil {
+		t.Fatal(err)
+	}
+	toSet, err := lconf.ToConfToSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !*toSet.Enabled || *toSet.Mode != apc.ObjLockCompliance || *toSet.Days != 365 {
+		t.Fatalf("unexpected conf-to-set: %v, %q, %d", *toSet.Enabled, *toSet.Mode, *toSet.Days)
+	}
+
+	conf := &cmn.ObjLockConf{Enabled: true, Mode: apc.ObjLockGovernance, Days: 90}
+	out := NewObjectLockConfiguration(conf)
+	if out.Rule == nil || out.Rule.DefaultRetention.Days != 90 || out.Rule.DefaultRetention.Mode != apc.ObjLockGovernance {
+		t.Fatalf("unexpected configuration: %+v", out)
+	}
+
+	lconf.Rule.DefaultRetention.Days = 30 // both days and years
+	if _, err := lconf.ToConfToSet(); err == nil {
+		t.Fatal("expecting error (both Days and Years)")
+	}
+	lconf.ObjectLockEnabled = ""
+	if _, err := lconf.ToConfToSet(); err == nil {
+		t.Fatal("expecting error (not enabled)")
+	}
+}
+
+func TestObjLockFromHdr(t *testing.T) {
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrObjLockMode, apc.ObjLockGovernance)
+	hdr.Set(cos.S3HdrObjLockRetainUntil, "2099-01-02T03:04:05.000Z")
+	hdr.Set(cos.S3HdrObjLockLegalHold, "ON")
+
+	if _, err := ObjLockFromHdr(nil, hdr, false /*enabled*/); err == nil {
+		t.Fatal("expecting error (object lock not enabled)")
+	}
+	custom, err := ObjLockFromHdr(nil, hdr, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if custom[cmn.RetentionModeObjMD] != apc.ObjLockGovernance || custom[cmn.RetainUntilObjMD] != "2099-01-02T03:04:05Z" ||
+		custom[cmn.LegalHoldObjMD] != cmn.LegalHoldOn {
+		t.Fatalf("unexpected custom metadata: %v", custom)
+	}
+
+	out := http.Header{}
+	SetObjLockHdr(out, custom)
+	if out.Get(cos.S3HdrObjLockMode) != apc.ObjLockGovernance || out.Get(cos.S3HdrObjLockLegalHold) != cmn.LegalHoldOn {
+		t.Fatalf("unexpected response headers: %v", out)
+	}
+	if r := NewRetention(custom); r == nil || r.ToMsg().RetainUntil != "2099-01-02T03:04:05Z" {
+		t.Fatalf("unexpected retention: %+v", r)
+	}
+
+	hdr.Del(cos.S3HdrObjLockRetainUntil) // mode without date
+	if _, err := ObjLockFromHdr(nil, hdr, true); err == nil {
+		t.Fatal("expecting error (missing retain-until date)")
+	}
+	hdr = http.Header{}
+	hdr.Set(cos.S3HdrObjLockLegalHold, "maybe")
+	if _, err := ObjLockFromHdr(nil, hdr, true); err == nil {
+		t.Fatal("expecting error (invalid legal hold)")
+	}
+}
@@ -0,0 +1,268 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// S3 (IAM-style) bucket policy <=> `Bprops.Access` + `Bprops.Policy`
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html
+// Translation:
+// - principal "*" (everyone): Deny statements clear the corresponding bucket access attributes;
+//   each new policy replaces all bucket access attributes that policy actions can express
+//   (ie., Allow is implied, unless explicitly denied);
+// - named principals (AuthN user IDs or IAM ARNs ending with "user/<ID>"): stored
+//   as per-user bindings in `Bprops.Policy` and enforced by AIS gateways.
+// Not supported:
+// - conditions, NotAction/NotPrincipal/NotResource
+// - object-level resources (other than "<bucket>/*")
+
+const (
+	policyVersion = "2012-10-17"
+
+	effectAllow = "Allow"
+	effectDeny  = "Deny"
+
+	principalAll = "*"
+	arnS3Prefix  = "arn:aws:s3:::"
+	arnUserSep   = ":user/"
+
+	NoPolicy = "BucketPolicy" // (see ErrNoSuchConfig)
+)
+
+type (
+	BucketPolicy struct {
+		Version   string       `json:"Version"`
+		ID        string       `json:"Id,omitempty"`
+		Statement []PolicyStmt `json:"Statement"`
+	}
+	PolicyStmt struct {
+		Principal Principal `json:"Principal"`
+		Sid       string    `json:"Sid,omitempty"`
+		Effect    string    `json:"Effect"`
+		Action    strList   `json:"Action"`
+		Resource  strList   `json:"Resource"`
+	}
+	// either "*" or {"AWS": "id" | ["id", ...]}
+	Principal struct {
+		AWS strList `json:"AWS"`
+	}
+	// JSON string or array of strings
+	strList []string
+)
+
+var policyActions = []struct {
+	action string
+	ace    apc.AccessAttrs
+}{
+	{"s3:GetObject", apc.AceGET | apc.AceObjHEAD},
+	{"s3:PutObject", apc.AcePUT | apc.AceAPPEND},
+	{"s3:DeleteObject", apc.AceObjDELETE},
+	{"s3:ListBucket", apc.AceObjLIST | apc.AceBckHEAD},
+}
+
+const policyActionAll = "s3:*"
+
+func (l *strList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := jsoniter.Unmarshal(b, &s); err == nil {
+		*l = strList{s}
+		return nil
+	}
+	var ss []string
+	if err := jsoniter.Unmarshal(b, &ss); err != nil {
+		return err
+	}
+	*l = ss
+	return nil
+}
+
+func (p *Principal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := jsoniter.Unmarshal(b, &s); err == nil {
+		if s != principalAll {
+			return fmt.Errorf("invalid principal %q", s)
+		}
+		p.AWS = strList{principalAll}
+		return nil
+	}
+	type principal Principal // (no recursion)
+	return jsoniter.Unmarshal(b, (*principal)(p))
+}
+
+func (p Principal) MarshalJSON() ([]byte, error) {
+	if len(p.AWS) == 1 && p.AWS[0] == principalAll {
+		return jsoniter.Marshal(principalAll)
+	}
+	return jsoniter.Marshal(map[string][]string{"AWS": p.AWS})
+}
+
+// (S3 => AIS)
+
+// Returns updated bucket access and per-user policy.
+func (bp *BucketPolicy) ToProps(bucket string, access apc.AccessAttrs) (apc.AccessAttrs, *cmn.BckPolicy, error) {
+	var (
+		deny   apc.AccessAttrs
+		policy = &cmn.BckPolicy{ID: bp.ID}
+	)
+	if len(bp.Statement) == 0 {
+		return 0, nil, errors.New("bucket policy must contain at least one statement")
+	}
+	for i := range bp.Statement {
+		stmt := &bp.Statement[i]
+		if stmt.Effect != effectAllow && stmt.Effect != effectDeny {
+			return 0, nil, fmt.Errorf("statement %q: invalid effect %q", stmt.Sid, stmt.Effect)
+		}
+		ace, err := stmt.access()
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := stmt.checkResources(bucket); err != nil {
+			return 0, nil, err
+		}
+		if len(stmt.Principal.AWS) == 0 {
+			return 0, nil, fmt.Errorf("statement %q: missing principal", stmt.Sid)
+		}
+		var users []string
+		for _, principal := range stmt.Principal.AWS {
+			if principal == principalAll {
+				if stmt.Effect == effectDeny {
+					deny |= ace
+				}
+				continue
+			}
+			users = append(users, principalToUser(principal))
+		}
+		if len(users) > 0 {
+			policy.Statements = append(policy.Statements, cmn.BckPolicyStmt{
+				Sid:        stmt.Sid,
+				Principals: users,
+				Access:     ace,
+				Deny:       stmt.Effect == effectDeny,
+			})
+		}
+	}
+	return PolicyAccess(access) &^ deny, policy, nil
+}
+
+// bucket access with all policy-expressible permissions restored (e.g., upon policy deletion)
+func PolicyAccess(access apc.AccessAttrs) apc.AccessAttrs {
+	for _, a := range policyActions {
+		access |= a.ace
+	}
+	return access
+}
+
+func (stmt *PolicyStmt) access() (ace apc.AccessAttrs, _ error) {
+	if len(stmt.Action) == 0 {
+		return 0, fmt.Errorf("statement %q: missing action", stmt.Sid)
+	}
+outer:
+	for _, action := range stmt.Action {
+		if action == policyActionAll {
+			ace |= apc.AccessAll &^ apc.AccessCluster
+			continue
+		}
+		for _, a := range policyActions {
+			if strings.EqualFold(action, a.action) {
+				ace |= a.ace
+				continue outer
+			}
+		}
+		return 0, cmn.NewErrUnsupp("bucket policy action", action)
+	}
+	return ace, nil
+}
+
+func (stmt *PolicyStmt) checkResources(bucket string) error {
+	for _, res := range stmt.Resource {
+		s := strings.TrimPrefix(res, arnS3Prefix)
+		if s == bucket || s == bucket+"/*" || s == principalAll {
+			continue
+		}
+		return fmt.Errorf("statement %q: resource %q is not supported (expecting %q or %q)",
+			stmt.Sid, res, arnS3Prefix+bucket, arnS3Prefix+bucket+"/*")
+	}
+	return nil
+}
+
+// e.g. "arn:aws:iam::123456789012:user/alice" => "alice"
+func principalToUser(principal string) string {
+	if i := strings.LastIndex(principal, arnUserSep); i >= 0 {
+		return principal[i+len(arnUserSep):]
+	}
+	return principal
+}
+
+// (AIS => S3)
+
+// Returns nil when there's nothing to report - neither bucket-wide restrictions nor per-user bindings.
+func NewBucketPolicy(bucket string, access apc.AccessAttrs, policy *cmn.BckPolicy) *BucketPolicy {
+	allowed, denied := aceToActions(access)
+	if len(denied) == 0 && len(policy.Statements) == 0 {
+		return nil
+	}
+	var (
+		bp  = &BucketPolicy{Version: policyVersion, ID: policy.ID}
+		res = strList{arnS3Prefix + bucket, arnS3Prefix + bucket + "/*"}
+	)
+	if len(denied) > 0 {
+		if len(allowed) > 0 {
+			bp.Statement = append(bp.Statement, PolicyStmt{
+				Principal: Principal{AWS: strList{principalAll}},
+				Effect:    effectAllow,
+				Action:    allowed,
+				Resource:  res,
+			})
+		}
+		bp.Statement = append(bp.Statement, PolicyStmt{
+			Principal: Principal{AWS: strList{principalAll}},
+			Effect:    effectDeny,
+			Action:    denied,
+			Resource:  res,
+		})
+	}
+	for i := range policy.Statements {
+		in := &policy.Statements[i]
+		out := PolicyStmt{
+			Sid:       in.Sid,
+			Principal: Principal{AWS: in.Principals},
+			Effect:    effectAllow,
+			Resource:  res,
+		}
+		if in.Deny {
+			out.Effect = effectDeny
+		}
+		if in.Access.Has(apc.AccessAll &^ apc.AccessCluster) {
+			out.Action = strList{policyActionAll}
+		} else {
+			out.Action, _ = aceToActions(in.Access)
+		}
+		bp.Statement = append(bp.Statement, out)
+	}
+	return bp
+}
+
+func aceToActions(ace apc.AccessAttrs) (has, hasNot strList) {
+	for _, a := range policyActions {
+		if ace.Has(a.ace) {
+			has = append(has, a.action)
+		} else {
+			hasNot = append(hasNot, a.action)
+		}
+	}
+	return has, hasNot
+}
+
+func (bp *BucketPolicy) MustMarshal() []byte { return cos.MustMarshal(bp) }
@@ -0,0 +1,59 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/sse"
+)
+
+// Server-side encryption (see cmn.SSEConf):
+// - PUT with `x-amz-server-side-encryption: AES256` encrypts the object with the default key
+//   of the configured key provider (SSE-S3);
+// - `aws:kms` with `x-amz-server-side-encryption-aws-kms-key-id` - with the specified key (SSE-KMS);
+// - otherwise, bucket configuration applies.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/serv-side-encryption.html
+
+const (
+	SSEAlgoAES256 = "AES256"
+	SSEAlgoKMS    = "aws:kms"
+)
+
+// returns the ID of the key to encrypt with, or empty string when not requested
+func SSEKeyFromHdr(hdr http.Header) (keyID string, err error) {
+	algo := hdr.Get(cos.S3HdrSSE)
+	keyID = hdr.Get(cos.S3HdrSSEKMSKeyID)
+	switch algo {
+	case "":
+		if keyID != "" {
+			err = fmt.Errorf("%s requires %s=%s", cos.S3HdrSSEKMSKeyID, cos.S3HdrSSE, SSEAlgoKMS)
+		}
+	case SSEAlgoAES256:
+		if keyID != "" {
+			err = fmt.Errorf("%s is not supported with %s=%s", cos.S3HdrSSEKMSKeyID, cos.S3HdrSSE, SSEAlgoAES256)
+			break
+		}
+		keyID, err = sse.DefaultKeyID()
+	case SSEAlgoKMS:
+		if keyID == "" {
+			keyID, err = sse.DefaultKeyID()
+		}
+	default:
+		err = fmt.Errorf("unsupported %s %q", cos.S3HdrSSE, algo)
+	}
+	return keyID, err
+}
+
+// PUT, GET, and HEAD response
+func SetSSEHdr(hdr http.Header, custom cos.StrKVs) {
+	if keyID, ok := custom[cmn.SSEKeyObjMD]; ok && keyID != "" {
+		hdr.Set(cos.S3HdrSSE, SSEAlgoKMS)
+		hdr.Set(cos.S3HdrSSEKMSKeyID, keyID)
+	}
+}
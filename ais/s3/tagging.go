@@ -0,0 +1,220 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 object tagging and user-defined metadata <=> LOM custom metadata
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/UsingMetadata.html#UserMetadata
+// Both are stored in cmn.ObjAttrs.CustomMD under cmn.TagObjMDPrefix and
+// cmn.UserObjMDPrefix, respectively.
+
+// limits
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+const (
+	maxTags      = 10
+	maxTagKeyLen = 128
+	maxTagValLen = 256
+)
+
+const (
+	directiveCopy    = "COPY"
+	directiveReplace = "REPLACE"
+)
+
+type (
+	Tagging struct {
+		TagSet TagSet `xml:"TagSet"`
+	}
+	TagSet struct {
+		Tags []Tag `xml:"Tag"`
+	}
+)
+
+var errInvalidTag = errors.New("InvalidTag")
+
+func (r *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *Tagging) ToKVs() (cos.StrKVs, error) {
+	return validateTags(r.TagSet.Tags)
+}
+
+func validateTags(tags []Tag) (cos.StrKVs, error) {
+	if len(tags) > maxTags {
+		return nil, fmt.Errorf("%w: object tags cannot be greater than %d", errInvalidTag, maxTags)
+	}
+	kvs := make(cos.StrKVs, len(tags))
+	for _, tag := range tags {
+		switch {
+		case tag.Key == "" || len(tag.Key) > maxTagKeyLen:
+			return nil, fmt.Errorf("%w: invalid tag key %q", errInvalidTag, tag.Key)
+		case len(tag.Value) > maxTagValLen:
+			return nil, fmt.Errorf("%w: tag %q value is too long", errInvalidTag, tag.Key)
+		case kvs.Contains(tag.Key):
+			return nil, fmt.Errorf("%w: duplicate tag key %q", errInvalidTag, tag.Key)
+		}
+		kvs[tag.Key] = tag.Value
+	}
+	return kvs, nil
+}
+
+// parse `x-amz-tagging` header (URL-encoded query, e.g. "k1=v1&k2=v2")
+func ParseTaggingHdr(s string) (cos.StrKVs, error) {
+	q, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", errInvalidTag, s, err)
+	}
+	tags := make([]Tag, 0, len(q))
+	for k, vals := range q {
+		if len(vals) > 1 {
+			return nil, fmt.Errorf("%w: duplicate tag key %q", errInvalidTag, k)
+		}
+		tags = append(tags, Tag{Key: k, Value: vals[0]})
+	}
+	return validateTags(tags)
+}
+
+//
+// LOM custom metadata
+//
+
+func GetTagging(custom cos.StrKVs) *Tagging {
+	out := &Tagging{TagSet: TagSet{Tags: make([]Tag, 0, 4)}}
+	for k, v := range custom {
+		if key, ok := strings.CutPrefix(k, cmn.TagObjMDPrefix); ok {
+			out.TagSet.Tags = append(out.TagSet.Tags, Tag{Key: key, Value: v})
+		}
+	}
+	sort.Slice(out.TagSet.Tags, func(i, j int) bool { return out.TagSet.Tags[i].Key < out.TagSet.Tags[j].Key })
+	return out
+}
+
+// replace all existing tags with the new ones (nil to delete)
+func SetTags(custom, tags cos.StrKVs) cos.StrKVs {
+	if custom == nil {
+		custom = make(cos.StrKVs, len(tags))
+	}
+	for k := range custom {
+		if strings.HasPrefix(k, cmn.TagObjMDPrefix) {
+			delete(custom, k)
+		}
+	}
+	for k, v := range tags {
+		custom[cmn.TagObjMDPrefix+k] = v
+	}
+	return custom
+}
+
+// replace all existing user-defined metadata with `x-amz-meta-*` headers from the request
+func SetUserMD(custom cos.StrKVs, hdr http.Header) cos.StrKVs {
+	if custom == nil {
+		custom = make(cos.StrKVs, 4)
+	}
+	for k := range custom {
+		if strings.HasPrefix(k, cmn.UserObjMDPrefix) {
+			delete(custom, k)
+		}
+	}
+	for k, vals := range hdr {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, cmn.UserObjMDPrefix) || len(vals) == 0 {
+			continue
+		}
+		switch k {
+		case cos.S3MetadataChecksumType, cos.S3MetadataChecksumVal: // (reserved)
+			continue
+		}
+		custom[k] = strings.Join(vals, ",")
+	}
+	return custom
+}
+
+// PUT with `x-amz-tagging` header and `x-amz-meta-*` headers
+func FromPutHdr(custom cos.StrKVs, hdr http.Header) (cos.StrKVs, error) {
+	custom = SetUserMD(custom, hdr)
+	if s := hdr.Get(cos.S3HdrTagging); s != "" {
+		tags, err := ParseTaggingHdr(s)
+		if err != nil {
+			return nil, err
+		}
+		custom = SetTags(custom, tags)
+	}
+	return custom, nil
+}
+
+// CopyObject: COPY (default) or REPLACE user-defined metadata and/or tags
+// Returns destination's user-defined metadata and tags (and the respective key prefixes) to replace
+// its existing ones (and nothing else) - or nil when there's nothing to replace.
+func FromCopyHdr(custom cos.StrKVs, hdr http.Header) (cos.StrKVs, []string, error) {
+	var (
+		mdirective = strings.ToUpper(hdr.Get(cos.S3HdrMetadataDirective))
+		tdirective = strings.ToUpper(hdr.Get(cos.S3HdrTaggingDirective))
+	)
+	for _, d := range []string{mdirective, tdirective} {
+		if d != "" && d != directiveCopy && d != directiveReplace {
+			return nil, nil, fmt.Errorf("invalid directive %q (expecting %q or %q)", d, directiveCopy, directiveReplace)
+		}
+	}
+	if mdirective != directiveReplace && tdirective != directiveReplace {
+		return nil, nil, nil
+	}
+	// (source's user-defined metadata and tags that are copied as is)
+	out := make(cos.StrKVs, 4)
+	for k, v := range custom {
+		switch {
+		case strings.HasPrefix(k, cmn.UserObjMDPrefix) && mdirective != directiveReplace:
+			out[k] = v
+		case strings.HasPrefix(k, cmn.TagObjMDPrefix) && tdirective != directiveReplace:
+			out[k] = v
+		}
+	}
+	if mdirective == directiveReplace {
+		out = SetUserMD(out, hdr)
+	}
+	if tdirective == directiveReplace {
+		tags, err := ParseTaggingHdr(hdr.Get(cos.S3HdrTagging))
+		if err != nil {
+			return nil, nil, err
+		}
+		out = SetTags(out, tags)
+	}
+	return out, []string{cmn.UserObjMDPrefix, cmn.TagObjMDPrefix}, nil
+}
+
+// HEAD and GET response: user-defined metadata and number of tags
+func SetMetaHeaders(hdr http.Header, custom cos.StrKVs) {
+	var cnt int
+	for k, v := range custom {
+		switch {
+		case strings.HasPrefix(k, cmn.UserObjMDPrefix):
+			hdr.Set(k, v)
+		case strings.HasPrefix(k, cmn.TagObjMDPrefix):
+			cnt++
+		}
+	}
+	if cnt > 0 {
+		hdr.Set(cos.S3HdrTaggingCount, strconv.Itoa(cnt))
+	}
+}
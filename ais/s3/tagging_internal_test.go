@@ -0,0 +1,86 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestTaggingRoundTrip(t *testing.T) {
+	hdr := http.Header{}
+	hdr.Set(cos.S3HdrTagging, "project=alpha&stage=raw")
+	hdr.Set("X-Amz-Meta-Owner", "team-a")
+	custom, err := FromPutHdr(cos.StrKVs{cmn.SourceObjMD: "ais"}, hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if custom[cmn.SourceObjMD] != "ais" || custom[cmn.UserObjMDPrefix+"owner"] != "team-a" {
+		t.Fatalf("unexpected custom metadata: %v", custom)
+	}
+	if !cmn.MatchTag(custom, "project") || !cmn.MatchTag(custom, "stage=raw") || cmn.MatchTag(custom, "stage=cooked") {
+		t.Fatalf("tag filter mismatch: %v", custom)
+	}
+	tagging := GetTagging(custom)
+	if len(tagging.TagSet.Tags) != 2 || tagging.TagSet.Tags[0].Key != "project" {
+		t.Fatalf("unexpected tag set: %+v", tagging.TagSet)
+	}
+
+	custom = SetTags(custom, nil)
+	if len(GetTagging(custom).TagSet.Tags) != 0 || custom[cmn.UserObjMDPrefix+"owner"] != "team-a" {
+		t.Fatalf("expecting tags (only) removed: %v", custom)
+	}
+
+	out := http.Header{}
+	SetMetaHeaders(out, custom)
+	if out.Get("x-amz-meta-owner") != "team-a" || out.Get(cos.S3HdrTaggingCount) != "" {
+		t.Fatalf("unexpected response headers: %v", out)
+	}
+}
+
+func TestTaggingLimits(t *testing.T) {
+	tags := make([]Tag, 0, maxTags+1)
+	for i := 0; i <= maxTags; i++ {
+		tags = append(tags, Tag{Key: string(rune('a' + i)), Value: "v"})
+	}
+	tagging := &Tagging{TagSet: TagSet{Tags: tags}}
+	if _, err := tagging.ToKVs(); err == nil {
+		t.Fatal("expecting too-many-tags error")
+	}
+	tagging.TagSet.Tags = []Tag{{Key: "k", Value: "1"}, {Key: "k", Value: "2"}}
+	if _, err := tagging.ToKVs(); err == nil {
+		t.Fatal("expecting duplicate-key error")
+	}
+}
+
+func TestCopyDirectives(t *testing.T) {
+	src := cos.StrKVs{
+		cmn.SourceObjMD:             "ais",
+		cmn.SSEKeyObjMD:             "src-key",
+		cmn.LegalHoldObjMD:          cmn.LegalHoldOn,
+		cmn.UserObjMDPrefix + "a":   "1",
+		cmn.TagObjMDPrefix + "tag1": "x",
+	}
+	hdr := http.Header{}
+	if custom, _, err := FromCopyHdr(src, hdr); err != nil || custom != nil {
+		t.Fatalf("expecting nothing to replace, got %v, %v", custom, err)
+	}
+
+	hdr.Set(cos.S3HdrMetadataDirective, directiveReplace)
+	hdr.Set("X-Amz-Meta-B", "2")
+	custom, prefixes, err := FromCopyHdr(src, hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(custom) != 2 || custom[cmn.UserObjMDPrefix+"b"] != "2" || custom[cmn.TagObjMDPrefix+"tag1"] != "x" {
+		t.Fatalf("expecting (only) new user metadata and source tags, got %v", custom)
+	}
+	if len(prefixes) != 2 {
+		t.Fatalf("unexpected prefixes %v", prefixes)
+	}
+}
@@ -68,6 +68,15 @@ type (
 		ETag       string `xml:"ETag"`
 		PartNumber int64  `xml:"PartNumber"`
 		Size       int64  `xml:"Size,omitempty"`
+		Checksums
+	}
+
+	// additional checksums (see cksum.go)
+	Checksums struct {
+		CRC32  string `xml:"ChecksumCRC32,omitempty"`
+		CRC32C string `xml:"ChecksumCRC32C,omitempty"`
+		SHA1   string `xml:"ChecksumSHA1,omitempty"`
+		SHA256 string `xml:"ChecksumSHA256,omitempty"`
 	}
 
 	// Multipart upload completion request
@@ -80,6 +89,7 @@ type (
 		Bucket string `xml:"Bucket"`
 		Key    string `xml:"Key"`
 		ETag   string `xml:"ETag"`
+		Checksums
 	}
 
 	// Multipart uploaded parts response
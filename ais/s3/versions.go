@@ -0,0 +1,168 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// ListObjectVersions and versioned GET/DELETE of ais:// objects
+// (previous versions are retained as per bucket's `versioning.retain`)
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/DeleteMarker.html
+
+type (
+	ListVersionsResult struct {
+		Name                string              `xml:"Name"`
+		Ns                  string              `xml:"xmlns,attr"`
+		Prefix              string              `xml:"Prefix"`
+		KeyMarker           string              `xml:"KeyMarker"`
+		VersionIDMarker     string              `xml:"VersionIdMarker"`
+		NextKeyMarker       string              `xml:"NextKeyMarker,omitempty"`
+		NextVersionIDMarker string              `xml:"NextVersionIdMarker,omitempty"`
+		MaxKeys             int                 `xml:"MaxKeys"`
+		IsTruncated         bool                `xml:"IsTruncated"`
+		Versions            []*VersionInfo      `xml:"Version"`
+		DeleteMarkers       []*DeleteMarkerInfo `xml:"DeleteMarker"`
+	}
+	VersionInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int64  `xml:"Size"`
+		Class        string `xml:"StorageClass"`
+	}
+	DeleteMarkerInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+	}
+)
+
+// (internal) sorting and pagination across both versions and delete markers
+type verEntry struct {
+	v   *VersionInfo
+	dm  *DeleteMarkerInfo
+	key string
+	ver int64
+}
+
+func NewListVersionsResult(bucket string) *ListVersionsResult {
+	return &ListVersionsResult{
+		Name:          bucket,
+		Ns:            s3Namespace,
+		MaxKeys:       1000,
+		Versions:      make([]*VersionInfo, 0),
+		DeleteMarkers: make([]*DeleteMarkerInfo, 0),
+	}
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// add all versions of a given object; `latest` is the first one (current or newest retained)
+func (r *ListVersionsResult) Add(objName string, versions []*core.ObjVersion) {
+	for i, ov := range versions {
+		lastModified := cos.FormatNanoTime(ov.Atime, cos.ISO8601)
+		if ov.DeleteMarker {
+			r.DeleteMarkers = append(r.DeleteMarkers, &DeleteMarkerInfo{
+				Key:          objName,
+				VersionID:    ov.Version,
+				IsLatest:     i == 0,
+				LastModified: lastModified,
+			})
+			continue
+		}
+		vi := &VersionInfo{
+			Key:          objName,
+			VersionID:    ov.Version,
+			IsLatest:     i == 0,
+			LastModified: lastModified,
+			Size:         ov.Size,
+		}
+		if ov.Cksum != nil {
+			vi.ETag = ov.Cksum.Value()
+		}
+		r.Versions = append(r.Versions, vi)
+	}
+}
+
+func (r *ListVersionsResult) Merge(other *ListVersionsResult) {
+	r.Versions = append(r.Versions, other.Versions...)
+	r.DeleteMarkers = append(r.DeleteMarkers, other.DeleteMarkers...)
+}
+
+// Sort (by name and, within the same name, newest version first), skip everything
+// up to and including (KeyMarker, VersionIDMarker), and truncate to MaxKeys.
+func (r *ListVersionsResult) Paginate() {
+	entries := make([]verEntry, 0, len(r.Versions)+len(r.DeleteMarkers))
+	for _, v := range r.Versions {
+		entries = append(entries, verEntry{v: v, key: v.Key, ver: verNum(v.VersionID)})
+	}
+	for _, dm := range r.DeleteMarkers {
+		entries = append(entries, verEntry{dm: dm, key: dm.Key, ver: verNum(dm.VersionID)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].key != entries[j].key {
+			return entries[i].key < entries[j].key
+		}
+		return entries[i].ver > entries[j].ver
+	})
+	if r.KeyMarker != "" {
+		var (
+			markerVer = verNum(r.VersionIDMarker)
+			i         int
+		)
+		for ; i < len(entries); i++ {
+			e := entries[i]
+			if e.key > r.KeyMarker || (e.key == r.KeyMarker && r.VersionIDMarker != "" && e.ver < markerVer) {
+				break
+			}
+		}
+		entries = entries[i:]
+	}
+	r.IsTruncated, r.NextKeyMarker, r.NextVersionIDMarker = false, "", ""
+	if r.MaxKeys > 0 && len(entries) > r.MaxKeys {
+		entries = entries[:r.MaxKeys]
+		last := entries[len(entries)-1]
+		r.IsTruncated = true
+		r.NextKeyMarker, r.NextVersionIDMarker = last.key, strconv.FormatInt(last.ver, 10)
+	}
+	r.Versions, r.DeleteMarkers = r.Versions[:0], r.DeleteMarkers[:0]
+	for _, e := range entries {
+		if e.v != nil {
+			r.Versions = append(r.Versions, e.v)
+		} else {
+			r.DeleteMarkers = append(r.DeleteMarkers, e.dm)
+		}
+	}
+}
+
+func verNum(ver string) int64 {
+	n, _ := strconv.ParseInt(ver, 10, 64)
+	return n
+}
+
+// PUT, GET, and HEAD response
+func SetVersionHdr(header http.Header, lom *core.LOM) {
+	if ver := lom.Version(); ver != "" && lom.Bck().IsAIS() {
+		header.Set(cos.S3VersionHeader, ver)
+	}
+}
@@ -0,0 +1,43 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/core"
+)
+
+func TestListVersionsPaginate(t *testing.T) {
+	var (
+		all = NewListVersionsResult("bck")
+		res = NewListVersionsResult("bck")
+	)
+	// two targets, in no particular order
+	all.Add("b", []*core.ObjVersion{{Version: "10"}, {Version: "9", DeleteMarker: true}, {Version: "2"}})
+	res.Add("a", []*core.ObjVersion{{Version: "3", DeleteMarker: true}, {Version: "1"}})
+	all.Merge(res)
+	all.MaxKeys = 3
+	all.Paginate()
+
+	if !all.IsTruncated || all.NextKeyMarker != "b" || all.NextVersionIDMarker != "10" {
+		t.Fatalf("unexpected next marker: %q, %q (truncated %t)", all.NextKeyMarker, all.NextVersionIDMarker, all.IsTruncated)
+	}
+	if len(all.Versions) != 2 || all.Versions[0].Key != "a" || all.Versions[1].VersionID != "10" || !all.Versions[1].IsLatest {
+		t.Fatalf("unexpected versions: %+v", all.Versions)
+	}
+	if len(all.DeleteMarkers) != 1 || all.DeleteMarkers[0].VersionID != "3" || !all.DeleteMarkers[0].IsLatest {
+		t.Fatalf("unexpected delete markers: %+v", all.DeleteMarkers)
+	}
+
+	// next page
+	all = NewListVersionsResult("bck")
+	all.Add("b", []*core.ObjVersion{{Version: "10"}, {Version: "9", DeleteMarker: true}, {Version: "2"}})
+	all.KeyMarker, all.VersionIDMarker = "b", "10"
+	all.Paginate()
+	if all.IsTruncated || len(all.DeleteMarkers) != 1 || len(all.Versions) != 1 || all.Versions[0].VersionID != "2" {
+		t.Fatalf("unexpected second page: %+v, %+v", all.Versions, all.DeleteMarkers)
+	}
+}
@@ -0,0 +1,41 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// per-bucket CORS (see cmn.CORSConf) - common for both proxy and target s3 handlers
+
+// OPTIONS /s3/<bucket-name>[/<object-name>]
+func s3Preflight(w http.ResponseWriter, r *http.Request, bowner meta.Bowner, items []string) {
+	if len(items) == 0 {
+		s3.WriteErr(w, r, errS3Req, 0)
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(items[0], bowner)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	s3.CORSPreflight(w, r, &bck.Props.CORS)
+}
+
+// cross-origin request: add Access-Control-* response headers (if allowed by the bucket's CORS rules)
+func s3CORS(w http.ResponseWriter, r *http.Request, bowner meta.Bowner, items []string) {
+	if len(items) == 0 || r.Header.Get(cos.HdrOrigin) == "" {
+		return
+	}
+	bck, err, _ := meta.InitByNameOnly(items[0], bowner)
+	if err != nil {
+		return // (the handler will report)
+	}
+	s3.SetCORSHeaders(w, r, &bck.Props.CORS)
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 )
 
@@ -27,5 +28,6 @@ func init() {
 // * Makefile (for `s3rproxy` build tag)
 // * ais/s3redirect_on.go
 func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, _, _ string) {
+	r.Header.Del(cos.HdrOrigin) // CORS response headers, if any, are already set (see s3CORS)
 	p.reverseNodeRequest(w, r, si)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/kvdb"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -70,6 +71,7 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		quotas       quotas
 	}
 )
 
@@ -140,6 +142,8 @@ func (t *target) _initBuiltin() error {
 			add, err = backend.NewAzure(t)
 		case apc.HDFS:
 			add, err = backend.NewHDFS(t)
+		case apc.File:
+			add, err = backend.NewFile(t)
 		case apc.AIS, apc.HTTP:
 			continue
 		default:
@@ -321,6 +325,14 @@ func (t *target) Run() error {
 	// register object type and workfile type
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{})
+	fs.CSM.Reg(fs.TierRedirType, &fs.TierRedirContentResolver{})
+	fs.CSM.Reg(fs.ETLCacheType, &fs.ETLCacheContentResolver{})
+
+	// server-side encryption: key provider (if configured)
+	if err := sse.Init(); err != nil {
+		cos.ExitLog(err)
+	}
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -374,6 +386,11 @@ func (t *target) Run() error {
 	mirror.Init()
 
 	xreg.RegWithHK()
+	t.regLifecycleHK()
+	t.regECScrubHK()
+	t.regTierHK()
+	t.regQuotaHK()
+	t.initWriteBack(config)
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -658,6 +675,10 @@ func (t *target) httpobjget(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 	}
 	lom := core.AllocLOM(apireq.items[1])
+	if apireq.dpq.version != "" && t.getObjVersion(w, r, apireq.bck, lom, apireq.dpq.version, false /*s3*/) {
+		core.FreeLOM(lom)
+		return
+	}
 	lom = t.getObject(w, r, apireq.dpq, apireq.bck, lom)
 	core.FreeLOM(lom)
 }
@@ -848,20 +869,24 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	if !t.isValidObjname(w, r, objName) {
 		return
 	}
-	if isRedirect(apireq.query) == "" {
+	// (intra-cluster DELETE: see delRemoteCopies)
+	if isRedirect(apireq.query) == "" && r.Header.Get(apc.HdrCallerID) == "" {
 		t.writeErrf(w, r, "%s: %s(obj) is expected to be redirected", t.si, r.Method)
 		return
 	}
 
-	evict := msg.Action == apc.ActEvictObjects
-	lom := core.AllocLOM(objName)
+	var (
+		evict  = msg.Action == apc.ActEvictObjects
+		bypass = cos.IsParseBool(apireq.query.Get(apc.QparamBypassGovernance))
+		lom    = core.AllocLOM(objName)
+	)
 	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
 		t.writeErr(w, r, err)
 		core.FreeLOM(lom)
 		return
 	}
 
-	errCode, err := t.DeleteObject(lom, evict)
+	errCode, err := t.delObject(lom, evict, bypass)
 	if err == nil && errCode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -881,7 +906,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActRenameObject {
+	if msg.Action != apc.ActRenameObject && msg.Action != apc.ActSetObjLock {
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -898,6 +923,16 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 	err = lom.InitBck(apireq.bck.Bucket())
+	if msg.Action == apc.ActSetObjLock {
+		if err == nil {
+			err = t.setObjLock(lom, msg)
+		}
+		if err != nil {
+			t.writeErr(w, r, err)
+		}
+		core.FreeLOM(lom)
+		return
+	}
 	if err == nil {
 		err = t.objMv(lom, msg)
 	}
@@ -1055,7 +1090,8 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 
 // PATCH /v1/objects/<bucket-name>/<object-name>
 // By default, adds or updates existing custom keys. Will remove all existing keys and
-// replace them with the specified ones _iff_ `apc.QparamNewCustom` is set.
+// replace them with the specified ones _iff_ `apc.QparamNewCustom` is set
+// (or, only those with the given `apc.QparamCustomPrefix` prefixes, if specified).
 func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	if err := t.parseReq(w, r, apireq); err != nil {
 		return
@@ -1094,14 +1130,54 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		return
 	}
 	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
-	if delOldSetNew {
-		lom.SetCustomMD(custom)
-	} else {
+	if err := patchCustom(lom, custom, delOldSetNew, apireq.query[apc.QparamCustomPrefix]...); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	lom.Persist()
+}
+
+// system metadata (object lock, encryption key, et al.) can be neither set nor removed - see cmn.IsSysObjMD
+func patchCustom(lom *core.LOM, custom cos.StrKVs, delOldSetNew bool, prefixes ...string) error {
+	for key := range custom {
+		if cmn.IsSysObjMD(key) {
+			return fmt.Errorf("%s: cannot modify system metadata %q", lom.Cname(), key)
+		}
+		if len(prefixes) > 0 && !hasAnyPrefix(key, prefixes) {
+			return fmt.Errorf("%s: custom key %q does not have any of the prefixes %v", lom.Cname(), key, prefixes)
+		}
+	}
+	switch {
+	case delOldSetNew && len(prefixes) > 0:
+		md := make(cos.StrKVs, len(custom)+4)
+		for key, val := range lom.GetCustomMD() {
+			if !hasAnyPrefix(key, prefixes) {
+				md[key] = val
+			}
+		}
 		for key, val := range custom {
-			lom.SetCustomKey(key, val)
+			md[key] = val
 		}
+		lom.SetCustomMD(md)
+		return nil
+	case delOldSetNew:
+		cmn.CopySysObjMD(custom, lom.GetCustomMD())
+		lom.SetCustomMD(custom)
+		return nil
 	}
-	lom.Persist()
+	for key, val := range custom {
+		lom.SetCustomKey(key, val)
+	}
+	return nil
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 //
@@ -1121,6 +1197,8 @@ func (t *target) httpecget(w http.ResponseWriter, r *http.Request) {
 		t.sendECMetafile(w, r, apireq.bck, apireq.items[2])
 	case ec.URLCT:
 		t.sendECCT(w, r, apireq.bck, apireq.items[2])
+	case ec.URLVerify:
+		t.verifyECCT(w, r, apireq.bck, apireq.items[2])
 	default:
 		t.writeErrURL(w, r)
 	}
@@ -1149,6 +1227,32 @@ func (t *target) sendECMetafile(w http.ResponseWriter, r *http.Request, bck *met
 	w.Write(b)
 }
 
+// Validates local slice (or replica) and returns its metadata (see ec-scrub).
+func (t *target) verifyECCT(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if err := bck.Init(t.owner.bmd); err != nil {
+		if !cmn.IsErrRemoteBckNotFound(err) { // is ais
+			t.writeErr(w, r, err, Silent)
+			return
+		}
+	}
+	md, err := ec.VerifyCT(bck, objName)
+	switch {
+	case err == nil:
+	case os.IsNotExist(err) || cos.IsNotExist(err, 0):
+		t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		return
+	case err == ec.ErrorCorrupted:
+		t.writeErr(w, r, err, http.StatusUnprocessableEntity, Silent)
+		return
+	default:
+		t.writeErr(w, r, err, http.StatusInternalServerError, Silent)
+		return
+	}
+	b := md.NewPack()
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
+	w.Write(b)
+}
+
 func (t *target) sendECCT(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
 	lom := core.AllocLOM(objName)
 	defer core.FreeLOM(lom)
@@ -1202,6 +1306,9 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 				fmt.Errorf("failed to archive %s: invalid flags %q in the request", lom.Cname(), s)
 		}
 	}
+	if lom.Bprops().SSE.Enabled {
+		return http.StatusNotImplemented, cmn.NewErrUnsupp("append to archive in bucket with server-side encryption", lom.Cname())
+	}
 	a := &putA2I{
 		started:  started,
 		t:        t,
@@ -1220,6 +1327,12 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		}
 		a.put = true
 	} else {
+		if lom.IsEncrypted() {
+			return http.StatusNotImplemented, cmn.NewErrUnsupp("append to encrypted archive", lom.Cname())
+		}
+		if err := lom.CheckObjLock(false /*bypass governance*/); err != nil {
+			return http.StatusForbidden, err
+		}
 		a.put = (flags == 0)
 	}
 	if s := r.Header.Get(cos.HdrContentLength); s != "" {
@@ -1234,10 +1347,14 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *core.LOM, evict bool) (int, error) {
+	return t.delObject(lom, evict, false /*bypass governance*/)
+}
+
+func (t *target) delObject(lom *core.LOM, evict, bypassGovernance bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, bypassGovernance)
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1252,13 +1369,16 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	}
 	if err == nil {
 		t.statsT.Inc(stats.DeleteCount)
+		if mconf := lom.MirrorConf(); mconf.Enabled && mconf.OnTargets() {
+			t.delRemoteCopies(lom)
+		}
 	} else {
 		t.statsT.IncErr(stats.DeleteCount) // TODO: count GET/PUT/DELETE remote errors separately..
 	}
 	return
 }
 
-func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *core.LOM, evict, bypassGovernance bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
@@ -1274,16 +1394,30 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 			return http.StatusNotFound, err, false
 		}
 	} else {
+		if err := lom.CheckObjLock(bypassGovernance); err != nil {
+			return http.StatusForbidden, err, false
+		}
+		if evict && core.WbPending(lom.Uname()) {
+			return http.StatusConflict, fmt.Errorf("cannot evict %s: pending write-back upload", lom.Cname()), false
+		}
 		delFromAIS = true
 	}
 
 	// do
 	if delFromBackend {
+		wback := core.WbCancel(lom.Uname())
 		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(lom)
+		if wback && cos.IsNotExist(backendErr, backendErrCode) {
+			backendErrCode, backendErr = 0, nil // (never uploaded)
+		}
 	}
 	if delFromAIS {
 		size := lom.SizeBytes()
-		aisErr = lom.Remove()
+		if retain := lom.Retain(); retain > 0 && !evict {
+			aisErr = lom.RemoveRetain(retain) // (ais:// only)
+		} else {
+			aisErr = lom.Remove()
+		}
 		if aisErr != nil {
 			if !os.IsNotExist(aisErr) {
 				if backendErr != nil {
@@ -1315,9 +1449,20 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	if lom.Bck().Props.EC.Enabled {
 		return fmt.Errorf("%s: cannot rename erasure-coded object %s", t.si, lom)
 	}
+	if mconf := lom.MirrorConf(); mconf.Enabled && mconf.OnTargets() {
+		return fmt.Errorf("%s: cannot rename object %s mirrored across targets", t.si, lom)
+	}
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if lom.Bprops().ObjLock.Enabled {
+		if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+			return err
+		}
+		if err := lom.CheckObjLock(false /*bypass governance*/); err != nil {
+			return err
+		}
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coiParams := core.AllocCOI()
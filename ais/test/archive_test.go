@@ -6,6 +6,7 @@ package integration_test
 
 import (
 	"archive/tar"
+	"bytes"
 	"fmt"
 	"math/rand"
 	"net/url"
@@ -90,6 +91,9 @@ func TestGetFromArch(t *testing.T) {
 				{
 					ext: archive.ExtTarLz4, nested: true, autodetect: true, mime: true,
 				},
+				{
+					ext: archive.ExtTarZst, nested: true, autodetect: true, mime: true,
+				},
 			}
 		)
 		if testing.Short() {
@@ -190,6 +194,102 @@ func TestGetFromArch(t *testing.T) {
 	})
 }
 
+// GET-batch: plain objects, archived files, WebDataset samples, and missing entries
+func TestGetBatch(t *testing.T) {
+	runProviderTests(t, func(t *testing.T, bck *meta.Bck) {
+		var (
+			m = ioContext{
+				t:         t,
+				bck:       bck.Clone(),
+				num:       20,
+				prefix:    "get-batch/",
+				fileSize:  cos.KiB,
+				fixedSize: true,
+			}
+			baseParams = tools.BaseAPIParams(m.proxyURL)
+			shardName  = "get-batch/shard-" + trand.String(5) + archive.ExtTar
+			numSamples = 5
+			recExts    = []string{".jpg", ".cls", ".json"}
+		)
+		m.init(true /*cleanup*/)
+		m.puts()
+
+		// a shard with `numSamples` samples, each consisting of len(recExts) files
+		var (
+			buf bytes.Buffer
+			aw  = archive.NewWriter(archive.ExtTar, &buf, nil, nil)
+		)
+		for i := 0; i < numSamples; i++ {
+			for _, ext := range recExts {
+				content := []byte(trand.String(100))
+				oah := cos.SimpleOAH{Size: int64(len(content))}
+				err := aw.Write(fmt.Sprintf("sample-%03d%s", i, ext), oah, bytes.NewReader(content))
+				tassert.CheckFatal(t, err)
+			}
+		}
+		aw.Fini()
+		_, err := api.PutObject(&api.PutArgs{
+			BaseParams: baseParams,
+			Bck:        m.bck,
+			ObjName:    shardName,
+			Reader:     readers.NewBytes(buf.Bytes()),
+			Size:       uint64(buf.Len()),
+		})
+		tassert.CheckFatal(t, err)
+
+		for _, mime := range []string{archive.ExtTar, archive.ExtTarGz, archive.ExtZip} {
+			for _, unordered := range []bool{false, true} {
+				t.Run(fmt.Sprintf("%s/unordered=%t", mime, unordered), func(t *testing.T) {
+					msg := &apc.GetBatchMsg{Mime: mime, Unordered: unordered}
+					for _, objName := range m.objNames {
+						msg.Entries = append(msg.Entries, apc.GetBatchEntry{ObjName: objName})
+					}
+					msg.Entries = append(msg.Entries,
+						apc.GetBatchEntry{ObjName: shardName, ArchPath: "sample-001.cls"},
+						apc.GetBatchEntry{ObjName: shardName, SampleKey: "sample-003"},
+					)
+					expected := len(m.objNames) + 1 + len(recExts)
+
+					var out bytes.Buffer
+					n, err := api.GetBatch(baseParams, m.bck, msg, &out)
+					tassert.CheckFatal(t, err)
+					tassert.Errorf(t, n == int64(out.Len()), "expected %d bytes, got %d", out.Len(), n)
+					files, err := tarch.GetFileInfosFromArchBuffer(out, mime)
+					tassert.CheckFatal(t, err)
+					tassert.Fatalf(t, len(files) == expected, "expected %d entries, got %d", expected, len(files))
+					if !unordered {
+						for i, objName := range m.objNames {
+							tassert.Errorf(t, files[i].Name() == objName, "expected %q at %d, got %q",
+								objName, i, files[i].Name())
+						}
+					}
+
+					// missing entry
+					msg.Entries = append(msg.Entries, apc.GetBatchEntry{ObjName: "get-batch/does-not-exist"})
+					out.Reset()
+					_, err = api.GetBatch(baseParams, m.bck, msg, &out)
+					tassert.Errorf(t, err != nil, "expecting get-batch to fail on a missing entry")
+
+					msg.ContinueOnError = true
+					out.Reset()
+					_, err = api.GetBatch(baseParams, m.bck, msg, &out)
+					tassert.CheckFatal(t, err)
+					files, err = tarch.GetFileInfosFromArchBuffer(out, mime)
+					tassert.CheckFatal(t, err)
+					tassert.Fatalf(t, len(files) == expected+1, "expected %d entries, got %d", expected+1, len(files))
+					var found bool
+					for _, f := range files {
+						if f.Name() == apc.GetBatchMissing+"get-batch/does-not-exist" {
+							found = true
+						}
+					}
+					tassert.Errorf(t, found, "missing-entry placeholder not found")
+				})
+			}
+		}
+	})
+}
+
 // archive multple obj-s with an option to append if exists
 func TestArchMultiObj(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
@@ -267,6 +367,9 @@ func testArch(t *testing.T, bck *meta.Bck) {
 			{
 				ext: archive.ExtTarLz4, list: false,
 			},
+			{
+				ext: archive.ExtTarZst, list: true, apnd: true,
+			},
 		}
 	)
 	if testing.Short() {
@@ -493,6 +596,9 @@ func TestAppendToArch(t *testing.T) {
 			{
 				ext: archive.ExtTarLz4, multi: true,
 			},
+			{
+				ext: archive.ExtTarZst, multi: true,
+			},
 		}
 	)
 	if !testing.Short() { // test-long, and see one other Skip below
@@ -1917,6 +1917,63 @@ func TestRemoteBucketMirror(t *testing.T) {
 	m.ensureNumCopies(baseParams, 3, false /*greaterOk*/)
 }
 
+// n-way mirror with copies placed across targets: GET must keep working when
+// one of the targets is gone (here: in maintenance, with no rebalance)
+func TestMirrorAcrossTargets(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{MinTargets: 3, Long: true})
+	var (
+		m = ioContext{
+			t:               t,
+			num:             500,
+			numGetsEachFile: 1,
+			fileSize:        cos.KiB,
+			fixedSize:       true,
+		}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	m.initAndSaveState(true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+
+	xid, err := api.SetBucketProps(baseParams, m.bck, &cmn.BpropsToSet{
+		Mirror: &cmn.MirrorConfToSet{
+			Enabled:   apc.Bool(true),
+			Copies:    apc.Int64(2),
+			Placement: apc.String(apc.MirrorPlaceTarget),
+		},
+	})
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActMakeNCopies, Bck: m.bck, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &args)
+	tassert.CheckFatal(t, err)
+
+	p, err := api.HeadBucket(baseParams, m.bck, true /* don't add */)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, p.Mirror.OnTargets(), "expecting %q placement, got %q", apc.MirrorPlaceTarget, p.Mirror.Placement)
+
+	m.puts()
+	args = xact.ArgsMsg{Kind: apc.ActPutCopies, Bck: m.bck}
+	api.WaitForXactionIdle(baseParams, &args)
+
+	tsi, _ := m.smap.GetRandTarget()
+	tlog.Logf("Put %s in maintenance (skipping rebalance)\n", tsi.StringEx())
+	actVal := &apc.ActValRmNode{DaemonID: tsi.ID(), SkipRebalance: true}
+	_, err = api.StartMaintenance(baseParams, actVal)
+	tassert.CheckFatal(t, err)
+	defer func() {
+		rebID, err := api.StopMaintenance(baseParams, actVal)
+		tassert.CheckFatal(t, err)
+		tools.WaitForRebalanceByID(t, baseParams, rebID)
+		tools.ClearMaintenance(baseParams, tsi)
+	}()
+	_, err = tools.WaitForClusterState(proxyURL, "target in maintenance",
+		m.smap.Version, m.smap.CountActivePs(), m.smap.CountActiveTs()-1)
+	tassert.CheckFatal(t, err)
+
+	m.gets(nil, false)
+	m.ensureNoGetErrors()
+}
+
 func TestBucketReadOnly(t *testing.T) {
 	m := ioContext{
 		t:               t,
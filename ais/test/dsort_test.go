@@ -1874,7 +1874,7 @@ func TestDsortMissingShards(t *testing.T) {
 
 func TestDsortDuplications(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
-	for _, ext := range []string{archive.ExtTar, archive.ExtTarLz4, archive.ExtTarGz, archive.ExtZip} { // all supported formats
+	for _, ext := range []string{archive.ExtTar, archive.ExtTarLz4, archive.ExtTarGz, archive.ExtZip, archive.ExtTarZst} { // all supported formats
 		t.Run(ext, func(t *testing.T) {
 			runDsortTest(
 				t, dsortTestSpec{
@@ -5,6 +5,7 @@
 package integration_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/rand"
 	"os"
@@ -598,11 +599,16 @@ func TestECChange(t *testing.T) {
 	_, err = api.SetBucketProps(baseParams, bck, bucketProps)
 	tassert.Errorf(t, err == nil, "Enabling EC failed: %v", err)
 
-	tlog.Logln("Trying to modify EC options when EC is enabled")
+	tlog.Logln("Modifying EC options when EC is enabled (triggers ec-reencode)")
 	bucketProps.EC.Enabled = apc.Bool(true)
 	bucketProps.EC.ObjSizeLimit = apc.Int64(300000)
 	_, err = api.SetBucketProps(baseParams, bck, bucketProps)
-	tassert.Errorf(t, err != nil, "Modifiying EC properties must fail")
+	tassert.Errorf(t, err == nil, "Modifying EC properties failed: %v", err)
+
+	tlog.Logln("Trying to set EC tiers in descending order")
+	bucketProps.EC.Tiers = &[]cmn.ECTier{{MinSize: cos.MiB, ParitySlices: 1}, {MinSize: cos.KiB, ParitySlices: 1}}
+	_, err = api.SetBucketProps(baseParams, bck, bucketProps)
+	tassert.Errorf(t, err != nil, "Setting invalid EC tiers must fail")
 
 	tlog.Logln("Resetting bucket properties")
 	_, err = api.ResetBucketProps(baseParams, bck)
@@ -2231,6 +2237,172 @@ func TestECBucketEncode(t *testing.T) {
 	//
 }
 
+// Erasure codes a bucket, changes its EC layout (adding size tiers), and waits for ec-reencode
+func TestECBucketReencode(t *testing.T) {
+	const (
+		parityCnt = 1
+		dataCnt   = 2
+	)
+	var (
+		proxyURL = tools.RandomProxyURL()
+		m        = ioContext{
+			t:        t,
+			num:      100,
+			fileSize: 64 * cos.KiB,
+			proxyURL: proxyURL,
+		}
+	)
+
+	m.initAndSaveState(true /*cleanup*/)
+	baseParams := tools.BaseAPIParams(proxyURL)
+
+	if nt := m.smap.CountActiveTs(); nt < parityCnt+dataCnt+1 {
+		t.Skipf("%s: not enough targets (%d): (d=%d, p=%d) requires at least %d",
+			t.Name(), nt, dataCnt, parityCnt, parityCnt+dataCnt+1)
+	}
+
+	initMountpaths(t, proxyURL)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+
+	m.puts()
+
+	tlog.Logf("Enabling EC (replicating all objects)\n")
+	bckPropsToUpate := &cmn.BpropsToSet{
+		EC: &cmn.ECConfToSet{
+			Enabled:      apc.Bool(true),
+			ObjSizeLimit: apc.Int64(cos.GiB),
+			DataSlices:   apc.Int(dataCnt),
+			ParitySlices: apc.Int(parityCnt),
+		},
+	}
+	_, err := api.SetBucketProps(baseParams, m.bck, bckPropsToUpate)
+	tassert.CheckFatal(t, err)
+	xargs := xact.ArgsMsg{Kind: apc.ActECEncode, Bck: m.bck, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+
+	tlog.Logf("Changing EC layout: %d+%d for objects of %s and larger\n", dataCnt, parityCnt, cos.ToSizeIEC(cos.KiB, 0))
+	bckPropsToUpate = &cmn.BpropsToSet{
+		EC: &cmn.ECConfToSet{
+			Tiers: &[]cmn.ECTier{{MinSize: cos.KiB, DataSlices: dataCnt, ParitySlices: parityCnt}},
+		},
+	}
+	_, err = api.SetBucketProps(baseParams, m.bck, bckPropsToUpate)
+	tassert.CheckFatal(t, err)
+
+	tlog.Logf("Wait for ec-reencode %s\n", m.bck)
+	xargs = xact.ArgsMsg{Kind: apc.ActECReencode, Bck: m.bck, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &xargs)
+	tassert.CheckFatal(t, err)
+
+	objList, err := api.ListObjects(baseParams, m.bck, nil, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	if len(objList.Entries) != m.num {
+		t.Fatalf("bucket %s: expected %d objects, got %d", m.bck, m.num, len(objList.Entries))
+	}
+	m.gets(nil, false)
+	m.ensureNoGetErrors()
+}
+
+// Damages (removes or corrupts) one slice of each object, runs ec-scrub, and checks
+// that all slices get rebuilt
+func TestECScrub(t *testing.T) {
+	const restoreTime = 10 * time.Second // (restored slices are sent asynchronously)
+	var (
+		bck = cmn.Bck{
+			Name:     testBucketName + "-ec-scrub",
+			Provider: apc.AIS,
+		}
+		proxyURL   = tools.RandomProxyURL()
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	o := ecOptions{
+		minTargets:  4,
+		objCount:    20,
+		concurrency: 8,
+		dataCnt:     2,
+		parityCnt:   1,
+		objSize:     ecMinBigSize * 2,
+		pattern:     "obj-scrub-%04d",
+		silent:      testing.Short(),
+	}.init(t, proxyURL)
+	initMountpaths(t, proxyURL)
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	totalCnt, objSize, sliceSize, _ := randObjectSize(0, 1, o)
+	for i := 0; i < o.objCount; i++ {
+		objName := fmt.Sprintf(o.pattern, i)
+		createECObject(t, baseParams, bck, objName, i, o)
+
+		// remove every other damaged slice, corrupt the rest
+		parts, _ := ecGetAllSlices(t, bck, ecTestDir+objName)
+		for fqn := range parts {
+			ct, err := core.NewCTFromFQN(fqn, nil)
+			tassert.CheckFatal(t, err)
+			if ct.ContentType() != fs.ECSliceType {
+				continue
+			}
+			if i%2 == 0 {
+				tlog.LogfCond(!o.silent, "Removing slice %s\n", fqn)
+				tassert.CheckFatal(t, os.Remove(fqn))
+			} else {
+				tlog.LogfCond(!o.silent, "Corrupting slice %s\n", fqn)
+				tassert.CheckFatal(t, os.WriteFile(fqn, bytes.Repeat([]byte{0xde}, int(sliceSize)), cos.PermRWR))
+			}
+			break
+		}
+	}
+
+	scrub := func() *ec.ExtECScrubStats {
+		xid, err := api.StartXaction(baseParams, &xact.ArgsMsg{Kind: apc.ActECScrub, Bck: bck})
+		tassert.CheckFatal(t, err)
+		args := xact.ArgsMsg{ID: xid, Kind: apc.ActECScrub, Timeout: tools.RebalanceTimeout}
+		_, err = api.WaitForXactionIC(baseParams, &args)
+		tassert.CheckFatal(t, err)
+
+		xs, err := api.QueryXactionSnaps(baseParams, &args)
+		tassert.CheckFatal(t, err)
+		total := &ec.ExtECScrubStats{}
+		for _, snaps := range xs {
+			for _, snap := range snaps {
+				ext := &ec.ExtECScrubStats{}
+				tassert.CheckFatal(t, cos.MorphMarshal(snap.Ext, ext))
+				total.Degraded += ext.Degraded
+				total.Repaired += ext.Repaired
+				total.Unrecoverable += ext.Unrecoverable
+			}
+		}
+		tlog.Logf("%s[%s]: degraded %d, repaired %d, unrecoverable %d\n", apc.ActECScrub, xid,
+			total.Degraded, total.Repaired, total.Unrecoverable)
+		return total
+	}
+
+	tlog.Logf("Scrubbing %s\n", bck.Cname(""))
+	stats := scrub()
+	tassert.Errorf(t, stats.Degraded == int64(o.objCount), "expected %d degraded objects, got %d", o.objCount, stats.Degraded)
+	tassert.Errorf(t, stats.Repaired == int64(o.objCount), "expected %d repaired objects, got %d", o.objCount, stats.Repaired)
+	tassert.Errorf(t, stats.Unrecoverable == 0, "expected no unrecoverable objects, got %d", stats.Unrecoverable)
+
+	for i := 0; i < o.objCount; i++ {
+		var (
+			objPath  = ecTestDir + fmt.Sprintf(o.pattern, i)
+			parts    map[string]ecSliceMD
+			deadline = time.Now().Add(restoreTime)
+		)
+		for time.Now().Before(deadline) {
+			if parts, _ = ecGetAllSlices(t, bck, objPath); len(parts) == totalCnt {
+				break
+			}
+			time.Sleep(250 * time.Millisecond)
+		}
+		ecCheckSlices(t, parts, bck, objPath, objSize, sliceSize, totalCnt)
+	}
+
+	tlog.Logf("Scrubbing %s (again)\n", bck.Cname(""))
+	stats = scrub()
+	tassert.Errorf(t, stats.Degraded == 0, "expected no degraded objects, got %d", stats.Degraded)
+}
+
 // Creates two buckets (with EC enabled and disabled), fill them with data,
 // and then runs two parallel rebalances
 func TestECAndRegularRebalance(t *testing.T) {
@@ -1605,3 +1605,53 @@ func TestOperationsWithRanges(t *testing.T) {
 		}
 	})
 }
+
+func TestObjectLock(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: "lock-" + trand.String(6), Provider: apc.AIS}
+		objName    = "locked-obj"
+		hold       = true
+	)
+	props := &cmn.BpropsToSet{ObjLock: &cmn.ObjLockConfToSet{Enabled: apc.Bool(true)}}
+	tools.CreateBucket(t, proxyURL, bck, props, true /*cleanup*/)
+
+	put := func() error {
+		_, err := api.PutObject(&api.PutArgs{
+			BaseParams: baseParams,
+			Bck:        bck,
+			ObjName:    objName,
+			Reader:     readers.NewBytes([]byte(trand.String(100))),
+			Size:       100,
+		})
+		return err
+	}
+	tassert.CheckFatal(t, put())
+
+	until := cmn.FormatRetainUntil(time.Now().Add(time.Hour))
+	err := api.SetObjectLock(baseParams, bck, objName, &apc.ObjLockMsg{
+		Retention: &apc.ObjRetention{Mode: apc.ObjLockGovernance, RetainUntil: until},
+		LegalHold: &hold,
+	})
+	tassert.CheckFatal(t, err)
+
+	tassert.Errorf(t, put() != nil, "expected overwrite to fail")
+	tassert.Errorf(t, api.RenameObject(baseParams, bck, objName, objName+".renamed") != nil, "expected rename to fail")
+	tassert.Errorf(t, api.DeleteObjectBypassGovernance(baseParams, bck, objName) != nil,
+		"expected delete to fail (legal hold)")
+
+	hold = false
+	tassert.CheckFatal(t, api.SetObjectLock(baseParams, bck, objName, &apc.ObjLockMsg{LegalHold: &hold}))
+	err = api.DeleteObject(baseParams, bck, objName)
+	tassert.Errorf(t, err != nil, "expected delete to fail (governance retention)")
+	if herr, ok := err.(*cmn.ErrHTTP); ok {
+		tassert.Errorf(t, herr.Status == http.StatusForbidden, "expected status %d, got %d", http.StatusForbidden, herr.Status)
+	}
+
+	// disabling is not permitted
+	_, err = api.SetBucketProps(baseParams, bck, &cmn.BpropsToSet{ObjLock: &cmn.ObjLockConfToSet{Enabled: apc.Bool(false)}})
+	tassert.Errorf(t, err != nil, "expected disabling object lock to fail")
+
+	tassert.CheckFatal(t, api.DeleteObjectBypassGovernance(baseParams, bck, objName))
+}
@@ -38,20 +38,22 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	if err != nil {
 		return
 	}
-	if err = t.isIntraCall(r.Header, false); err != nil {
-		t.writeErr(w, r, err)
-		return
-	}
 	msg, err := t.readAisMsg(w, r)
 	if err != nil {
 		return
 	}
-	t.ensureLatestBMD(msg, r)
-
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		t.writeErr(w, r, err)
 		return
 	}
+	// all bucket GETs are intra-cluster calls except get-batch redirected by proxy
+	if msg.Action != apc.ActGetBatch || dpq.ptime == "" {
+		if err = t.isIntraCall(r.Header, false); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.ensureLatestBMD(msg, r)
+	}
 
 	switch msg.Action {
 	case apc.ActList:
@@ -158,11 +160,113 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			}
 		}
 		t.bsumm(w, r, phase, bck, &bsumMsg, dpq)
+	case apc.ActGetBatch:
+		if len(apiItems) == 0 {
+			t.writeErrURL(w, r)
+			return
+		}
+		t.getBatch(w, r, apiItems[0], msg, dpq)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
 }
 
+// GET-batch: two cases
+// - redirected by proxy: this target is the designated one (DT) to assemble and write the output
+// - intra-cluster call from DT: send (to DT) locally stored entries
+func (t *target) getBatch(w http.ResponseWriter, r *http.Request, bucket string, msg *aisMsg, dpq *dpq) {
+	bck, err := newBckFromQ(bucket, nil, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		if cmn.IsErrRemoteBckNotFound(err) {
+			t.BMDVersionFixup(r)
+			err = bck.Init(t.owner.bmd)
+		}
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+	}
+	var gbmsg apc.GetBatchMsg
+	if err := cos.MorphMarshal(msg.Value, &gbmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+
+	var (
+		smap = t.owner.smap.get()
+		tsi  *meta.Snode // DT, when called by DT
+	)
+	if dpq.ptime == "" {
+		callerID := r.Header.Get(apc.HdrCallerID)
+		if tsi = smap.GetTarget(callerID); tsi == nil {
+			t.writeErr(w, r, &errNodeNotFound{apc.ActGetBatch + " failure:", callerID, t.si, smap})
+			return
+		}
+		// DT and this target must agree on who owns which entry (HRW) -
+		// otherwise, some entries would be sent twice and some never
+		if callerSver := r.Header.Get(apc.HdrCallerSmapVer); callerSver != smap.vstr {
+			t.writeErrf(w, r, "%s: %s from %s with Smap v%s, have %s", t, apc.ActGetBatch, meta.Tname(callerID),
+				callerSver, smap)
+			return
+		}
+	}
+
+	rns := xreg.RenewGetBatch()
+	if rns.Err != nil {
+		t.writeErr(w, r, rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get().(*xs.XactGetBatch)
+	if tsi != nil {
+		xctn.Send(msg.Name /*batch ID*/, &gbmsg, bck.Bucket(), &smap.Smap, tsi)
+		return
+	}
+
+	// DT (note that the same Smap version is carried by the broadcast below - see htrun.call)
+	id := cos.GenUUID()
+	wi := xctn.NewWI(id, &gbmsg, bck.Bucket(), &smap.Smap)
+	if smap.CountActiveTs() > 1 {
+		args := allocBcArgs()
+		args.req = cmn.HreqArgs{
+			Method: http.MethodGet,
+			Path:   apc.URLPathBuckets.Join(bck.Name),
+			Query:  bck.NewQuery(),
+			Body:   cos.MustMarshal(t.newAmsg(&apc.ActMsg{Action: apc.ActGetBatch, Name: id, Value: &gbmsg}, nil)),
+		}
+		args.to = core.Targets
+		args.smap = smap
+		results := t.bcastGroup(args)
+		freeBcArgs(args)
+		for _, res := range results {
+			if res.err != nil {
+				err = res.toErr()
+				break
+			}
+		}
+		freeBcastRes(results)
+		if err != nil {
+			wi.Discard()
+			t.writeErr(w, r, err)
+			return
+		}
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	n, err := wi.Do(w)
+	if err == nil {
+		return
+	}
+	if n == 0 {
+		t.writeErr(w, r, err)
+	} else {
+		// (too late to respond with an error status)
+		nlog.Errorln(wi.String()+":", err)
+	}
+}
+
 // there's a difference between looking for all (any) provider vs a specific one -
 // in the former case the fact that (the corresponding backend is not configured)
 // is not an error
@@ -387,6 +491,14 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			nlp := newBckNLP(apireq.bck)
 			nlp.Lock()
 			defer nlp.Unlock()
+			if err := chkBckObjLock(apireq.bck); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+			if err := chkBckWriteBack(apireq.bck); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
 
 			core.UncacheBck(apireq.bck)
 			err := fs.DestroyBucket(msg.Action, apireq.bck.Bucket(), apireq.bck.Props.BID)
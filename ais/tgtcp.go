@@ -676,8 +676,10 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		// NOTE: apc.ActMakeNCopies takes care of itself
 	}
 	if f.obck.Props.EC.Enabled && !nbck.Props.EC.Enabled {
-		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
-		xreg.DoAbort(flt, errors.New("apply-bmd"))
+		err := errors.New("apply-bmd")
+		xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}, err)
+		xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: nbck}, err)
+		xreg.DoAbort(xreg.Flt{Kind: apc.ActECScrub, Bck: nbck}, err)
 	}
 	return true // break
 }
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -25,8 +26,8 @@ import (
 
 // [METHOD] /v1/etl
 func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
-	if !k8s.IsK8s() {
-		t.writeErr(w, r, k8s.ErrK8sRequired, 0, Silent)
+	if err := etl.Supported(); err != nil {
+		t.writeErr(w, r, err, 0, Silent)
 		return
 	}
 	switch {
@@ -118,7 +119,9 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request) {
 	case apc.ETLHealth:
 		t.healthETL(w, r, apiItems[0])
 	case apc.ETLMetrics:
-		k8s.InitMetricsClient()
+		if k8s.IsK8s() {
+			k8s.InitMetricsClient()
+		}
 		t.metricsETL(w, r, apiItems[0])
 	default:
 		t.writeErrURL(w, r)
@@ -157,6 +160,10 @@ func (t *target) doETL(w http.ResponseWriter, r *http.Request, etlName string, b
 		comm etl.Communicator
 		err  error
 	)
+	if strings.Contains(etlName, apc.ETLPipelineSep) {
+		t.pipeETL(w, r, etlName, bck, objName)
+		return
+	}
 	comm, err = etl.GetCommunicator(etlName)
 	if err != nil {
 		if cos.IsErrNotFound(err) {
@@ -169,7 +176,7 @@ func (t *target) doETL(w http.ResponseWriter, r *http.Request, etlName string, b
 		t.writeErr(w, r, err)
 		return
 	}
-	if err := comm.InlineTransform(w, r, bck, objName); err != nil {
+	if err := etl.InlineTransform(comm, w, r, bck, objName); err != nil {
 		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
 			err.Error())
 		xetl := comm.Xact()
@@ -178,6 +185,24 @@ func (t *target) doETL(w http.ResponseWriter, r *http.Request, etlName string, b
 	}
 }
 
+// inline transformation via ETL pipeline, e.g.: GET /v1/objects/bucket/object?etl_name=decode,resize
+func (t *target) pipeETL(w http.ResponseWriter, r *http.Request, etlNames string, bck *meta.Bck, objName string) {
+	tr := apc.ParseTransform(etlNames)
+	pipe, err := etl.GetPipeline(tr.Names())
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound)
+			return
+		}
+		t.writeErr(w, r, err)
+		return
+	}
+	// (errors are added to the respective stage's xaction)
+	if err := pipe.InlineTransform(w, bck, objName); err != nil {
+		t.writeErr(w, r, err)
+	}
+}
+
 func (t *target) logsETL(w http.ResponseWriter, r *http.Request, etlName string) {
 	logs, err := etl.PodLogs(etlName)
 	if err != nil {
@@ -94,6 +94,9 @@ func (t *target) PutObject(lom *core.LOM, params *core.PutParams) error {
 }
 
 func (t *target) FinalizeObj(lom *core.LOM, workFQN string, xctn core.Xact) (errCode int, err error) {
+	if err = t.encryptWork(lom, workFQN); err != nil {
+		return http.StatusInternalServerError, err
+	}
 	poi := allocPOI()
 	{
 		poi.t = t
@@ -0,0 +1,61 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// bucket lifecycle: see cmn.LifecycleConf and xact/xs/lcycle.go
+
+const (
+	lcycleHKName    = "bucket-lifecycle" + hk.NameSuffix
+	lcycleInitIval  = time.Hour // (give the cluster time to start up)
+	lcycleRetryIval = 10 * time.Minute
+)
+
+func (t *target) regLifecycleHK() { hk.Reg(lcycleHKName, t.lcycleHK, lcycleInitIval) }
+
+// runs once a day, visits all ais:// buckets that have active lifecycle rules
+func (t *target) lcycleHK() time.Duration {
+	if !t.ClusterStarted() {
+		return lcycleRetryIval
+	}
+	var (
+		bmd      = t.owner.bmd.get()
+		provider = apc.AIS
+	)
+	bmd.Range(&provider, nil, func(bck *meta.Bck) bool {
+		if bck.Props.Lifecycle.IsActive() {
+			if rns := t.runLifecycle(cos.GenUUID(), bck); rns.Err != nil {
+				nlog.Errorln(t.String(), "failed to run lifecycle on", bck.Cname(""), "err:", rns.Err)
+			}
+		}
+		return false
+	})
+	return hk.DayInterval
+}
+
+func (*target) runLifecycle(uuid string, bck *meta.Bck) xreg.RenewRes {
+	args := &xreg.LcycleArgs{
+		AbortMpt: func(bck *meta.Bck, prefix string, olderThan time.Duration) int {
+			return s3.AbortStale(bck.Bucket(), prefix, olderThan)
+		},
+	}
+	rns := xreg.RenewLifecycle(uuid, bck, args)
+	if rns.Err == nil && !rns.IsRunning() {
+		xact.GoRunW(rns.Entry.Get())
+	}
+	return rns
+}
@@ -0,0 +1,58 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/mirror"
+)
+
+// cluster-level n-way mirror (copies across targets): see mirror/targets.go
+
+// upon deleting (or evicting) an object, its owner removes the remote copies as well
+// (non-owners do nothing)
+func (t *target) delRemoteCopies(lom *core.LOM) {
+	var (
+		smap   = t.owner.smap.get()
+		action = apc.ActDeleteObjects
+	)
+	tsis, err := mirror.Placement(lom, &smap.Smap)
+	if err != nil || tsis[0].ID() != t.SID() {
+		return
+	}
+	if lom.Bck().IsRemote() {
+		action = apc.ActEvictObjects // never delete remote copies from the backend
+	}
+	for _, tsi := range tsis[1:] {
+		cargs := allocCargs()
+		{
+			cargs.si = tsi
+			cargs.req = cmn.HreqArgs{
+				Method: http.MethodDelete,
+				Header: http.Header{
+					apc.HdrCallerID:   []string{t.SID()},
+					apc.HdrCallerName: []string{t.callerName()},
+				},
+				Base:  tsi.URL(cmn.NetIntraControl),
+				Path:  apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+				Query: lom.Bck().NewQuery(),
+				Body:  cos.MustMarshal(apc.ActMsg{Action: action}),
+			}
+			cargs.timeout = cmn.Rom.CplaneOperation()
+		}
+		res := t.call(cargs, smap)
+		if res.err != nil && res.status != http.StatusNotFound {
+			nlog.Warningln(t.String(), "failed to remove", lom.Cname(), "copy from", tsi.StringEx(), "err:", res.err)
+		}
+		freeCargs(cargs)
+		freeCR(res)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -54,6 +55,7 @@ type (
 		config     *cmn.Config   // (during this request)
 		resphdr    http.Header   // as implied
 		workFQN    string        // temp fqn to be renamed
+		sseKeyID   string        // encrypt with a given key (S3 x-amz-server-side-encryption); empty: as per bucket
 		atime      int64         // access time.Now()
 		ltime      int64         // mono.NanoTime, to measure latency
 		size       int64         // aka Content-Length
@@ -272,11 +274,26 @@ func (poi *putOI) finalize() (errCode int, err error) {
 // poi.workFQN => LOM
 func (poi *putOI) fini() (errCode int, err error) {
 	var (
-		lom = poi.lom
-		bck = lom.Bck()
+		lom          = poi.lom
+		bck          = lom.Bck()
+		objLock      = poi.owt < cmn.OwtRebalance && lom.Bprops().ObjLock.Enabled
+		wback        = bck.IsRemote() && poi.owt < cmn.OwtRebalance && lom.Bprops().WriteBack.Enabled
+		usage        *core.BckUsage
+		dsize, dobjs int64
 	)
-	// put remote
-	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
+	// bucket and namespace quotas (enforcing only new content)
+	if usage, dsize, dobjs = poi.t.quotaDelta(lom, lom.SizeOnDisk()); usage != nil && poi.owt < cmn.OwtRebalance {
+		if err = poi.t.chkQuota(lom, usage, dsize, dobjs); err != nil {
+			return http.StatusForbidden, err
+		}
+	}
+	// put remote (unless write-back - see below)
+	if bck.IsRemote() && poi.owt < cmn.OwtRebalance && !wback {
+		if objLock {
+			if err = poi.chkObjLock(false /*locked*/); err != nil {
+				return http.StatusForbidden, err
+			}
+		}
 		errCode, err = poi.putRemote()
 		if err != nil {
 			loghdr := poi.loghdr()
@@ -315,8 +332,21 @@ func (poi *putOI) fini() (errCode int, err error) {
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// object lock (WORM): cannot overwrite; otherwise, apply default retention
+	if objLock {
+		if err = poi.chkObjLock(true /*locked*/); err != nil {
+			return http.StatusForbidden, err
+		}
+		lom.SetDefaultRetention(time.Now())
+	}
+
 	// ais versioning
 	if bck.IsAIS() && lom.VersionConf().Enabled {
+		if retain := lom.Retain(); retain > 0 && poi.owt == cmn.OwtPut && !poi.skipVC {
+			if err = poi.retain(retain); err != nil {
+				return
+			}
+		}
 		if poi.owt < cmn.OwtRebalance {
 			if poi.skipVC {
 				err = lom.IncVersion()
@@ -329,10 +359,20 @@ func (poi *putOI) fini() (errCode int, err error) {
 		}
 	}
 
+	if wback {
+		poi.wbPrune()
+	}
+
 	// done
 	if err = lom.RenameFrom(poi.workFQN); err != nil {
 		return
 	}
+	// write-back: journal only when the new content is in place (and prior to persisting its metadata)
+	if wback {
+		if err = poi.wbJournal(usage, dsize, dobjs); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
@@ -341,17 +381,60 @@ func (poi *putOI) fini() (errCode int, err error) {
 	if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
 		lom.SetAtimeUnix(poi.atime)
 	}
-	err = lom.PersistMain()
+	if err = lom.PersistMain(); err == nil && usage != nil {
+		usage.Add(dsize, dobjs)
+	}
+	if wback {
+		poi.t.wakeWriteBack()
+	}
 	return
 }
 
+// keep the current (about to be overwritten) version, if any; otherwise,
+// continue numbering from the latest retained one (e.g., delete marker)
+func (poi *putOI) retain(retain int) error {
+	var (
+		lom  = poi.lom
+		prev = core.AllocLOM(lom.ObjName)
+	)
+	defer core.FreeLOM(prev)
+	if err := prev.InitBck(lom.Bucket()); err != nil {
+		return err
+	}
+	if err := prev.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			return err
+		}
+		lom.SetVersion(lom.LatestRetained())
+		return nil
+	}
+	lom.SetVersion(prev.Version())
+	return prev.RetainVersion(retain)
+}
+
+// fail to overwrite existing object that is locked (retained or on legal hold)
+func (poi *putOI) chkObjLock(locked bool) error {
+	prev := core.AllocLOM(poi.lom.ObjName)
+	defer core.FreeLOM(prev)
+	if err := prev.InitBck(poi.lom.Bucket()); err != nil {
+		return err
+	}
+	if err := prev.Load(false /*cache it*/, locked); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil
+		}
+		return err
+	}
+	return prev.CheckObjLock(false /*bypass governance*/)
+}
+
 // via backend.PutObj()
 func (poi *putOI) putRemote() (errCode int, err error) {
 	var (
 		lom     = poi.lom
 		backend = poi.t.Backend(lom.Bck())
 	)
-	lmfh, err := cos.NewFileHandle(poi.workFQN)
+	lmfh, err := lom.OpenFQN(poi.workFQN) // (decrypting)
 	if err != nil {
 		err = cmn.NewErrFailedTo(poi.t, "open", poi.workFQN, err)
 		return
@@ -383,6 +466,17 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 	if lmfh, err = poi.lom.CreateFile(poi.workFQN); err != nil {
 		return
 	}
+	// encrypt or write as is (note that checksums always cover plaintext)
+	var (
+		w  io.Writer = lmfh
+		sw *sse.Writer
+	)
+	if sw, err = poi.sseWriter(lmfh); err != nil {
+		return
+	}
+	if sw != nil {
+		w = sw
+	}
 	if poi.size <= 0 {
 		buf, slab = poi.t.gmm.Alloc()
 	} else {
@@ -394,13 +488,13 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(w, poi.r, buf)
 	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf):
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
 		poi.lom.SetCksum(poi.cksumToUse)
 		// (ditto)
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(w, poi.r, buf)
 	default:
 		writers := make([]io.Writer, 0, 3)
 		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
@@ -415,12 +509,17 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 				writers = append(writers, cksums.compt.H)
 			}
 		}
-		writers = append(writers, lmfh)
+		writers = append(writers, w)
 		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), poi.r, buf) // (ditto)
 	}
 	if err != nil {
 		return
 	}
+	if sw != nil {
+		if err = sw.Close(); err != nil {
+			return
+		}
+	}
 
 	// validate
 	if cksums.compt != nil {
@@ -589,6 +688,7 @@ do:
 			return http.StatusInsufficientStorage, cs.Err()
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
+		goi.lom.IncAccessCnt()
 
 		if loaded, err = goi._coldLock(); err != nil {
 			return 0, err
@@ -945,14 +1045,14 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 
 func (goi *getOI) finalize() (errCode int, err error) {
 	var (
-		lmfh *os.File
+		lmfh core.LomHandle
 		hrng *htrange
 		fqn  = goi.lom.FQN
 	)
 	if !goi.cold && !goi.isGFN {
 		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
 	}
-	lmfh, err = os.Open(fqn)
+	lmfh, err = goi.lom.OpenFQN(fqn) // (decrypting if need be)
 	if err != nil {
 		if os.IsNotExist(err) {
 			errCode = http.StatusNotFound
@@ -987,7 +1087,7 @@ ret:
 }
 
 // in particular, setup reader and writer and set headers
-func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange) (errCode int, err error) {
+func (goi *getOI) fini(fqn string, lmfh core.LomHandle, hdr http.Header, hrng *htrange) (errCode int, err error) {
 	var (
 		size   int64
 		reader io.Reader = lmfh
@@ -1083,6 +1183,7 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 			return errSendingResp
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
+		goi.lom.IncAccessCnt()
 		goi.lom.Recache()
 	}
 	//
@@ -1181,10 +1282,13 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, errCode int, err error) {
 		workFQN = a.hdl.workFQN
 	)
 	if workFQN == "" {
+		if ty := a.lom.CksumType(); !cos.IsResumableCksum(ty) {
+			return "", http.StatusNotImplemented, cmn.NewErrUnsupp("append with checksum type "+ty+" to", a.lom.Cname())
+		}
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			_, a.hdl.partialCksum, err = a.lom.CopyPlain(workFQN, buf, a.lom.CksumType())
 			a.lom.Unlock(false)
 			if err != nil {
 				errCode = http.StatusInternalServerError
@@ -1267,7 +1371,11 @@ func (a *apndOI) parse(packedHdl string) error {
 	if err != nil {
 		return err
 	}
-	if err := a.hdl.partialCksum.H.(encoding.BinaryUnmarshaler).UnmarshalBinary(buf); err != nil {
+	unm, ok := a.hdl.partialCksum.H.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return fmt.Errorf("invalid append handle: checksum type %q is not resumable", items[2])
+	}
+	if err := unm.UnmarshalBinary(buf); err != nil {
 		return err
 	}
 
@@ -1407,6 +1515,10 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 	}
 
 	// w-lock the destination unless already locked (above)
+	var (
+		usage        *core.BckUsage
+		dsize, dobjs int64
+	)
 	if !lcopy {
 		dst.Lock(true)
 		defer dst.Unlock(true)
@@ -1417,9 +1529,18 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 		} else if cmn.IsErrBucketNought(err) {
 			return 0, err
 		}
+		// bucket and namespace quotas (compare with poi.fini)
+		if usage, dsize, dobjs = t.quotaDelta(dst, copySizeOnDisk(lom, dst)); usage != nil {
+			if err := t.chkQuota(dst, usage, dsize, dobjs); err != nil {
+				return 0, err
+			}
+		}
 	}
 	dst2, err := lom.Copy2FQN(dst.FQN, coi.Buf)
 	if err == nil {
+		if usage != nil {
+			usage.Add(dsize, dobjs)
+		}
 		size = lom.SizeBytes()
 		if coi.Finalize {
 			t.putMirror(dst2)
@@ -1708,6 +1829,13 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		debug.AssertNoErr(err)
 		debug.Assertf(finfo.Size() == size, "%d != %d", finfo.Size(), size)
 	})
+	// new shard: encrypt as per bucket's SSE config (compare with putOI)
+	// (appending to encrypted shards is not supported - see t.putApndArch)
+	if a.put {
+		if err := a.t.encryptWork(a.lom, fqn); err != nil {
+			return err
+		}
+	}
 	// done
 	if err := a.lom.RenameFrom(fqn); err != nil {
 		return err
@@ -1736,7 +1864,12 @@ func (t *target) putMirror(lom *core.LOM) {
 	if !mconfig.Enabled {
 		return
 	}
-	if mpathCnt := fs.NumAvail(); mpathCnt < int(mconfig.Copies) {
+	if mconfig.OnTargets() {
+		// only the owner replicates (see mirror/targets.go)
+		if _, local, err := lom.HrwTarget(&t.owner.smap.get().Smap); err != nil || !local {
+			return
+		}
+	} else if mpathCnt := fs.NumAvail(); mpathCnt < int(mconfig.Copies) {
 		t.statsT.IncErr(stats.ErrPutMirrorCount)
 		nanotim := mono.NanoTime()
 		if nanotim&0x7 == 7 {
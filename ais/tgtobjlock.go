@@ -0,0 +1,82 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Object lock (WORM): per-object retention and legal hold (see cmn.ObjLockConf and core/lobjlock.go)
+// - enforced in: PUT (overwrite), DELETE, evict, rename, append-to-arch, and LRU;
+// - destroying (or evicting) a bucket fails if any of its objects is locked.
+
+// POST { action: apc.ActSetObjLock } /v1/objects/bucket-name/object-name
+func (t *target) setObjLock(lom *core.LOM, msg *apc.ActMsg) error {
+	olmsg := &apc.ObjLockMsg{}
+	if err := cos.MorphMarshal(msg.Value, olmsg); err != nil {
+		return fmt.Errorf(cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+	}
+	return updObjLock(lom, olmsg)
+}
+
+func updObjLock(lom *core.LOM, olmsg *apc.ObjLockMsg) error {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if olmsg.Retention != nil {
+		if err := lom.SetRetention(olmsg.Retention, olmsg.BypassGovernance); err != nil {
+			return err
+		}
+	}
+	if olmsg.LegalHold != nil {
+		if err := lom.SetLegalHold(*olmsg.LegalHold); err != nil {
+			return err
+		}
+	}
+	return lom.Persist()
+}
+
+// fail to destroy (or evict) a bucket that contains locked objects
+// (walks all local objects - lock-enabled buckets only)
+func chkBckObjLock(bck *meta.Bck) error {
+	if !bck.Props.ObjLock.Enabled {
+		return nil
+	}
+	cb := func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		lom := &core.LOM{}
+		if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+			return nil
+		}
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+			return nil
+		}
+		return lom.CheckObjLock(false /*bypass governance*/)
+	}
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		opts := &fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjectType}, Callback: cb}
+		opts.Bck.Copy(bck.Bucket())
+		if err := fs.Walk(opts); err != nil {
+			if cmn.IsErrObjLocked(err) {
+				return fmt.Errorf("cannot destroy %s: %w", bck, err)
+			}
+			nlog.Warningln(bck.String(), mi.String(), err)
+		}
+	}
+	return nil
+}
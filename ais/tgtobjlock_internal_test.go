@@ -0,0 +1,113 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/readers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Object lock", func() {
+	const (
+		bucket  = "objlock-bck"
+		objName = "locked-obj"
+		ver     = "1"
+	)
+	var lom *core.LOM
+
+	// under w-lock
+	update := func(cb func()) {
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		Expect(lom.Load(false /*cache it*/, true /*locked*/)).NotTo(HaveOccurred())
+		cb()
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+	}
+	delVersion := func(bypass bool) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodDelete, "/"+apc.S3+"/"+bucket+"/"+objName+"?versionId="+ver, http.NoBody)
+		t.delObjVersionS3(w, r, lom, ver, bypass)
+		return w.Code
+	}
+
+	BeforeEach(func() {
+		fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
+		bck := meta.NewBck(bucket, apc.AIS, cmn.NsGlobal)
+		if _, present := t.owner.bmd.get().Get(bck); !present {
+			bmd := t.owner.bmd.get().clone()
+			bmd.add(bck, &cmn.Bprops{
+				Cksum:   cmn.CksumConf{Type: cos.ChecksumNone},
+				ObjLock: cmn.ObjLockConf{Enabled: true},
+			})
+			Expect(t.owner.bmd.putPersist(bmd, nil)).NotTo(HaveOccurred())
+			fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+		}
+		lom = core.AllocLOM(objName)
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		r, _ := readers.NewRand(cos.KiB, cos.ChecksumNone)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       t,
+			lom:     lom,
+			r:       r,
+			workFQN: path.Join(testMountpath, objName+".work"),
+			config:  cmn.GCO.Get(),
+		}
+		_, err := poi.putObject()
+		Expect(err).NotTo(HaveOccurred())
+		update(func() {
+			lom.SetVersion(ver)
+			Expect(lom.SetLegalHold(true)).NotTo(HaveOccurred())
+		})
+	})
+	AfterEach(func() {
+		lom.Uncache()
+		os.Remove(lom.FQN)
+		core.FreeLOM(lom)
+	})
+
+	It("should not allow PATCH to lift legal hold", func() {
+		update(func() {
+			Expect(patchCustom(lom, cos.StrKVs{cmn.LegalHoldObjMD: "OFF"}, false)).To(HaveOccurred())
+			Expect(patchCustom(lom, cos.StrKVs{cmn.SSEKeyObjMD: "forged"}, false)).To(HaveOccurred())
+			Expect(patchCustom(lom, cos.StrKVs{"foo": "bar"}, true /*replace all*/)).NotTo(HaveOccurred())
+		})
+		update(func() {
+			Expect(lom.LegalHold()).To(BeTrue())
+			v, _ := lom.GetCustomKey("foo")
+			Expect(v).To(Equal("bar"))
+			Expect(lom.CheckObjLock(true /*bypass governance*/)).To(HaveOccurred())
+		})
+	})
+
+	It("should not delete locked object by version", func() {
+		Expect(delVersion(true /*bypass governance*/)).To(Equal(http.StatusForbidden))
+		Expect(cos.Stat(lom.FQN)).NotTo(HaveOccurred())
+
+		// governance: deleted only when bypassing
+		update(func() {
+			Expect(lom.SetLegalHold(false)).NotTo(HaveOccurred())
+			until := cmn.FormatRetainUntil(time.Now().Add(time.Hour))
+			Expect(lom.SetRetention(&apc.ObjRetention{Mode: apc.ObjLockGovernance, RetainUntil: until}, false)).NotTo(HaveOccurred())
+		})
+		Expect(delVersion(false)).To(Equal(http.StatusForbidden))
+		Expect(cos.Stat(lom.FQN)).NotTo(HaveOccurred())
+		Expect(delVersion(true)).To(Equal(http.StatusOK))
+		Expect(cos.Stat(lom.FQN)).To(HaveOccurred())
+	})
+})
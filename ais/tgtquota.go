@@ -0,0 +1,287 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// bucket and namespace quotas: see cmn.QuotaConf and core/lquota.go
+// - tracked buckets: buckets with bucket quota and all buckets in namespaces with namespace quota;
+// - local usage is (re)counted periodically and upon tracking a new bucket;
+// - until counted, the usage is unknown and the corresponding quota is not enforced.
+
+const (
+	quotaHKName      = "quota" + hk.NameSuffix
+	quotaIval        = time.Minute
+	quotaRecountIval = time.Hour
+)
+
+type (
+	nsQuota struct {
+		bids    []uint64 // all buckets in the namespace
+		maxSize int64
+		maxObjs int64
+	}
+	quotas struct {
+		ns       map[string]*nsQuota // namespace uname => limits
+		counted  map[uint64]int64    // bucket ID => last counted (mono-time)
+		bmdVer   int64
+		mu       sync.RWMutex
+		counting atomic.Bool
+	}
+)
+
+func (t *target) regQuotaHK() { hk.Reg(quotaHKName, t.quotaHK, quotaIval) }
+
+func (t *target) quotaHK() time.Duration {
+	if t.ClusterStarted() {
+		t.quotas.sync(t.owner.bmd.get())
+		t.countUsage(false /*all*/)
+	}
+	return quotaIval
+}
+
+// given the new content's on-disk size, returns the object's contribution to its bucket's usage
+// (compare with core.LOM.usageRm), and nil usage if the bucket is not tracked
+func (t *target) quotaDelta(lom *core.LOM, size int64) (usage *core.BckUsage, dsize, dobjs int64) {
+	if added := t.quotas.sync(t.owner.bmd.get()); added {
+		go t.countUsage(true /*only new*/)
+	}
+	if usage = core.Usage(lom.Bprops().BID); usage == nil {
+		return
+	}
+	dsize, dobjs = size, 1
+	if finfo, err := os.Lstat(lom.FQN); err == nil { // overwriting
+		dsize -= finfo.Size()
+		dobjs = 0
+	}
+	return
+}
+
+// on-disk size of the object's copy in another bucket
+// (the copy is encrypted iff the destination bucket is - see core.LOM.Copy2FQN)
+func copySizeOnDisk(lom, dst *core.LOM) int64 {
+	encrypted := dst.Bprops().SSE.Enabled
+	if dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/) {
+		encrypted = lom.IsEncrypted()
+	}
+	if encrypted {
+		return sse.EncSize(lom.SizeBytes())
+	}
+	return lom.SizeBytes()
+}
+
+// check bucket and namespace quotas (each target enforces its share)
+func (t *target) chkQuota(lom *core.LOM, usage *core.BckUsage, dsize, dobjs int64) error {
+	if dsize <= 0 && dobjs <= 0 {
+		return nil
+	}
+	var (
+		bck  = lom.Bck()
+		conf = &lom.Bprops().Quota
+		nt   = t.owner.smap.get().CountActiveTs()
+	)
+	if conf.HasBck() {
+		if size, objs, ready := usage.Get(); ready {
+			if err := _chkQuota(bck.Cname(""), size+dsize, objs+dobjs, conf.MaxSize, conf.MaxObjects, nt); err != nil {
+				return err
+			}
+		}
+	}
+
+	q := &t.quotas
+	q.mu.RLock()
+	nq := q.ns[bck.Ns.Uname()]
+	if nq == nil {
+		q.mu.RUnlock()
+		return nil
+	}
+	var nsize, nobjs int64
+	for _, bid := range nq.bids {
+		u := core.Usage(bid)
+		if u == nil {
+			q.mu.RUnlock()
+			return nil
+		}
+		size, objs, ready := u.Get()
+		if !ready {
+			q.mu.RUnlock()
+			return nil
+		}
+		nsize += size
+		nobjs += objs
+	}
+	maxSize, maxObjs := nq.maxSize, nq.maxObjs
+	q.mu.RUnlock()
+	return _chkQuota("namespace "+bck.Ns.String(), nsize+dsize, nobjs+dobjs, maxSize, maxObjs, nt)
+}
+
+func _chkQuota(name string, size, objs, maxSize, maxObjs int64, nt int) error {
+	if maxSize > 0 && size > cmn.QuotaShare(maxSize, nt) {
+		return cmn.NewErrQuotaExceeded(name, "size", maxSize)
+	}
+	if maxObjs > 0 && objs > cmn.QuotaShare(maxObjs, nt) {
+		return cmn.NewErrQuotaExceeded(name, "objects", maxObjs)
+	}
+	return nil
+}
+
+// (re)count local usage of the tracked buckets: only those that were never counted
+// or else, those that were counted more than quotaRecountIval ago
+func (t *target) countUsage(onlyNew bool) {
+	q := &t.quotas
+	if !q.counting.CAS(false, true) {
+		return
+	}
+	defer q.counting.Store(false)
+
+	var (
+		bmd  = t.owner.bmd.get()
+		now  = mono.NanoTime()
+		todo = make([]*meta.Bck, 0, 4)
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bid := bck.Props.BID
+		if core.Usage(bid) == nil {
+			return false
+		}
+		q.mu.RLock()
+		last, ok := q.counted[bid]
+		q.mu.RUnlock()
+		if !ok || (!onlyNew && time.Duration(now-last) > quotaRecountIval) {
+			todo = append(todo, bck)
+		}
+		return false
+	})
+	for _, bck := range todo {
+		size, objs, err := countBck(bck)
+		if err != nil {
+			nlog.Errorln(t.String(), "failed to count", bck.Cname(""), "usage:", err)
+			continue
+		}
+		if u := core.Usage(bck.Props.BID); u != nil {
+			u.Set(size, objs)
+			q.mu.Lock()
+			q.counted[bck.Props.BID] = mono.NanoTime()
+			q.mu.Unlock()
+		}
+	}
+}
+
+// local usage: objects at their HRW locations (i.e., not counting mirrored copies)
+func countBck(bck *meta.Bck) (size, objs int64, err error) {
+	cb := func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		lom := core.AllocLOM("")
+		hrw := lom.InitFQN(fqn, bck.Bucket()) == nil && lom.IsHRW()
+		core.FreeLOM(lom)
+		if !hrw {
+			return nil
+		}
+		if finfo, err := os.Lstat(fqn); err == nil {
+			size += finfo.Size()
+			objs++
+		}
+		return nil
+	}
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{Mi: mi, Bck: bck.Clone(), CTs: []string{fs.ObjectType}, Callback: cb}
+		if err = fs.Walk(opts); err != nil && !os.IsNotExist(err) {
+			return
+		}
+		err = nil
+	}
+	return
+}
+
+////////////
+// quotas //
+////////////
+
+// sync with BMD: namespace limits and tracked buckets; returns true if new buckets are tracked
+func (q *quotas) sync(bmd *bucketMD) (added bool) {
+	q.mu.RLock()
+	same := q.bmdVer == bmd.Version
+	q.mu.RUnlock()
+	if same {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.bmdVer == bmd.Version {
+		return
+	}
+	ns := make(map[string]*nsQuota, 2)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		conf := &bck.Props.Quota
+		if !conf.HasNs() {
+			return false
+		}
+		uname := bck.Ns.Uname()
+		nq, ok := ns[uname]
+		if !ok {
+			nq = &nsQuota{}
+			ns[uname] = nq
+		}
+		nq.maxSize = _minLimit(nq.maxSize, conf.NsMaxSize)
+		nq.maxObjs = _minLimit(nq.maxObjs, conf.NsMaxObjects)
+		return false
+	})
+	tracked := make(map[uint64]struct{}, 4)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bid := bck.Props.BID
+		nq := ns[bck.Ns.Uname()]
+		if nq != nil {
+			nq.bids = append(nq.bids, bid)
+		}
+		if nq != nil || bck.Props.Quota.HasBck() {
+			tracked[bid] = struct{}{}
+			if _, ok := core.TrackUsage(bid); ok {
+				added = true
+			}
+		}
+		return false
+	})
+	if q.counted == nil {
+		q.counted = make(map[uint64]int64, len(tracked))
+	}
+	core.RangeUsage(func(bid uint64, _ *core.BckUsage) bool {
+		if _, ok := tracked[bid]; !ok {
+			core.UntrackUsage(bid)
+			delete(q.counted, bid)
+		}
+		return true
+	})
+	q.ns, q.bmdVer = ns, bmd.Version
+	return added
+}
+
+// the smallest non-zero
+func _minLimit(a, b int64) int64 {
+	switch {
+	case a == 0:
+		return b
+	case b == 0:
+		return a
+	default:
+		return min(a, b)
+	}
+}
@@ -34,6 +34,12 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.Method == http.MethodOptions {
+		s3Preflight(w, r, t.owner.bmd, apiItems)
+		return
+	}
+	s3CORS(w, r, t.owner.bmd, apiItems)
+
 	switch r.Method {
 	case http.MethodHead:
 		t.headObjS3(w, r, apiItems)
@@ -44,15 +50,19 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMptUpload(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging):
+			t.delObjTaggingS3(w, r, apiItems)
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
 		t.postObjS3(w, r, apiItems)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost,
+			http.MethodOptions)
 	}
 }
 
@@ -83,6 +93,12 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 			}
 			t.putMptPart(w, r, items, q, bck)
 		}
+	case q.Has(s3.QparamTagging):
+		t.putObjTaggingS3(w, r, bck, items)
+	case q.Has(s3.QparamRetention):
+		t.putObjRetentionS3(w, r, bck, items)
+	case q.Has(s3.QparamLegalHold):
+		t.putObjLegalHoldS3(w, r, bck, items)
 	case r.Header.Get(cos.S3HdrObjSrc) == "":
 		t.putObjS3(w, r, bck, config, items)
 	default:
@@ -131,6 +147,12 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	// replace user-defined metadata and/or tags (nil custom: copy as is)
+	custom, prefixes, err := s3.FromCopyHdr(lom.GetCustomMD(), r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 	// dst
 	bckTo, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
 	if err != nil {
@@ -158,6 +180,12 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 		}
 		return
 	}
+	if custom != nil {
+		if err := t.replaceCustomS3(bckTo, s3.ObjName(items), custom, prefixes); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
 
 	var cksumValue string
 	if cksum := lom.Checksum(); cksum.Type() == cos.ChecksumMD5 {
@@ -192,34 +220,77 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 			return
 		}
 	}
-	started := time.Now()
-	lom.SetAtimeUnix(started.UnixNano())
-
-	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
-
 	dpq := dpqAlloc()
 	defer dpqFree(dpq)
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+
+	// load (maybe) to keep incrementing ais versions
+	skipVC := cmn.Rom.Features().IsSet(feat.SkipVC) || cos.IsParseBool(dpq.skipVC) // apc.QparamSkipVC
+	if !skipVC {
+		_ = lom.Load(true, false)
+	}
+	started := time.Now()
+	lom.SetAtimeUnix(started.UnixNano())
+
+	// x-amz-meta-* and x-amz-tagging (replacing the existing ones, if any)
+	custom, err := s3.FromPutHdr(nil, r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	// x-amz-object-lock-* (retention and legal hold)
+	if custom, err = s3.ObjLockFromHdr(custom, r.Header, bck.Props.ObjLock.Enabled); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	lom.SetCustomMD(custom)
+
+	// x-amz-server-side-encryption (empty: as per bucket's SSE config)
+	sseKeyID, err := s3.SSEKeyFromHdr(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	// x-amz-checksum-* (validated and stored upon receiving the entire content)
+	cksumReader, err := s3.NewCksumReader(r.Body, r.Header, lom.ObjAttrs())
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	if cksumReader != nil {
+		r.Body = cksumReader
+	}
+
+	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
+
 	poi := allocPOI()
 	{
 		poi.atime = started.UnixNano()
 		poi.t = t
 		poi.lom = lom
 		poi.config = config
-		poi.skipVC = cmn.Rom.Features().IsSet(feat.SkipVC) || cos.IsParseBool(dpq.skipVC) // apc.QparamSkipVC
+		poi.skipVC = skipVC
 		poi.restful = true
+		poi.sseKeyID = sseKeyID
 	}
 	errCode, err := poi.do(nil /*response hdr*/, r, dpq)
 	freePOI(poi)
 	if err != nil {
+		if cksumReader != nil && cksumReader.Err() != nil {
+			s3.WriteErr(w, r, cksumReader.Err(), http.StatusBadRequest)
+			return
+		}
 		t.fsErr(err, lom.FQN)
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
 	s3.SetETag(w.Header(), lom)
+	s3.SetVersionHdr(w.Header(), lom)
+	s3.SetSSEHdr(w.Header(), lom.GetCustomMD())
 }
 
 // GET s3/<bucket-name[/<object-name>]
@@ -238,11 +309,23 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listMptUploads(w, bck, q)
 		return
 	}
+	if len(items) == 1 && q.Has(s3.QparamVersions) {
+		t.listObjVersionsS3(w, r, bck, q)
+		return
+	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
 	objName := s3.ObjName(items)
+	if q.Has(s3.QparamTagging) {
+		t.getObjTaggingS3(w, r, bck, objName)
+		return
+	}
+	if q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold) {
+		t.getObjLockS3(w, r, bck, objName, q.Has(s3.QparamRetention))
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		if cmn.Rom.FastV(5, cos.SmoduleS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, q)
@@ -269,6 +352,19 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infoln("getObject", lom.String(), dpq)
 	}
+	if dpq.version != "" && t.getObjVersion(w, r, bck, lom, dpq.version, true /*s3*/) {
+		core.FreeLOM(lom)
+		dpqFree(dpq)
+		return
+	}
+	// user-defined metadata and version (must be set prior to transmitting the object)
+	if err := lom.InitBck(bck.Bucket()); err == nil && lom.Load(true /*cache it*/, false /*locked*/) == nil {
+		s3.SetMetaHeaders(w.Header(), lom.GetCustomMD())
+		s3.SetVersionHdr(w.Header(), lom)
+		s3.SetSSEHdr(w.Header(), lom.GetCustomMD())
+		s3.SetCksumHdrs(w.Header(), r.Header, lom.GetCustomMD())
+		s3.SetObjLockHdr(w.Header(), lom.GetCustomMD())
+	}
 	t.getObject(w, r, dpq, bck, lom)
 	s3.SetETag(w.Header(), lom) // add etag/md5
 	core.FreeLOM(lom)
@@ -296,6 +392,24 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	}
 	exists := true
 	err = lom.Load(true /*cache it*/, false /*locked*/)
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" && bck.IsAIS() && (err != nil || lom.Version() != ver) {
+		// HEAD a given retained version
+		vlom, errV := lom.LoadVersion(ver)
+		if errV != nil {
+			s3.WriteErr(w, r, cos.NewErrNotFound(t, lom.Cname()+" version "+ver), 0)
+			return
+		}
+		marker := vlom.IsDeleteMarker()
+		lom.CopyAttrs(vlom.ObjAttrs(), false)
+		core.FreeLOM(vlom)
+		if marker {
+			w.Header().Set(cos.S3HdrDeleteMarker, "true")
+			w.Header().Set(cos.S3VersionHeader, ver)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		err = nil
+	}
 	if err != nil {
 		exists = false
 		if !cos.IsNotExist(err, 0) {
@@ -334,6 +448,13 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	if v, ok := custom[cos.HdrContentType]; ok {
 		hdr.Set(cos.HdrContentType, v)
 	}
+	s3.SetMetaHeaders(hdr, custom)
+	s3.SetSSEHdr(hdr, custom)
+	s3.SetCksumHdrs(hdr, r.Header, custom)
+	s3.SetObjLockHdr(hdr, custom)
+	if exists {
+		s3.SetVersionHdr(hdr, lom)
+	}
 	// e.g. https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html#API_HeadObject_Examples
 	// (compare w/ `p.listObjectsS3()`
 	lastModified := cos.FormatNanoTime(op.Atime, cos.RFC1123GMT)
@@ -361,16 +482,26 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	errCode, err = t.DeleteObject(lom, false)
+	bypass := cos.IsParseBool(r.Header.Get(cos.S3HdrBypassGovernance))
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" {
+		t.delObjVersionS3(w, r, lom, ver, bypass)
+		return
+	}
+	errCode, err = t.delObject(lom, false /*evict*/, bypass)
 	if err != nil {
 		name := lom.Cname()
 		if errCode == http.StatusNotFound {
 			s3.WriteErr(w, r, cos.NewErrNotFound(t, name), http.StatusNotFound)
 		} else {
-			s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %v", name, err), errCode)
+			s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %w", name, err), errCode)
 		}
 		return
 	}
+	if lom.Retain() > 0 {
+		hdr := w.Header()
+		hdr.Set(cos.S3HdrDeleteMarker, "true")
+		hdr.Set(cos.S3VersionHeader, lom.Version())
+	}
 	// EC cleanup if EC is enabled
 	ec.ECM.CleanupObject(lom)
 }
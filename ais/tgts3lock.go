@@ -0,0 +1,100 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object retention (`?retention`) and legal hold (`?legal-hold`)
+// (see s3/objlock.go and tgtobjlock.go)
+
+// GET /s3/<bucket-name>/<object-name>?retention|legal-hold
+func (t *target) getObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, retention bool) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	sgl := t.gmm.NewSGL(0)
+	defer sgl.Free()
+	if retention {
+		resp := s3.NewRetention(lom.GetCustomMD())
+		if resp == nil {
+			s3.WriteErr(w, r, s3.NewErrNoSuchConfig(bck.Name, s3.NoRetention), http.StatusNotFound)
+			return
+		}
+		resp.MustMarshal(sgl)
+	} else {
+		s3.NewLegalHold(lom.GetCustomMD()).MustMarshal(sgl)
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+}
+
+// PUT /s3/<bucket-name>/<object-name>?retention
+func (t *target) putObjRetentionS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, items []string) {
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	retention := &s3.Retention{}
+	if err := xml.NewDecoder(r.Body).Decode(retention); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	olmsg := &apc.ObjLockMsg{
+		Retention:        retention.ToMsg(),
+		BypassGovernance: cos.IsParseBool(r.Header.Get(cos.S3HdrBypassGovernance)),
+	}
+	t.updObjLockS3(w, r, bck, s3.ObjName(items), olmsg)
+}
+
+// PUT /s3/<bucket-name>/<object-name>?legal-hold
+func (t *target) putObjLegalHoldS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, items []string) {
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	hold := &s3.LegalHold{}
+	if err := xml.NewDecoder(r.Body).Decode(hold); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	on, err := hold.IsOn()
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	t.updObjLockS3(w, r, bck, s3.ObjName(items), &apc.ObjLockMsg{LegalHold: &on})
+}
+
+func (t *target) updObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, olmsg *apc.ObjLockMsg) {
+	if !bck.Props.ObjLock.Enabled {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(bck.Name, s3.NoObjLock), http.StatusBadRequest)
+		return
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := updObjLock(lom, olmsg); err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
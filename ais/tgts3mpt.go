@@ -95,9 +95,20 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		return
 	}
 
+	// x-amz-checksum-*
+	cksumReader, err := s3.NewCksumReader(r.Body, r.Header, nil)
+	if err != nil {
+		cos.Close(fh)
+		if nerr := cos.RemoveFile(wfqn); nerr != nil {
+			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
+		}
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 	var (
-		buf, slab = t.gmm.Alloc()
-		cksumMD5  = cos.NewCksumHash(cos.ChecksumMD5)
+		body      io.Reader = r.Body
+		buf, slab           = t.gmm.Alloc()
+		cksumMD5            = cos.NewCksumHash(cos.ChecksumMD5)
 		cksumSHA  *cos.CksumHash
 		partSHA   string
 		mwriter   io.Writer
@@ -108,14 +119,21 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 	} else {
 		mwriter = io.MultiWriter(cksumMD5.H, fh)
 	}
-	size, err := io.CopyBuffer(mwriter, r.Body, buf)
+	if cksumReader != nil {
+		body = cksumReader
+	}
+	size, err := io.CopyBuffer(mwriter, body, buf)
 	cos.Close(fh)
 	slab.Free(buf)
 	if err != nil {
 		if nerr := cos.RemoveFile(wfqn); nerr != nil {
 			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
 		}
-		s3.WriteErr(w, r, err, 0)
+		errCode := 0
+		if cksumReader != nil && cksumReader.Err() != nil {
+			errCode = http.StatusBadRequest
+		}
+		s3.WriteErr(w, r, err, errCode)
 		return
 	}
 	cksumMD5.Finalize()
@@ -136,11 +154,19 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		Size: size,
 		Num:  partNum,
 	}
+	if cksumReader != nil {
+		npart.Cksums.FromCustom(cksumReader.Cksums())
+	}
 	if err := s3.AddPart(uploadID, npart); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
 	w.Header().Set(cos.S3CksumHeader, cksumMD5.Value()) // s3cmd checks this one
+	if cksumReader != nil {
+		for k, v := range cksumReader.Cksums() {
+			w.Header().Set(k, v)
+		}
+	}
 }
 
 // Initialize multipart upload.
@@ -156,7 +182,7 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 	}
 
 	uploadID := cos.GenUUID()
-	s3.InitUpload(uploadID, bck.Name, objName)
+	s3.InitUpload(uploadID, bck.Bucket(), objName)
 	result := &s3.InitiateMptUploadResult{Bucket: bck.Name, Key: objName, UploadID: uploadID}
 
 	sgl := t.gmm.NewSGL(0)
@@ -213,6 +239,11 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	cksums, err := s3.MptCksums(partList.Parts, nparts)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 	// 3. append all parts and, separately, their respective MD5s
 	buf, slab := t.gmm.Alloc()
 	defer slab.Free(buf)
@@ -263,6 +294,9 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	lom.SetSize(size)
 	lom.SetCustomKey(cmn.ETag, objETag)
 	lom.SetCksum(actualMD5.Cksum.Clone())
+	for k, v := range cksums {
+		lom.SetCustomKey(k, v)
+	}
 	t.FinalizeObj(lom, objWorkfile, nil) // locks inside
 
 	// 6. mpt state => xattr
@@ -271,6 +305,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 
 	// 7. respond
 	result := &s3.CompleteMptUploadResult{Bucket: bck.Name, Key: objName, ETag: objETag}
+	result.FromCustom(cksums)
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -375,7 +410,7 @@ func (t *target) getMptPart(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	if err != nil {
 		s3.WriteErr(w, r, err, status)
 	}
-	fh, err := os.Open(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -0,0 +1,164 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object tagging (`?tagging`) and CopyObject metadata directives
+// (tags and user-defined metadata are kept in LOM custom metadata - see s3/tagging.go)
+
+// GET /s3/<bucket-name>/<object-name>?tagging
+func (t *target) getObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tagging := s3.GetTagging(lom.GetCustomMD())
+	sgl := t.gmm.NewSGL(0)
+	tagging.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+func (t *target) putObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, items []string) {
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tagging := &s3.Tagging{}
+	if err := xml.Unmarshal(b, tagging); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	tags, err := tagging.ToKVs()
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	t.updateTagsS3(w, r, bck, s3.ObjName(items), tags)
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+func (t *target) delObjTaggingS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	if t.updateTagsS3(w, r, bck, s3.ObjName(items), nil) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (t *target) updateTagsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, tags cos.StrKVs) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	lom.SetCustomMD(s3.SetTags(cloneCustom(lom.GetCustomMD()), tags))
+	if err := lom.Persist(); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// CopyObject with `x-amz-metadata-directive` and/or `x-amz-tagging-directive` set to REPLACE:
+// replace destination's user-defined metadata and tags (only) locally or, if the destination
+// belongs to another target, via intra-cluster PATCH (see `httpobjpatch`)
+func (t *target) replaceCustomS3(bckTo *meta.Bck, objName string, custom cos.StrKVs, prefixes []string) error {
+	smap := t.owner.smap.get()
+	tsi, err := smap.HrwName2T(bckTo.MakeUname(objName))
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == t.SID() {
+		lom := core.AllocLOM(objName)
+		defer core.FreeLOM(lom)
+		if err := lom.InitBck(bckTo.Bucket()); err != nil {
+			return err
+		}
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return err
+		}
+		if err := patchCustom(lom, custom, true /*delOldSetNew*/, prefixes...); err != nil {
+			return err
+		}
+		return lom.Persist()
+	}
+
+	q := bckTo.Bucket().AddToQuery(make(url.Values, 4))
+	q.Set(apc.QparamNewCustom, "true")
+	q[apc.QparamCustomPrefix] = prefixes
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPatch,
+			Header: http.Header{
+				apc.HdrCallerID:    []string{t.SID()},
+				apc.HdrCallerName:  []string{t.callerName()},
+				cos.HdrContentType: []string{cos.ContentJSON},
+			},
+			Base:  tsi.URL(cmn.NetIntraControl),
+			Path:  apc.URLPathObjects.Join(bckTo.Name, objName),
+			Query: q,
+			Body:  cos.MustMarshal(apc.ActMsg{Value: custom}),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	err = res.err
+	freeCargs(cargs)
+	freeCR(res)
+	return err
+}
+
+func cloneCustom(custom cos.StrKVs) cos.StrKVs {
+	out := make(cos.StrKVs, len(custom)+2)
+	for k, v := range custom {
+		out[k] = v
+	}
+	return out
+}
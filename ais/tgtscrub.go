@@ -0,0 +1,80 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// periodic EC scrubbing: see cmn.ECConf.ScrubInterval and ec/bckscrubxact.go
+
+const (
+	ecScrubHKName = "ec-scrub" + hk.NameSuffix
+	ecScrubIval   = 10 * time.Minute
+)
+
+func (t *target) regECScrubHK() {
+	last := make(map[string]int64, 4) // bucket => last started (mono-time); accessed only by the housekeeper
+	hk.Reg(ecScrubHKName, func() time.Duration { return t.ecScrubHK(last) }, ecScrubIval)
+}
+
+// visits all erasure coded buckets that have periodic scrubbing enabled
+func (t *target) ecScrubHK(last map[string]int64) time.Duration {
+	if !t.ClusterStarted() {
+		return ecScrubIval
+	}
+	var (
+		bmd = t.owner.bmd.get()
+		now = mono.NanoTime()
+		all = make(map[string]struct{}, len(last))
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		ecConf := &bck.Props.EC
+		if !ecConf.Enabled || ecConf.ScrubInterval == 0 {
+			return false
+		}
+		uname := bck.MakeUname("")
+		all[uname] = struct{}{}
+		started, ok := last[uname]
+		switch {
+		case !ok:
+			last[uname] = now // first time around: start counting from now
+		case time.Duration(now-started) >= ecConf.ScrubInterval.D():
+			if rns := t.runECScrub(cos.GenUUID(), bck); rns.Err != nil {
+				nlog.Errorln(t.String(), "failed to run ec-scrub on", bck.Cname(""), "err:", rns.Err)
+			} else {
+				last[uname] = now
+			}
+		}
+		return false
+	})
+	// forget buckets that are gone or no longer scrubbed
+	for uname := range last {
+		if _, ok := all[uname]; !ok {
+			delete(last, uname)
+		}
+	}
+	return ecScrubIval
+}
+
+func (t *target) runECScrub(uuid string, bck *meta.Bck) xreg.RenewRes {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActECScrub); err != nil {
+		return xreg.RenewRes{Err: err}
+	}
+	rns := xreg.RenewECScrub(bck, uuid)
+	if rns.Err == nil && !rns.IsRunning() {
+		xact.GoRunW(rns.Entry.Get())
+	}
+	return rns
+}
@@ -0,0 +1,94 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Server-side encryption at rest - see cmn.SSEConf, cmn/sse, and core/lsse.go
+
+// returns encrypting writer iff new content is to be encrypted
+// (and updates object's custom metadata accordingly)
+func (poi *putOI) sseWriter(lmfh io.Writer) (*sse.Writer, error) {
+	var (
+		lom   = poi.lom
+		keyID = poi.sseKeyID
+	)
+	if keyID == "" {
+		var err error
+		if keyID, err = lom.SSEKeyToUse(); err != nil {
+			return nil, cmn.NewErrFailedTo(poi.t, "get encryption key ID for", lom.Cname(), err)
+		}
+	}
+	if keyID == "" {
+		lom.ObjAttrs().DelCustomKeys(cmn.SSEKeyObjMD)
+		return nil, nil
+	}
+	key, err := sse.Key(keyID)
+	if err != nil {
+		return nil, cmn.NewErrFailedTo(poi.t, "get encryption key for", lom.Cname(), err)
+	}
+	lom.SetCustomKey(cmn.SSEKeyObjMD, keyID)
+	return sse.NewWriter(lmfh, key)
+}
+
+// encrypt (plaintext) workfile that was written by other than `poi.write` -
+// e.g., archive or multipart upload - as per bucket's SSE config
+func (t *target) encryptWork(lom *core.LOM, workFQN string) error {
+	lom.ObjAttrs().DelCustomKeys(cmn.SSEKeyObjMD)
+	keyID, err := lom.SSEKeyToUse()
+	if err != nil || keyID == "" {
+		return err
+	}
+	key, err := sse.Key(keyID)
+	if err != nil {
+		return cmn.NewErrFailedTo(t, "get encryption key for", lom.Cname(), err)
+	}
+	src, err := os.Open(workFQN)
+	if err != nil {
+		return err
+	}
+	var (
+		sw     *sse.Writer
+		encFQN = fs.CSM.Gen(lom, fs.WorkfileType, "sse")
+	)
+	dst, err := lom.CreateFile(encFQN)
+	if err != nil {
+		cos.Close(src)
+		return err
+	}
+	if sw, err = sse.NewWriter(dst, key); err == nil {
+		buf, slab := t.gmm.AllocSize(lom.SizeBytes())
+		_, err = io.CopyBuffer(sw, src, buf)
+		slab.Free(buf)
+		if err == nil {
+			err = sw.Close()
+		}
+	}
+	cos.Close(src)
+	if errC := dst.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(encFQN, workFQN)
+	}
+	if err != nil {
+		if errRemove := cos.RemoveFile(encFQN); errRemove != nil {
+			nlog.Errorf(fmtNested, t, err, "remove", encFQN, errRemove)
+		}
+		return cmn.NewErrFailedTo(t, "encrypt", lom.Cname(), err)
+	}
+	lom.SetCustomKey(cmn.SSEKeyObjMD, keyID)
+	return nil
+}
@@ -0,0 +1,75 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// tiered storage: see cmn.TierConf, core/ltier.go, and xact/xs/tiermigrate.go
+
+const (
+	tierHKName = "tier-migrate" + hk.NameSuffix
+	tierIval   = time.Hour
+)
+
+func (t *target) regTierHK() { hk.Reg(tierHKName, t.tierHK, tierIval) }
+
+// visits all buckets that have tiering enabled (provided this target has cold mountpaths)
+func (t *target) tierHK() time.Duration {
+	if !t.ClusterStarted() || !fs.Tiered() {
+		return tierIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.Tier.Enabled {
+			if rns := t.runTierMigrate(cos.GenUUID(), bck); rns.Err != nil {
+				nlog.Errorln(t.String(), "failed to run tier-migrate on", bck.Cname(""), "err:", rns.Err)
+			}
+		}
+		return false
+	})
+	return tierIval
+}
+
+func (t *target) runTierMigrate(uuid string, bck *meta.Bck) xreg.RenewRes {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActTierMigrate); err != nil {
+		return xreg.RenewRes{Err: err}
+	}
+	rns := xreg.RenewTierMigrate(uuid, bck)
+	if rns.Err == nil && !rns.IsRunning() {
+		xact.GoRunW(rns.Entry.Get())
+	}
+	return rns
+}
+
+// returns true when tiering configuration changes in a way that requires moving objects
+func _reTier(bprops, nprops *cmn.Bprops) bool {
+	if !bprops.Tier.Enabled && !nprops.Tier.Enabled {
+		return false
+	}
+	return bprops.Tier != nprops.Tier
+}
+
+// upon changing bucket's tiering configuration (compare with _reMirror and _reEC);
+// NOTE: tier-migrate that may be running with the previous configuration gets aborted (see tmgFactory)
+func (t *target) reTier(bck *meta.Bck) {
+	if !fs.Tiered() {
+		return
+	}
+	if rns := t.runTierMigrate(cos.GenUUID(), bck); rns.Err != nil {
+		nlog.Errorln(t.String(), "failed to run tier-migrate on", bck.Cname(""), "err:", rns.Err)
+	}
+}
@@ -20,12 +20,12 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
-	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/xact"
@@ -285,7 +285,7 @@ func (t *target) validateMakeNCopies(bck *meta.Bck, msg *aisMsg) (curCopies, new
 	curCopies = bck.Props.Mirror.Copies
 	newCopies, err = _parseNCopies(msg.Value)
 	if err == nil {
-		err = fs.ValidateNCopies(t.si.Name(), int(newCopies))
+		err = mirror.ValidateNCopies(t.si.Name(), int(newCopies), &bck.Props.Mirror)
 	}
 	// (consider adding "force" option similar to CopyBckMsg.Force)
 	if err == nil {
@@ -357,10 +357,22 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 			xact.GoRunW(xctn)
 			xid = xctn.ID()
 		}
+		if _reTier(bprops, nprops) {
+			t.reTier(c.bck)
+		}
 		if _, reec := _reEC(bprops, nprops, c.bck, nil /*smap*/); reec {
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}
-			xreg.DoAbort(flt, errors.New("re-ec"))
-			rns := xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
+			var (
+				rns  xreg.RenewRes
+				rerr = errors.New("re-ec")
+			)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}, rerr)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: c.bck}, rerr)
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECScrub, Bck: c.bck}, rerr) // (the layout is about to change)
+			if bprops.EC.Enabled {
+				rns = xreg.RenewECReencode(c.bck, c.uuid)
+			} else {
+				rns = xreg.RenewECEncode(c.bck, c.uuid, apc.ActCommit)
+			}
 			if rns.Err != nil {
 				return "", rns.Err
 			}
@@ -393,10 +405,17 @@ func (t *target) validateNprops(bck *meta.Bck, msg *aisMsg) (nprops *cmn.Bprops,
 	}
 	err = cs.Err()
 	if nprops.Mirror.Enabled {
-		mpathCount := fs.NumAvail()
-		if int(nprops.Mirror.Copies) > mpathCount {
-			err = fmt.Errorf(fmtErrInsuffMpaths1, t, mpathCount, bck, nprops.Mirror.Copies)
-			return
+		if nprops.Mirror.OnTargets() {
+			if tcnt := t.owner.smap.get().CountActiveTs(); int(nprops.Mirror.Copies) > tcnt {
+				err = fmt.Errorf(fmtErrInsuffTargets, t, tcnt, bck, nprops.Mirror.Copies)
+				return
+			}
+		} else {
+			mpathCount := fs.NumAvail()
+			if int(nprops.Mirror.Copies) > mpathCount {
+				err = fmt.Errorf(fmtErrInsuffMpaths1, t, mpathCount, bck, nprops.Mirror.Copies)
+				return
+			}
 		}
 		if nprops.Mirror.Copies < bck.Props.Mirror.Copies {
 			err = nil
@@ -506,8 +525,8 @@ func (t *target) validateBckRenTxn(bckFrom, bckTo *meta.Bck, msg *aisMsg) error
 }
 
 func etlDP(msg *apc.TCBMsg) (core.DP, error) {
-	if !k8s.IsK8s() {
-		return nil, k8s.ErrK8sRequired
+	if err := etl.Supported(); err != nil {
+		return nil, err
 	}
 	if err := msg.Validate(true); err != nil {
 		return nil, err
@@ -885,6 +904,14 @@ func (t *target) beginRm(c *txnSrv) error {
 func (t *target) destroyBucket(c *txnSrv) error {
 	switch c.phase {
 	case apc.ActBegin:
+		if err := c.bck.Init(t.owner.bmd); err == nil {
+			if err := chkBckObjLock(c.bck); err != nil {
+				return err
+			}
+			if err := chkBckWriteBack(c.bck); err != nil {
+				return err
+			}
+		}
 		nlp := newBckNLP(c.bck)
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBusy("bucket", c.bck, "")
@@ -0,0 +1,215 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Retained (previous) versions of ais:// objects: versioned GET (native and S3),
+// S3 DELETE ?versionId, and ListObjectVersions - see also core/lversion.go
+
+// GET a given version (apc.QparamObjVersion or s3.QparamVersionID)
+// returns false when the requested version is the current one (to be handled by the regular GET)
+func (t *target) getObjVersion(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lom *core.LOM, ver string, s3api bool) bool {
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t._errver(w, r, err, 0, s3api)
+		return true
+	}
+	if !bck.IsAIS() {
+		t._errver(w, r, fmt.Errorf("%s: GET by version is only supported for ais:// buckets", bck), http.StatusNotImplemented, s3api)
+		return true
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil && lom.Version() == ver {
+		return false
+	}
+	vlom, err := lom.LoadVersion(ver)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = cos.NewErrNotFound(t, lom.Cname()+" version "+ver)
+		}
+		t._errver(w, r, err, 0, s3api)
+		return true
+	}
+	defer core.FreeLOM(vlom)
+
+	hdr := w.Header()
+	if vlom.IsDeleteMarker() {
+		hdr.Set(cos.S3HdrDeleteMarker, "true")
+		hdr.Set(cos.S3VersionHeader, ver)
+		t._errver(w, r, fmt.Errorf("%s version %s is a delete marker", lom.Cname(), ver), http.StatusMethodNotAllowed, s3api)
+		return true
+	}
+	fh, err := vlom.NewHandle()
+	if err != nil {
+		t._errver(w, r, err, 0, s3api)
+		return true
+	}
+	if s3api {
+		s3.SetMetaHeaders(hdr, vlom.GetCustomMD())
+		s3.SetETag(hdr, vlom)
+		s3.SetVersionHdr(hdr, vlom)
+		s3.SetSSEHdr(hdr, vlom.GetCustomMD())
+		hdr.Set(cos.HdrContentLength, strconv.FormatInt(vlom.SizeBytes(), 10))
+	} else {
+		cmn.ToHeader(vlom, hdr)
+	}
+	buf, slab := t.gmm.AllocSize(vlom.SizeBytes())
+	written, err := io.CopyBuffer(w, fh, buf)
+	slab.Free(buf)
+	cos.Close(fh)
+	if err != nil {
+		t.statsT.IncErr(stats.GetCount)
+		return true // (too late to write error)
+	}
+	t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.GetCount, Value: 1},
+		cos.NamedVal64{Name: stats.GetThroughput, Value: written},
+	)
+	return true
+}
+
+func (t *target) _errver(w http.ResponseWriter, r *http.Request, err error, code int, s3api bool) {
+	if s3api {
+		s3.WriteErr(w, r, err, code)
+	} else {
+		t.writeErr(w, r, err, code)
+	}
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?versionId=<ver>
+// permanently removes a given version (including delete marker) unless locked (see CheckObjLock);
+// if the version in question is the latest, the previous one (if any) becomes current
+func (t *target) delObjVersionS3(w http.ResponseWriter, r *http.Request, lom *core.LOM, ver string, bypassGovernance bool) {
+	if !lom.Bck().IsAIS() {
+		s3.WriteErr(w, r, fmt.Errorf("%s: DELETE by version is only supported for ais:// buckets", lom.Bck()),
+			http.StatusNotImplemented)
+		return
+	}
+	var (
+		err    error
+		marker bool
+	)
+	lom.Lock(true)
+	errLoad := lom.Load(false /*cache it*/, true /*locked*/)
+	if errLoad == nil && lom.Version() == ver {
+		if err = lom.CheckObjLock(bypassGovernance); err == nil {
+			if err = lom.Remove(); err == nil {
+				err = lom.RestoreLatest()
+			}
+		}
+	} else {
+		if vlom, errV := lom.LoadVersion(ver); errV == nil {
+			marker = vlom.IsDeleteMarker()
+			err = vlom.CheckObjLock(bypassGovernance)
+			core.FreeLOM(vlom)
+		}
+		if err == nil {
+			err = lom.DelVersion(ver)
+		}
+		if err == nil && errLoad != nil {
+			err = lom.RestoreLatest()
+		}
+	}
+	lom.Unlock(true)
+
+	if err != nil {
+		t.statsT.IncErr(stats.DeleteCount)
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	t.statsT.Inc(stats.DeleteCount)
+	hdr := w.Header()
+	hdr.Set(cos.S3VersionHeader, ver)
+	if marker {
+		hdr.Set(cos.S3HdrDeleteMarker, "true")
+	}
+}
+
+// GET /s3/<bucket-name>?versions
+// lists all versions of all objects stored by this target; the proxy aggregates
+func (t *target) listObjVersionsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, q url.Values) {
+	var (
+		prefix = q.Get(s3.QparamPrefix)
+		names  = make(cos.StrSet, 64)
+		result = s3.NewListVersionsResult(bck.Name)
+	)
+	result.Prefix = prefix
+	result.KeyMarker, result.VersionIDMarker = q.Get(s3.QparamKeyMarker), q.Get(s3.QparamVersionIDMarker)
+	if s := q.Get(s3.QparamMaxKeys); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			s3.WriteErr(w, r, fmt.Errorf("invalid %s=%q", s3.QparamMaxKeys, s), http.StatusBadRequest)
+			return
+		}
+		result.MaxKeys = n
+	}
+	if bck.IsAIS() {
+		cb := func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			parsed, err := fs.ParseFQN(fqn)
+			if err != nil {
+				return nil
+			}
+			objName := parsed.ObjName
+			if parsed.ContentType == fs.ObjVersionType {
+				var ok bool
+				if objName, _, ok = fs.ParseObjVersion(objName); !ok {
+					return nil
+				}
+			}
+			if strings.HasPrefix(objName, prefix) {
+				names.Set(objName)
+			}
+			return nil
+		}
+		for _, mi := range fs.GetAvail() {
+			opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjectType, fs.ObjVersionType}, Callback: cb}
+			if err := fs.Walk(opts); err != nil && !errors.Is(err, os.ErrNotExist) {
+				s3.WriteErr(w, r, err, 0)
+				return
+			}
+		}
+	}
+	for objName := range names {
+		lom := core.AllocLOM(objName)
+		if err := lom.InitBck(bck.Bucket()); err == nil {
+			lom.Lock(false)
+			versions, _ := lom.Versions()
+			if lom.Load(true /*cache it*/, true /*locked*/) == nil {
+				versions = append([]*core.ObjVersion{lom.ToObjVersion()}, versions...)
+			}
+			lom.Unlock(false)
+			result.Add(objName, versions)
+		}
+		core.FreeLOM(lom)
+	}
+	result.Paginate()
+
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
@@ -0,0 +1,95 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// write-back PUT: see cmn.WriteBackConf, core/lwback.go, and xact/xs/wback.go
+
+const (
+	wbHKName = "write-back" + hk.NameSuffix
+	wbIval   = 10 * time.Second
+)
+
+func (t *target) initWriteBack(config *cmn.Config) {
+	n, err := core.WbInit(filepath.Join(config.ConfigDir, fname.WbJournal))
+	if err != nil {
+		cos.ExitLogf("%s: failed to load write-back journal: %v", t, err)
+	}
+	if n > 0 {
+		nlog.Infoln(t.String(), "write-back journal:", n, "pending upload"+cos.Plural(n))
+	}
+	hk.Reg(wbHKName, t.wbHK, wbIval)
+}
+
+// (re)start x-write-back when there's a backlog - e.g., upon restart,
+// or after it went idle with all pending uploads waiting to be retried
+func (t *target) wbHK() time.Duration {
+	if t.ClusterStarted() {
+		if cnt, _, _ := core.WbBacklog(); cnt > 0 {
+			t.wakeWriteBack()
+		}
+	}
+	return wbIval
+}
+
+func (t *target) wakeWriteBack() {
+	rns := xreg.RenewWriteBack()
+	if rns.Err != nil {
+		nlog.Errorln(t.String(), "failed to start", rns.Err)
+		return
+	}
+	rns.Entry.Get().(*xs.XactWriteBack).Wake()
+}
+
+// instead of synchronous backend.PutObj (compare with putRemote), step 1:
+// new content has no remote attributes until uploaded; the caller must be holding wlock
+func (poi *putOI) wbPrune() {
+	lom := poi.lom
+	if poi.owt == cmn.OwtPut && !lom.Bck().IsRemoteAIS() {
+		// (will be set upon upload)
+		lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
+	}
+}
+
+// step 2: journal new content that's already in place (ie., renamed);
+// failing that, remove the object that'd otherwise never get uploaded
+// (and account for the usage delta that lom.Remove subtracts - see putObject)
+func (poi *putOI) wbJournal(usage *core.BckUsage, dsize, dobjs int64) error {
+	lom := poi.lom
+	err := core.WbAdd(lom.Uname(), lom.SizeBytes())
+	if err == nil {
+		return nil
+	}
+	if errRm := lom.Remove(); errRm != nil {
+		nlog.Errorf("PUT (%s): failed to remove unjournaled object: %v", poi.loghdr(), errRm)
+	}
+	if usage != nil {
+		usage.Add(dsize, dobjs)
+	}
+	return err
+}
+
+// cannot destroy or evict bucket that has objects pending write-back upload
+func chkBckWriteBack(bck *meta.Bck) error {
+	if n := core.WbPendingBck(bck.Bucket()); n > 0 {
+		return cmn.NewErrBusy("bucket", bck, fmt.Sprintf("%d object%s pending write-back upload", n, cos.Plural(n)))
+	}
+	return nil
+}
@@ -221,6 +221,30 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck) error {
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return rns.Err
+	case apc.ActLifecycle:
+		rns := t.runLifecycle(args.ID, bck)
+		return rns.Err
+	case apc.ActECReencode:
+		if !bck.Props.EC.Enabled {
+			return fmt.Errorf("cannot start %q: %s is not erasure coded", args, bck)
+		}
+		if err := xreg.LimitedCoexistence(t.si, bck, args.Kind); err != nil {
+			return err
+		}
+		rns := xreg.RenewECReencode(bck, args.ID)
+		if rns.Err == nil && !rns.IsRunning() {
+			xact.GoRunW(rns.Entry.Get())
+		}
+		return rns.Err
+	case apc.ActECScrub:
+		if !bck.Props.EC.Enabled {
+			return fmt.Errorf("cannot start %q: %s is not erasure coded", args, bck)
+		}
+		rns := t.runECScrub(args.ID, bck)
+		return rns.Err
+	case apc.ActTierMigrate:
+		rns := t.runTierMigrate(args.ID, bck)
+		return rns.Err
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
@@ -21,10 +21,12 @@ const (
 
 	ActSummaryBck = "summary-bck"
 
-	ActECEncode  = "ec-encode" // erasure code a bucket
-	ActECGet     = "ec-get"    // read erasure coded objects
-	ActECPut     = "ec-put"    // erasure code objects
-	ActECRespond = "ec-resp"   // respond to other targets' EC requests
+	ActECEncode   = "ec-encode"   // erasure code a bucket
+	ActECReencode = "ec-reencode" // migrate erasure coded bucket to its current EC layout(s)
+	ActECScrub    = "ec-scrub"    // verify and repair slices and replicas of erasure coded objects
+	ActECGet      = "ec-get"      // read erasure coded objects
+	ActECPut      = "ec-put"      // erasure code objects
+	ActECRespond  = "ec-resp"     // respond to other targets' EC requests
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
@@ -37,6 +39,10 @@ const (
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 
+	ActTierMigrate = "tier-migrate" // move objects between hot and cold mountpaths (see cmn.TierConf)
+
+	ActWriteBack = "write-back" // upload objects PUT to remote buckets with write-back enabled (see cmn.WriteBackConf)
+
 	ActRebalance = "rebalance"
 	ActMoveBck   = "move-bck"
 
@@ -46,6 +52,7 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActLifecycle    = "lifecycle" // enforce bucket lifecycle rules (see cmn.LifecycleConf)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
@@ -54,6 +61,7 @@ const (
 	ActNewPrimary     = "new-primary"
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActSetObjLock     = "set-obj-lock" // see ObjLockMsg
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
@@ -70,7 +78,8 @@ const (
 	ActETLObjects      = "etl-listrange"
 	ActEvictObjects    = "evict-listrange"
 	ActPrefetchObjects = "prefetch-listrange"
-	ActArchive         = "archive" // see ArchiveMsg
+	ActArchive         = "archive"   // see ArchiveMsg
+	ActGetBatch        = "get-batch" // see GetBatchMsg
 
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
@@ -31,7 +31,15 @@ type (
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
-		UsedPct      uint64 `json:"used_pct"`
-		IsBckPresent bool   `json:"is_present"` // in BMD
+		Quota        *BsummQuota `json:"quota,omitempty"` // bucket quota, if configured
+		UsedPct      uint64      `json:"used_pct"`
+		IsBckPresent bool        `json:"is_present"` // in BMD
+	}
+	// bucket quota and its usage (%)
+	BsummQuota struct {
+		MaxSize    int64  `json:"max_size,string"`
+		MaxObjects int64  `json:"max_objects,string"`
+		SizePct    uint64 `json:"size_pct"`
+		ObjsPct    uint64 `json:"objs_pct"`
 	}
 )
@@ -10,17 +10,21 @@ import (
 
 // NOTE:
 // LZ4 block and frame formats: http://fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
+// Zstandard: https://datatracker.ietf.org/doc/html/rfc8878
 
 // Compression enum
 const (
-	CompressAlways = "always"
+	CompressAlways = "always" // lz4
 	CompressNever  = "never"
+	CompressZstd   = "zstd" // always, zstd
 )
 
 // sent via req.Header.Set(apc.HdrCompress, LZ4Compression)
-// (alternative to lz4 compressions upon popular request)
-const LZ4Compression = "lz4"
+const (
+	LZ4Compression  = "lz4"
+	ZstdCompression = "zstd"
+)
 
-var SupportedCompression = []string{CompressNever, CompressAlways}
+var SupportedCompression = []string{CompressNever, CompressAlways, CompressZstd}
 
 func IsValidCompression(c string) bool { return c == "" || cos.StringInSlice(c, SupportedCompression) }
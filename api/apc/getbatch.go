@@ -0,0 +1,32 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// GET-batch: retrieve multiple objects, archived files, and/or WebDataset samples
+// (from multiple buckets and shards) as a single serialized (e.g., .tar) response
+
+// missing entries (when `ContinueOnError` is set) show up in the output as zero-size
+// placeholders named GetBatchMissing + <name>
+const GetBatchMissing = "__404__/"
+
+type (
+	GetBatchEntry struct {
+		Bucket   string `json:"bucket,omitempty"`   // defaults to the (request) bucket
+		Provider string `json:"provider,omitempty"` // ditto
+		ObjName  string `json:"objname"`
+		// optionally, treat the object as a shard and select from it:
+		ArchPath  string `json:"archpath,omitempty"` // a single archived file, or
+		SampleKey string `json:"sample,omitempty"`   // all archived files sharing the same basename (WebDataset)
+	}
+	GetBatchMsg struct {
+		Entries         []GetBatchEntry `json:"in"`
+		Mime            string          `json:"mime,omitempty"`      // output format (one of the archive.FileExtensions); default ".tar"
+		Unordered       bool            `json:"unordered,omitempty"` // emit entries as they become available (default: in the request order)
+		InclSrcBname    bool            `json:"isbn,omitempty"`      // prefix output names with the respective source bucket names
+		ContinueOnError bool            `json:"coer,omitempty"`      // on missing entry: include GetBatchMissing placeholder (default: fail)
+	}
+)
+
+func (e *GetBatchEntry) IsArch() bool { return e.ArchPath != "" || e.SampleKey != "" }
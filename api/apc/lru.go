@@ -0,0 +1,24 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// LRU eviction policies (see cmn.LRUConf)
+const (
+	LRUPolicyLRU     = "lru"      // least recently used first (default)
+	LRUPolicySizeLRU = "size-lru" // size-weighted LRU: large objects that have not been accessed for a while go first
+	LRUPolicyLFU     = "lfu"      // least frequently used first (ties: least recently used)
+	LRUPolicyARC     = "arc"      // ARC-like: objects accessed only once go before those accessed repeatedly
+)
+
+var SupportedLRUPolicies = []string{LRUPolicyLRU, LRUPolicySizeLRU, LRUPolicyLFU, LRUPolicyARC}
+
+func IsValidLRUPolicy(policy string) bool {
+	for _, p := range SupportedLRUPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
@@ -144,6 +144,7 @@ type LsoMsg struct {
 	StartAfter        string `json:"start_after"`        // start listing after (AIS buckets only)
 	ContinuationToken string `json:"continuation_token"` // => LsoResult.ContinuationToken => LsoMsg.ContinuationToken
 	SID               string `json:"target"`             // selected target to solely execute backend.list-objects
+	Tag               string `json:"tag,omitempty"`      // filter by object tag: "key" or "key=value" (in-cluster objects only)
 	Flags             uint64 `json:"flags,string"`       // enum {LsObjCached, ...} - "LsoMsg flags" above
 	PageSize          uint   `json:"pagesize"`           // max entries returned by list objects call
 }
@@ -0,0 +1,11 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// n-way mirror: placement of object copies (see cmn.MirrorConf)
+const (
+	MirrorPlaceMpath  = "mountpath" // different mountpaths of the same target (default)
+	MirrorPlaceTarget = "target"    // different targets selected by HRW (and, optionally, different failure domains)
+)
@@ -21,7 +21,7 @@ type (
 	// at the specified (bucket) destination.
 	// See also: api.PutApndArchArgs
 	// --------------------  terminology   ---------------------
-	// here and elsewhere "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4) formatted object.
+	// here and elsewhere "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4, .tar.zst) formatted object.
 	ArchiveMsg struct {
 		TxnUUID     string `json:"-"`        // internal use
 		FromBckName string `json:"-"`        // ditto
@@ -0,0 +1,25 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// object lock (WORM) retention modes (see cmn.ObjLockConf)
+const (
+	ObjLockGovernance = "GOVERNANCE" // can be shortened or removed when bypassing governance (see QparamBypassGovernance)
+	ObjLockCompliance = "COMPLIANCE" // cannot be shortened or removed by anyone
+)
+
+type (
+	// set (or update) object retention and/or legal hold (see ActSetObjLock)
+	ObjLockMsg struct {
+		Retention        *ObjRetention `json:"retention,omitempty"`  // nil: no change
+		LegalHold        *bool         `json:"legal_hold,omitempty"` // ditto
+		BypassGovernance bool          `json:"bypass_governance,omitempty"`
+	}
+	// empty mode and retain-until: remove retention
+	ObjRetention struct {
+		Mode        string `json:"mode"`         // ObjLockGovernance | ObjLockCompliance
+		RetainUntil string `json:"retain_until"` // RFC3339, e.g. "2027-01-31T00:00:00Z"
+	}
+)
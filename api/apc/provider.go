@@ -16,8 +16,9 @@ const (
 	GCP   = "gcp"
 	HDFS  = "hdfs"
 	HTTP  = "ht"
+	File  = "file" // local or shared (e.g., NFS) filesystem
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://, file://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -35,12 +36,14 @@ const (
 	AISScheme     = "ais"
 )
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP, File)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
+// NOTE: includes file:// - a (shared) filesystem that, for all intents and purposes,
+// is treated as cloud storage: must be configured, supports listing, versioning metadata, etc.
 func IsCloudProvider(p string) bool {
-	return p == AWS || p == GCP || p == Azure
+	return p == AWS || p == GCP || p == Azure || p == File
 }
 
 func IsRemoteProvider(p string) bool {
@@ -92,6 +95,8 @@ func DisplayProvider(p string) string {
 		return "HDFS"
 	case HTTP:
 		return "HTTP(S)"
+	case File:
+		return "File"
 	default:
 		return p
 	}
@@ -21,6 +21,8 @@ const (
 	// remove existing custom keys and store new custom metadata
 	// NOTE: making an s/_/-/ naming exception because of the namesake CLI usage
 	QparamNewCustom = "set-new-custom"
+	// (with QparamNewCustom) remove and replace only the custom keys with given prefix(es)
+	QparamCustomPrefix = "custom-prefix"
 
 	// Main bucket query params.
 	QparamProvider  = "provider" // aka backend provider or, simply, backend
@@ -92,6 +94,12 @@ const (
 	// - implies remote backend
 	QparamLatestVer = "latest-ver"
 
+	// GET a given (retained) version of ais:// object; see also: `versioning.retain`
+	QparamObjVersion = "obj-ver"
+
+	// DELETE (or shorten retention of) an object locked in governance mode; see also: `object_lock`
+	QparamBypassGovernance = "bypass-governance"
+
 	QparamSync = "synchronize" // TODO: in progress
 
 	QparamSilent = "sln" // when true., skip nlog.Error* (motivation: can be quite numerous and/or ignorable)
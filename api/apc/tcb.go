@@ -6,6 +6,7 @@ package apc
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -22,8 +23,15 @@ type (
 		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
 	}
 	Transform struct {
-		Name    string       `json:"id,omitempty"`
+		Name string `json:"id,omitempty"`
+		// (optional) ETL pipeline: subsequent ETLs to pipe the output of the `Name`-d one through, in order
+		Chain   []string     `json:"chain,omitempty"`
 		Timeout cos.Duration `json:"request_timeout,omitempty"`
+		// (optional) transform archived files (shard members) one at a time, and pack the results
+		// into a destination shard, preserving the order
+		ArchMembers bool `json:"arch_members,omitempty"`
+		// (optional) format of the destination shards, e.g. ".tar.gz"; default: same as the source
+		ArchMime string `json:"arch_mime,omitempty"`
 	}
 	TCBMsg struct {
 		// NOTE: objname extension ----------------------------------------------------------------------
@@ -38,13 +46,46 @@ type (
 	}
 )
 
+// ETL pipeline, as in: QparamETLName=decode,resize,normalize
+const ETLPipelineSep = ","
+
+///////////////
+// Transform //
+///////////////
+
+// parse comma-separated list of ETL names (see ETLPipelineSep)
+func ParseTransform(names string) Transform {
+	l := strings.Split(names, ETLPipelineSep)
+	return Transform{Name: l[0], Chain: l[1:]}
+}
+
+// all stages, in order
+func (t *Transform) Names() []string {
+	if len(t.Chain) == 0 {
+		return []string{t.Name}
+	}
+	return append([]string{t.Name}, t.Chain...)
+}
+
 ////////////
 // TCBMsg //
 ////////////
 
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
-	if isEtl && msg.Transform.Name == "" {
-		err = errors.New("ETL name can't be empty")
+	if !isEtl {
+		return
+	}
+	if msg.Transform.Name == "" {
+		return errors.New("ETL name can't be empty")
+	}
+	for i, name := range msg.Transform.Chain {
+		if name == "" {
+			return fmt.Errorf("ETL pipeline %v: stage #%d: ETL name can't be empty", msg.Transform.Names(), i+2)
+		}
+	}
+	if msg.Transform.ArchMime != "" && !msg.Transform.ArchMembers {
+		return fmt.Errorf("ETL %v: destination shard format (%q) requires transforming archived files",
+			msg.Transform.Names(), msg.Transform.ArchMime)
 	}
 	return
 }
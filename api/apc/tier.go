@@ -0,0 +1,13 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// storage tiers: mountpath classes (see cmn.FSPConf) and bucket's preferred tier (see cmn.TierConf)
+const (
+	TierHot  = "hot"  // e.g., NVMe (default: mountpaths with no configured class are hot)
+	TierCold = "cold" // e.g., HDD
+)
+
+func IsValidTier(tier string) bool { return tier == TierHot || tier == TierCold }
@@ -17,6 +17,8 @@ var (
 		CertKey       string
 		ClientCA      string
 		SkipVerifyCrt string
+		// node labels (failure domains), e.g. "rack=r7,zone=z1"
+		NodeLabels string
 		// tests, CI
 		NumTarget string
 		NumProxy  string
@@ -41,6 +43,9 @@ var (
 		// TLS: common
 		SkipVerifyCrt: "AIS_SKIP_VERIFY_CRT", // cluster config: "net.http.skip_verify"
 
+		// at startup, adds (or overrides) labels from the node's local config (see cmn.LocalConfig.Labels)
+		NodeLabels: "AIS_NODE_LABELS",
+
 		// variables used in tests and CI
 		NumTarget: "NUM_TARGET",
 		NumProxy:  "NUM_PROXY",
@@ -0,0 +1,18 @@
+// Package env contains environment variables
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package env
+
+// server-side encryption (SSE) key provider (storage targets)
+// see also: docs/environment-vars.md, cmn/sse
+
+var (
+	SSE = struct {
+		Keyfile string
+		KeyURL  string
+	}{
+		Keyfile: "AIS_SSE_KEYFILE", // local JSON keyfile (e.g., tests and development)
+		KeyURL:  "AIS_SSE_KEY_URL", // KMS-like HTTP key service
+	}
+)
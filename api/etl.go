@@ -145,6 +145,7 @@ func etlPostAction(bp BaseParams, etlName, action string) (err error) {
 }
 
 // TODO: add ETL-specific query param and change the examples/docs (!4455)
+// `etlName` can also be an ETL pipeline: comma-separated list of names (see apc.ETLPipelineSep)
 func ETLObject(bp BaseParams, etlName string, bck cmn.Bck, objName string, w io.Writer) (err error) {
 	_, err = GetObject(bp, bck, objName, &GetArgs{
 		Writer: w,
@@ -5,6 +5,7 @@
 package api
 
 import (
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -67,6 +68,28 @@ func Prefetch(bp BaseParams, bck cmn.Bck, msg apc.PrefetchMsg) (string, error) {
 	return dolr(bp, bck, apc.ActPrefetchObjects, msg, q)
 }
 
+// GetBatch retrieves multiple objects, archived files, and/or WebDataset samples
+// (possibly, from multiple buckets and shards) and writes them to `w` as a single
+// serialized archive (format-wise, see `apc.GetBatchMsg.Mime`).
+// Returns the number of bytes written.
+func GetBatch(bp BaseParams, bck cmn.Bck, msg *apc.GetBatchMsg, w io.Writer) (int64, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActGetBatch, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	wresp, err := reqParams.doWriter(w)
+	FreeRp(reqParams)
+	if err != nil {
+		return 0, err
+	}
+	return wresp.n, nil
+}
+
 // multi-object list-range (delete, prefetch, evict, archive, copy, and etl)
 func dolr(bp BaseParams, bck cmn.Bck, action string, msg any, q url.Values) (xid string, err error) {
 	reqParams := AllocRp()
@@ -43,6 +43,10 @@ type (
 		// 4. `apc.QparamLatestVer`: get latest version from the associated Cloud bucket; see also: `ValidateWarmGet`
 		Query url.Values
 
+		// Optional: GET a given version of ais:// object - the current one or one of the
+		// previous versions retained as per bucket's `versioning.retain` property
+		Version string
+
 		// The field is exclusively used to facilitate Range Read.
 		// E.g. usage:
 		// * Header.Set(cos.HdrRange, fmt.Sprintf("bytes=%d-%d", fromOffset, toOffset))
@@ -128,6 +132,13 @@ func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 		w = args.Writer
 	}
 	q, hdr = args.Query, args.Header
+	if args.Version != "" {
+		q = make(url.Values, len(args.Query)+1)
+		for k, v := range args.Query {
+			q[k] = v
+		}
+		q.Set(apc.QparamObjVersion, args.Version)
+	}
 	return
 }
 
@@ -438,7 +449,7 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 }
 
 // Archive the content of a reader (`args.Reader` - e.g., an open file).
-// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4)
+// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst)
 // formatted object (aka "shard") or a new one (or, a new version).
 // ---
 // For the updated list of supported archival formats -- aka MIME types -- see cmn/cos/archive.go.
@@ -547,6 +558,39 @@ func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	return err
 }
 
+// SetObjectLock sets, extends, or removes object retention and/or legal hold
+// (the bucket must have object lock enabled - see cmn.ObjLockConf)
+func SetObjectLock(bp BaseParams, bck cmn.Bck, objName string, msg *apc.ObjLockMsg) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSetObjLock, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// DeleteObjectBypassGovernance deletes object that may be under governance-mode retention
+// (requires apc.AcePATCH permission)
+func DeleteObjectBypassGovernance(bp BaseParams, bck cmn.Bck, objName string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = bck.NewQuery()
+		reqParams.Query.Set(apc.QparamBypassGovernance, "true")
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // promote files and directories to ais objects
 func Promote(bp BaseParams, bck cmn.Bck, args *apc.PromoteArgs) (xid string, err error) {
 	actMsg := apc.ActMsg{Action: apc.ActPromote, Name: args.SrcFQN, Value: args}
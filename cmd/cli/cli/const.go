@@ -94,6 +94,7 @@ const (
 	cmdLRU         = apc.ActLRU
 	cmdStgCleanup  = "cleanup" // display name for apc.ActStoreCleanup
 	cmdStgValidate = "validate"
+	cmdStgScrub    = "scrub"   // view ec-scrub results (apc.ActECScrub)
 	cmdSummary     = "summary" // ditto apc.ActSummaryBck
 
 	cmdCluster    = commandCluster
@@ -497,6 +498,14 @@ var (
 			indent4 + "\traw - do not convert to (or from) human-readable format",
 	}
 
+	// `ais show cluster --domain`
+	domainFlag = cli.StringFlag{
+		Name: "domain",
+		Usage: "group nodes by the specified node label (failure domain), e.g.:\n" +
+			indent4 + "\t--domain host\t- group by physical host;\n" +
+			indent4 + "\t--domain rack\t- group by rack (see node 'labels' in the local config and AIS_NODE_LABELS)",
+	}
+
 	// list-objects
 	startAfterFlag = cli.StringFlag{
 		Name:  "start-after",
@@ -857,6 +866,20 @@ var (
 		Usage:    "unique ETL name (leaving this field empty will have unique ID auto-generated)",
 		Required: true,
 	}
+	etlCacheSizeFlag = cli.StringFlag{
+		Name: "cache-size",
+		Usage: "per-target capacity of the cache of inline transformation results, e.g. 10GiB (see '--units');\n" +
+			indent4 + "\t(default: no caching)",
+	}
+	etlArchMembersFlag = cli.BoolFlag{
+		Name:  "arch-members",
+		Usage: "transform archived files (shard members) one at a time, and pack the results into destination shards",
+	}
+	etlArchMimeFlag = cli.StringFlag{
+		Name: "arch-mime",
+		Usage: "format of the destination shards, one of: " + archFormats + "\n" +
+			indent4 + "\t(default: same as the source; requires " + qflprn(etlArchMembersFlag) + ")",
+	}
 	etlBucketRequestTimeout = DurationFlag{
 		Name: "etl-timeout",
 		Usage: "server-side timeout transforming a single object;\n" +
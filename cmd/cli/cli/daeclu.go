@@ -7,6 +7,7 @@ package cli
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
@@ -70,8 +71,13 @@ func cluDaeStatus(c *cli.Context, smap *meta.Smap, tstatusMap, pstatusMap teb.St
 			}
 		}
 
-		out := tableP.Template(false) + "\n"
-		out += tableT.Template(false) + "\n"
+		var out string
+		if flagIsSet(c, domainFlag) {
+			out = domainsStatus(c, smap, &body.Status, units)
+		} else {
+			out = tableP.Template(false) + "\n"
+			out += tableT.Template(false) + "\n"
+		}
 
 		// summary
 		title := fgreen("Summary:")
@@ -84,3 +90,55 @@ func cluDaeStatus(c *cli.Context, smap *meta.Smap, tstatusMap, pstatusMap teb.St
 
 	return fmt.Errorf("expecting a valid NODE_ID or node type (\"proxy\" or \"target\"), got %q", sid)
 }
+
+// `ais show cluster --domain LABEL`: proxies and targets grouped by failure domain
+// (nodes that do not have the label are shown last)
+func domainsStatus(c *cli.Context, smap *meta.Smap, st *teb.StatsAndStatusHelper, units string) (out string) {
+	const none = "(none)"
+	var (
+		label   = parseStrFlag(c, domainFlag)
+		domains = make(map[string]*teb.StatsAndStatusHelper, 4)
+		names   = make([]string, 0, 4)
+	)
+	group := func(m teb.StstMap, isTarget bool) {
+		for sid, ds := range m {
+			var domain string
+			if node := smap.GetNode(sid); node != nil {
+				domain = node.Label(label)
+			}
+			if domain == "" {
+				domain = none
+			}
+			d, ok := domains[domain]
+			if !ok {
+				d = &teb.StatsAndStatusHelper{Pmap: make(teb.StstMap, 2), Tmap: make(teb.StstMap, 4)}
+				domains[domain] = d
+				names = append(names, domain)
+			}
+			if isTarget {
+				d.Tmap[sid] = ds
+			} else {
+				d.Pmap[sid] = ds
+			}
+		}
+	}
+	group(st.Pmap, false)
+	group(st.Tmap, true)
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == none || names[j] == none {
+			return names[j] == none && names[i] != none
+		}
+		return names[i] < names[j]
+	})
+	for _, domain := range names {
+		d := domains[domain]
+		out += fcyan(label+": "+domain) + fmt.Sprintf(" (proxies: %d, targets: %d)\n", len(d.Pmap), len(d.Tmap))
+		if len(d.Pmap) > 0 {
+			out += teb.NewDaeMapStatus(d, smap, apc.Proxy, units).Template(false) + "\n"
+		}
+		if len(d.Tmap) > 0 {
+			out += teb.NewDaeMapStatus(d, smap, apc.Target, units).Template(false) + "\n"
+		}
+	}
+	return out
+}
@@ -35,6 +35,7 @@ var (
 			funcTransformFlag,
 			argTypeFlag,
 			chunkSizeFlag,
+			etlCacheSizeFlag,
 			waitPodReadyTimeoutFlag,
 			etlNameFlag,
 		},
@@ -42,6 +43,7 @@ var (
 			fromFileFlag,
 			commTypeFlag,
 			argTypeFlag,
+			etlCacheSizeFlag,
 			waitPodReadyTimeoutFlag,
 			etlNameFlag,
 		},
@@ -52,6 +54,8 @@ var (
 			etlAllObjsFlag,
 			continueOnErrorFlag,
 			etlExtFlag,
+			etlArchMembersFlag,
+			etlArchMimeFlag,
 			forceFlag,
 			copyPrependFlag,
 			copyDryRunFlag,
@@ -114,14 +118,15 @@ var (
 	}
 	objCmdETL = cli.Command{
 		Name:         cmdObject,
-		Usage:        "transform object",
+		Usage:        "transform object (to pipe the object through multiple ETLs, specify comma-separated ETL_NAME list, e.g. 'decode,resize')",
 		ArgsUsage:    etlNameArgument + " " + objectArgument + " OUTPUT",
 		Action:       etlObjectHandler,
 		BashComplete: etlIDCompletions,
 	}
 	bckCmdETL = cli.Command{
-		Name:         cmdBucket,
-		Usage:        "transform entire bucket or selected objects (to select, use '--list', '--template', or '--prefix')",
+		Name: cmdBucket,
+		Usage: "transform entire bucket or selected objects (to select, use '--list', '--template', or '--prefix');\n" +
+			indent1 + "to pipe objects through multiple ETLs, specify comma-separated ETL_NAME list, e.g. 'decode,resize,normalize'",
 		ArgsUsage:    etlNameArgument + " " + bucketObjectSrcArgument + " " + bucketDstArgument,
 		Action:       etlBucketHandler,
 		Flags:        etlSubFlags[cmdBucket],
@@ -208,6 +213,11 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 		msg.ArgTypeX = parseStrFlag(c, argTypeFlag)
 		msg.Spec = spec
 	}
+	if flagIsSet(c, etlCacheSizeFlag) {
+		if msg.CacheSize, err = parseSizeFlag(c, etlCacheSizeFlag); err != nil {
+			return err
+		}
+	}
 	if !strings.HasSuffix(msg.CommTypeX, etl.CommTypeSeparator) {
 		msg.CommTypeX += etl.CommTypeSeparator
 	}
@@ -276,6 +286,12 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 		}
 	}
 
+	if flagIsSet(c, etlCacheSizeFlag) {
+		if msg.CacheSize, err = parseSizeFlag(c, etlCacheSizeFlag); err != nil {
+			return err
+		}
+	}
+
 	msg.Timeout = cos.Duration(parseDurationFlag(c, waitPodReadyTimeoutFlag))
 
 	// funcs
@@ -77,7 +77,9 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		text  = "Copying objects"
 	)
 	if etlName != "" {
-		msg.Name = etlName
+		timeout := msg.Timeout
+		msg.Transform = etlTransform(c, etlName)
+		msg.Timeout = timeout
 		text = "Transforming objects"
 		xkind = apc.ActETLObjects
 		xid, err = api.ETLMultiObj(apiBP, bckFrom, &msg)
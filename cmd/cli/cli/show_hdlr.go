@@ -67,6 +67,7 @@ var (
 			longRunFlags,
 			jsonFlag,
 			noHeaderFlag,
+			domainFlag,
 		),
 		cmdSmap: append(
 			longRunFlags,
@@ -20,7 +20,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -144,6 +146,10 @@ var (
 			longRunFlags,
 			waitJobXactFinishedFlag,
 		),
+		cmdStgScrub: append(
+			longRunFlags,
+			noHeaderFlag,
+		),
 	}
 
 	//
@@ -188,6 +194,15 @@ var (
 				Action:       showMisplacedAndMore,
 				BashComplete: bucketCompletions(bcmplop{}),
 			},
+			{
+				Name: cmdStgScrub,
+				Usage: "show erasure coded buckets' health as per the most recent ec-scrub (degraded, repaired, and unrecoverable objects);\n" +
+					indent4 + "\tto start scrubbing a bucket, run 'ais start ec-scrub BUCKET'",
+				ArgsUsage:    optionalBucketArgument,
+				Flags:        storageFlags[cmdStgScrub],
+				Action:       showECScrubHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
 			mpathCmd,
 			showCmdDisk,
 			cleanupCmd,
@@ -242,6 +257,82 @@ func cleanupStorageHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+//
+// ec-scrub
+//
+
+type bckScrub struct {
+	Bck           cmn.Bck
+	State         string
+	Objs          int64
+	Degraded      int64
+	Repaired      int64
+	Unrecoverable int64
+}
+
+func showECScrubHandler(c *cli.Context) (err error) {
+	var bck cmn.Bck
+	if c.NArg() != 0 {
+		if bck, err = parseBckURI(c, c.Args().Get(0), false); err != nil {
+			return err
+		}
+	}
+	xs, err := api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{Kind: apc.ActECScrub, Bck: bck})
+	if err != nil {
+		return V(err)
+	}
+
+	// the most recent scrub of a given bucket on a given target
+	latest := make(map[string]map[string]*core.Snap, 4) // bucket => (target => snap)
+	for tid, snaps := range xs {
+		for _, snap := range snaps {
+			uname := snap.Bck.MakeUname("")
+			if latest[uname] == nil {
+				latest[uname] = make(map[string]*core.Snap, len(xs))
+			}
+			if prev, ok := latest[uname][tid]; !ok || snap.StartTime.After(prev.StartTime) {
+				latest[uname][tid] = snap
+			}
+		}
+	}
+	if len(latest) == 0 {
+		if bck.IsEmpty() {
+			fmt.Fprintln(c.App.Writer, "No ec-scrub jobs found")
+		} else {
+			fmt.Fprintf(c.App.Writer, "No ec-scrub jobs found for bucket %s\n", bck.Cname(""))
+		}
+		return nil
+	}
+
+	all := make([]*bckScrub, 0, len(latest))
+	for _, snaps := range latest {
+		var res *bckScrub
+		for _, snap := range snaps {
+			ext := &ec.ExtECScrubStats{}
+			if err := cos.MorphMarshal(snap.Ext, ext); err != nil {
+				ext = &ec.ExtECScrubStats{}
+			}
+			if res == nil {
+				res = &bckScrub{Bck: snap.Bck, State: teb.FmtXactStatus(snap)}
+			}
+			if snap.Running() {
+				res.State = teb.FmtXactStatus(snap)
+			}
+			res.Objs += snap.Stats.Objs
+			res.Degraded += ext.Degraded
+			res.Repaired += ext.Repaired
+			res.Unrecoverable += ext.Unrecoverable
+		}
+		all = append(all, res)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Bck.Less(&all[j].Bck) })
+
+	if flagIsSet(c, noHeaderFlag) {
+		return teb.Print(all, teb.ECScrubBody)
+	}
+	return teb.Print(all, teb.ECScrubTmpl)
+}
+
 //
 // disk
 //
@@ -251,9 +251,16 @@ func etlBucketHandler(c *cli.Context) error {
 	return copyTransform(c, etlName, objFrom, bckFrom, bckTo, flagIsSet(c, etlAllObjsFlag))
 }
 
+func etlTransform(c *cli.Context, etlName string) apc.Transform {
+	t := apc.ParseTransform(etlName) // (ETL pipeline when comma-separated)
+	t.ArchMembers = flagIsSet(c, etlArchMembersFlag)
+	t.ArchMime = parseStrFlag(c, etlArchMimeFlag)
+	return t
+}
+
 func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck, allIncludingRemote bool) error {
 	var msg = apc.TCBMsg{
-		Transform: apc.Transform{Name: etlName},
+		Transform: etlTransform(c, etlName),
 	}
 	if err := _iniCopyBckMsg(c, &msg.CopyBckMsg); err != nil {
 		return err
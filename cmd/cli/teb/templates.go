@@ -241,12 +241,12 @@ const (
 	ListBucketsTmplNoSummary = ListBucketsHdrNoSummary + ListBucketsBodyNoSummary
 
 	// Bucket summary templates
-	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\n" +
+	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\t QUOTA(%)\n" +
 		BucketsSummariesBody
 	BucketsSummariesBody = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount.Present}} {{$v.ObjCount.Remote}}\t " +
 		"{{FormatMAM $v.ObjSize.Min}} {{FormatMAM $v.ObjSize.Avg}} {{FormatMAM $v.ObjSize.Max}}\t " +
-		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
+		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\t {{FormatQuota $v.Quota}}\n" +
 		"{{end}}"
 
 	BucketSummaryValidateTmpl = "BUCKET\t OBJECTS\t MISPLACED\t MISSING COPIES\n" + bucketSummaryValidateBody
@@ -254,6 +254,11 @@ const (
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjectCnt}}\t {{$v.Misplaced}}\t {{$v.MissingCopies}}\n" +
 		"{{end}}"
 
+	ECScrubTmpl = "BUCKET\t OBJECTS\t DEGRADED\t REPAIRED\t UNRECOVERABLE\t STATE\n" + ECScrubBody
+	ECScrubBody = "{{range $v := . }}" +
+		"{{FormatBckName $v.Bck}}\t {{$v.Objs}}\t {{$v.Degraded}}\t {{$v.Repaired}}\t {{$v.Unrecoverable}}\t {{$v.State}}\n" +
+		"{{end}}"
+
 	// For `object put` mass uploader. A caller adds to the template
 	// total count and size. That is why the template ends with \t
 	MultiPutTmpl = "Files to upload:\nEXTENSION\t COUNT\t SIZE\n" +
@@ -423,6 +428,7 @@ var (
 		"FormatACL":           fmtACL,
 		"FormatNameArch":      fmtNameArch,
 		"FormatXactState":     FmtXactStatus,
+		"FormatQuota":         fmtQuota,
 		//  misc. helpers
 		"IsUnsetTime":   isUnsetTime,
 		"IsEqS":         func(a, b string) bool { return a == b },
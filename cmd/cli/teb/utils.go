@@ -320,3 +320,21 @@ func FmtStartEnd(start, end time.Time) (startS, endS string) {
 	startS = cos.FormatTime(start, f)
 	return
 }
+
+// bucket summary: percentage of the bucket quota (size, number of objects) in use
+func fmtQuota(q *apc.BsummQuota) string {
+	if q == nil {
+		return NotSetVal
+	}
+	var parts []string
+	if q.MaxSize > 0 {
+		parts = append(parts, fmt.Sprintf("size %d%%", q.SizePct))
+	}
+	if q.MaxObjects > 0 {
+		parts = append(parts, fmt.Sprintf("objects %d%%", q.ObjsPct))
+	}
+	if len(parts) == 0 {
+		return NotSetVal
+	}
+	return strings.Join(parts, ", ")
+}
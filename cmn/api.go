@@ -6,6 +6,7 @@
 package cmn
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
@@ -37,6 +38,12 @@ const (
 	PropBackendBckProvider = PropBackendBck + ".provider"
 )
 
+// S3 request signing (see ExtraPropsAWS.SigVersion)
+const (
+	S3SigV4 = "v4"
+	S3SigV2 = "v2"
+)
+
 type (
 	Bprops struct {
 		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
@@ -52,6 +59,14 @@ type (
 		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		Lifecycle   LifecycleConf   `json:"lifecycle" list:"omitempty"`     // S3-compatible lifecycle rules
+		Policy      BckPolicy       `json:"policy" list:"omitempty"`        // per-user permissions (see also: Access)
+		CORS        CORSConf        `json:"cors" list:"omitempty"`          // cross-origin resource sharing
+		SSE         SSEConf         `json:"sse" list:"omitempty"`           // server-side encryption at rest
+		ObjLock     ObjLockConf     `json:"object_lock" list:"omitempty"`   // object lock (WORM) and default retention
+		Tier        TierConf        `json:"tier" list:"omitempty"`          // preferred storage tier and migration policy
+		Quota       QuotaConf       `json:"quota" list:"omitempty"`         // bucket and namespace capacity quotas
+		WriteBack   WriteBackConf   `json:"write_back" list:"omitempty"`    // asynchronous PUT to remote backend
 	}
 
 	ExtraProps struct {
@@ -78,11 +93,33 @@ type (
 		// set the value of the environment variable will be loaded (AWS_PROFILE,
 		// or AWS_DEFAULT_PROFILE if the Shared Config is enabled)."
 		Profile string `json:"profile,omitempty"`
+
+		// S3-compatible (non-AWS) storage, e.g. MinIO or Ceph RGW: no region discovery
+		// (the region defaults to us-east-1 unless specified) - see also the knobs below
+		S3Compat bool `json:"s3compat,omitempty"`
+
+		// path-style addressing: "<endpoint>/<bucket>/<object>"
+		PathStyle bool `json:"path_style,omitempty"`
+
+		// PEM-encoded CA bundle to verify the endpoint's certificate (local path on each target)
+		CACert string `json:"ca_cert,omitempty"`
+
+		// static credentials: ID (name) of the credentials in the targets' local credentials file;
+		// the credentials themselves are never stored in bucket metadata
+		CredsID string `json:"creds_id,omitempty"`
+
+		// request signing: S3SigV4 (default) or S3SigV2
+		SigVersion string `json:"sig_version,omitempty"`
 	}
 	ExtraPropsAWSToSet struct {
 		CloudRegion *string `json:"cloud_region"`
 		Endpoint    *string `json:"endpoint"`
 		Profile     *string `json:"profile"`
+		S3Compat    *bool   `json:"s3compat"`
+		PathStyle   *bool   `json:"path_style"`
+		CACert      *string `json:"ca_cert"`
+		CredsID     *string `json:"creds_id"`
+		SigVersion  *string `json:"sig_version"`
 	}
 
 	ExtraPropsHTTP struct {
@@ -114,6 +151,14 @@ type (
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
+		Lifecycle   *LifecycleConfToSet   `json:"lifecycle,omitempty"`
+		Policy      *BckPolicyToSet       `json:"policy,omitempty"`
+		CORS        *CORSConfToSet        `json:"cors,omitempty"`
+		SSE         *SSEConfToSet         `json:"sse,omitempty"`
+		ObjLock     *ObjLockConfToSet     `json:"object_lock,omitempty"`
+		Tier        *TierConfToSet        `json:"tier,omitempty"`
+		Quota       *QuotaConfToSet       `json:"quota,omitempty"`
+		WriteBack   *WriteBackConfToSet   `json:"write_back,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
@@ -202,8 +247,33 @@ func (bp *Bprops) Validate(targetCnt int) error {
 			return fmt.Errorf("backend bucket %q must be remote", bp.BackendBck)
 		}
 	}
+	if bp.Versioning.Retain != 0 {
+		if err := bp.Versioning.Validate(); err != nil {
+			return err
+		}
+		if bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty() {
+			return fmt.Errorf("retaining previous object versions is only supported for %q buckets (with no remote backend)",
+				apc.AIS)
+		}
+	}
+	if bp.Lifecycle.Enabled && bp.Provider != apc.AIS {
+		return fmt.Errorf("lifecycle rules are only supported for %q buckets (got %q)", apc.AIS, bp.Provider)
+	}
+	if bp.Mirror.Enabled && bp.Mirror.OnTargets() && bp.EC.Enabled {
+		return fmt.Errorf("n-way mirror with copies placed across targets cannot be combined with erasure coding (%s)", bp.EC.String())
+	}
+	if bp.Tier.Enabled && (bp.EC.Enabled || (bp.Mirror.Enabled && !bp.Mirror.OnTargets())) {
+		return fmt.Errorf("storage tiering cannot be combined with erasure coding or n-way mirroring across mountpaths (%s)", bp.Tier.String())
+	}
+	if bp.WriteBack.Enabled && bp.Provider == apc.HTTP {
+		return fmt.Errorf("write-back is not supported for %q buckets (read-only)", apc.HTTP)
+	}
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	pvs := []PropsValidator{
+		&bp.Cksum, &bp.LRU, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Lifecycle, &bp.Policy, &bp.CORS, &bp.SSE,
+		&bp.ObjLock, &bp.Tier, &bp.Quota,
+	}
+	for _, pv := range pvs {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
@@ -222,6 +292,9 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		return fmt.Errorf("cannot enable mirroring and ec at the same time for the same bucket")
 	}
+	if bp.SSE.Enabled && bp.EC.Enabled {
+		return fmt.Errorf("cannot enable server-side encryption and ec at the same time for the same bucket")
+	}
 	return softErr
 }
 
@@ -257,6 +330,8 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	provider, ok := arg[0].(string)
 	debug.Assert(ok)
 	switch provider {
+	case apc.AWS:
+		return c.AWS.validate()
 	case apc.HDFS:
 		if c.HDFS.RefDirectory == "" {
 			return fmt.Errorf("reference directory must be set for a bucket with HDFS provider")
@@ -269,6 +344,23 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	return nil
 }
 
+func (c *ExtraPropsAWS) validate() error {
+	switch c.SigVersion {
+	case "", S3SigV4:
+	case S3SigV2:
+		if !c.PathStyle {
+			return fmt.Errorf("s3 signature %s requires path-style addressing (extra.aws.path_style)", S3SigV2)
+		}
+	default:
+		return fmt.Errorf("invalid s3 signature version %q (expecting %q or %q)", c.SigVersion, S3SigV4, S3SigV2)
+	}
+	if !c.S3Compat && (c.PathStyle || c.CACert != "" || c.CredsID != "" || c.SigVersion != "") {
+		return errors.New("path-style addressing, custom CA, static credentials, and signature version " +
+			"require S3-compatible mode (extra.aws.s3compat)")
+	}
+	return nil
+}
+
 //
 // Bucket Summary - result for a given bucket, and all results -------------------------------------------------
 //
@@ -330,6 +422,21 @@ func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
 	}
 }
 
+// (see QuotaConf)
+func (summ *BsummResult) SetQuota(conf *QuotaConf) {
+	if !conf.HasBck() {
+		return
+	}
+	q := &apc.BsummQuota{MaxSize: conf.MaxSize, MaxObjects: conf.MaxObjects}
+	if conf.MaxSize > 0 {
+		q.SizePct = cos.DivRoundU64(summ.TotalSize.PresentObjs*100, uint64(conf.MaxSize))
+	}
+	if conf.MaxObjects > 0 {
+		q.ObjsPct = cos.DivRoundU64(summ.ObjCount.Present*100, uint64(conf.MaxObjects))
+	}
+	summ.Quota = q
+}
+
 //
 // Multi-object (list|range) operations source bucket => dest. bucket ---------------------------------------
 //
@@ -338,7 +445,7 @@ type (
 	// ArchiveBckMsg contains parameters to archive mutiple objects from the specified (source) bucket.
 	// Destination bucket may the same as the source or a different one.
 	// --------------------  NOTE on terminology:   ---------------------
-	// "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4) formatted object often also called "shard"
+	// "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4, .tar.zst) formatted object often also called "shard"
 	//
 	// See also: apc.PutApndArchArgs
 	ArchiveBckMsg struct {
@@ -11,7 +11,7 @@ import (
 	"io"
 )
 
-// copy .tar, .tar.gz, and .tar.lz4 (`src` => `tw` one file at a time)
+// copy .tar, .tar.gz, .tar.lz4, and .tar.zst (`src` => `tw` one file at a time)
 // - opens specific arch reader
 // - always closes it
 // - `tw` is the writer that can be further used to write (ie., append)
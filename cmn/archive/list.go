@@ -51,6 +51,8 @@ func List(fqn string) ([]*Entry, error) {
 		}
 	case ExtTarLz4:
 		lst, err = lsLz4(fh)
+	case ExtTarZst:
+		lst, err = lsZst(fh)
 	default:
 		debug.Assert(false, mime)
 	}
@@ -113,3 +115,12 @@ func lsLz4(reader io.Reader) ([]*Entry, error) {
 	lzr := lz4.NewReader(reader)
 	return lsTar(lzr)
 }
+
+func lsZst(reader io.Reader) ([]*Entry, error) {
+	zsr, err := NewZstdReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer zsr.Close()
+	return lsTar(zsr)
+}
@@ -29,6 +29,7 @@ const (
 	ExtTarGz  = ".tar.gz"
 	ExtZip    = ".zip"
 	ExtTarLz4 = ".tar.lz4"
+	ExtTarZst = ".tar.zst"
 )
 
 const (
@@ -47,7 +48,7 @@ type detect struct {
 	offset int
 }
 
-var FileExtensions = []string{ExtTar, ExtTgz, ExtTarGz, ExtZip, ExtTarLz4}
+var FileExtensions = []string{ExtTar, ExtTgz, ExtTarGz, ExtZip, ExtTarLz4, ExtTarZst}
 
 // standard file signatures
 var (
@@ -55,8 +56,9 @@ var (
 	magicGzip = detect{sig: []byte{0x1f, 0x8b}, mime: ExtTarGz}
 	magicZip  = detect{sig: []byte{0x50, 0x4b}, mime: ExtZip}
 	magicLz4  = detect{sig: []byte{0x04, 0x22, 0x4d, 0x18}, mime: ExtTarLz4}
+	magicZstd = detect{sig: []byte{0x28, 0xb5, 0x2f, 0xfd}, mime: ExtTarZst}
 
-	allMagics = []detect{magicTar, magicGzip, magicZip, magicLz4} // NOTE: must contain all
+	allMagics = []detect{magicTar, magicGzip, magicZip, magicLz4, magicZstd} // NOTE: must contain all
 )
 
 // motivation: prevent from creating archives with non-standard extensions
@@ -91,6 +93,8 @@ func normalize(mime string) (string, error) {
 		return ExtTarGz, nil
 	case strings.Contains(mime, ExtTarLz4[1:]): // ditto
 		return ExtTarLz4, nil
+	case strings.Contains(mime, ExtTarZst[1:]): // ditto
+		return ExtTarZst, nil
 	default:
 		for _, ext := range FileExtensions {
 			if strings.Contains(mime, ext[1:]) {
@@ -112,7 +116,7 @@ func byExt(filename string) (string, error) {
 }
 
 // NOTE convention: caller may pass nil `smm` _not_ to spend time (usage: listing and reading)
-func MimeFile(file *os.File, smm *memsys.MMSA, mime, archname string) (m string, err error) {
+func MimeFile(file io.ReadSeeker, smm *memsys.MMSA, mime, archname string) (m string, err error) {
 	m, err = Mime(mime, archname)
 	if err == nil || IsErrUnknownMime(err) {
 		return
@@ -164,7 +168,7 @@ func MimeFQN(smm *memsys.MMSA, mime, archname string) (m string, err error) {
 	return
 }
 
-func _detect(file *os.File, archname string, buf []byte) (m string, n int, err error) {
+func _detect(file io.Reader, archname string, buf []byte) (m string, n int, err error) {
 	n, err = file.Read(buf)
 	if err != nil {
 		return
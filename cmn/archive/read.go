@@ -14,6 +14,7 @@ import (
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -54,6 +55,10 @@ type (
 		tr  tarReader
 		lzr *lz4.Reader
 	}
+	zstReader struct {
+		tr  tarReader
+		zsr *zstd.Decoder
+	}
 )
 
 // interface guard
@@ -62,6 +67,7 @@ var (
 	_ Reader = (*tgzReader)(nil)
 	_ Reader = (*zipReader)(nil)
 	_ Reader = (*lz4Reader)(nil)
+	_ Reader = (*zstReader)(nil)
 )
 
 func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error) {
@@ -75,6 +81,8 @@ func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error)
 		ar = &zipReader{size: size[0]}
 	case ExtTarLz4:
 		ar = &lz4Reader{}
+	case ExtTarZst:
+		ar = &zstReader{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -203,6 +211,38 @@ func (lzr *lz4Reader) Range(filename string, rcb ReadCB) (cos.ReadCloseSizer, er
 	return lzr.tr.Range(filename, rcb)
 }
 
+// zstReader
+
+// NOTE: the caller must Close() returned decoder (to release its resources)
+func NewZstdReader(r io.Reader) (*zstd.Decoder, error) {
+	return zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+}
+
+func (zsr *zstReader) init(fh io.Reader) (err error) {
+	zsr.zsr, err = NewZstdReader(fh)
+	if err != nil {
+		return
+	}
+	zsr.tr.baseR.init(zsr.zsr)
+	zsr.tr.tr = tar.NewReader(zsr.zsr)
+	return
+}
+
+// (closing semantics - see tgzReader above)
+func (zsr *zstReader) Range(filename string, rcb ReadCB) (cos.ReadCloseSizer, error) {
+	reader, err := zsr.tr.Range(filename, rcb)
+	if err != nil {
+		zsr.zsr.Close()
+		return reader, err
+	}
+	if reader != nil {
+		csc := &cslClose{gzr: zsr.zsr.IOReadCloser() /*to close*/, R: reader /*to read from*/, N: reader.Size()}
+		return csc, err
+	}
+	zsr.zsr.Close()
+	return nil, nil
+}
+
 //
 // more limited readers
 //
@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -67,6 +68,10 @@ type (
 		tw  tarWriter
 		lzw *lz4.Writer
 	}
+	zstWriter struct {
+		tw  tarWriter
+		zsw *zstd.Encoder
+	}
 )
 
 // interface guard
@@ -75,6 +80,7 @@ var (
 	_ Writer = (*tgzWriter)(nil)
 	_ Writer = (*zipWriter)(nil)
 	_ Writer = (*lz4Writer)(nil)
+	_ Writer = (*zstWriter)(nil)
 )
 
 // calls init() -> open(),alloc()
@@ -88,6 +94,8 @@ func NewWriter(mime string, w io.Writer, cksum *cos.CksumHashSize, opts *Opts) (
 		aw = &zipWriter{}
 	case ExtTarLz4:
 		aw = &lz4Writer{}
+	case ExtTarZst:
+		aw = &zstWriter{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -263,3 +271,32 @@ func (lzw *lz4Writer) Copy(src io.Reader, _ ...int64) error {
 	lzr := lz4.NewReader(src)
 	return cpTar(lzr, lzw.tw.tw, lzw.tw.buf)
 }
+
+// zstWriter
+
+func (zsw *zstWriter) init(w io.Writer, cksum *cos.CksumHashSize, opts *Opts) {
+	var err error
+	zsw.tw.baseW.init(w, cksum, opts)
+	zsw.zsw, err = zstd.NewWriter(zsw.tw.wmul, zstd.WithEncoderConcurrency(1))
+	debug.AssertNoErr(err)
+	zsw.tw.tw = tar.NewWriter(zsw.zsw)
+}
+
+func (zsw *zstWriter) Fini() {
+	zsw.tw.Fini()
+	zsw.zsw.Close()
+}
+
+func (zsw *zstWriter) Write(fullname string, oah cos.OAH, reader io.Reader) error {
+	return zsw.tw.Write(fullname, oah, reader)
+}
+
+func (zsw *zstWriter) Copy(src io.Reader, _ ...int64) error {
+	zsr, err := NewZstdReader(src)
+	if err != nil {
+		return err
+	}
+	err = cpTar(zsr, zsw.tw.tw, zsw.tw.buf)
+	zsr.Close()
+	return err
+}
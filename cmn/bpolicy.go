@@ -0,0 +1,86 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket policy: per-principal (AuthN user) bucket permissions.
+// - complements (and, in case of Deny, restricts) permissions carried by AuthN tokens;
+// - bucket-wide permissions (S3 principal "*") are not stored here - they
+//   translate directly into `Bprops.Access`;
+// - takes effect only when AuthN is enabled (see proxy.access).
+// See also: ais/s3/policy.go (S3 bucket policy <=> BckPolicy)
+
+const PolicyMaxStmts = 100
+
+type (
+	BckPolicy struct {
+		ID         string          `json:"id,omitempty"`
+		Statements []BckPolicyStmt `json:"statements,omitempty"`
+	}
+	BckPolicyToSet struct {
+		ID         *string          `json:"id,omitempty"`
+		Statements *[]BckPolicyStmt `json:"statements,omitempty"`
+	}
+	BckPolicyStmt struct {
+		Sid        string          `json:"sid,omitempty"`
+		Principals []string        `json:"principals"` // AuthN user IDs
+		Access     apc.AccessAttrs `json:"access,string"`
+		Deny       bool            `json:"deny,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*BckPolicy)(nil)
+
+func (p *BckPolicy) ValidateAsProps(...any) error {
+	if len(p.Statements) > PolicyMaxStmts {
+		return fmt.Errorf("too many bucket policy statements: %d (max %d)", len(p.Statements), PolicyMaxStmts)
+	}
+	for i := range p.Statements {
+		stmt := &p.Statements[i]
+		if len(stmt.Principals) == 0 {
+			return fmt.Errorf("bucket policy statement %q: no principals", stmt.Sid)
+		}
+		for _, principal := range stmt.Principals {
+			if principal == "" || principal == "*" {
+				return fmt.Errorf("bucket policy statement %q: invalid principal %q", stmt.Sid, principal)
+			}
+		}
+		if stmt.Access == apc.AccessNone {
+			return fmt.Errorf("bucket policy statement %q: no permissions", stmt.Sid)
+		}
+	}
+	return nil
+}
+
+func (p *BckPolicy) String() string {
+	if len(p.Statements) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d statement(s)", len(p.Statements))
+}
+
+// returns permissions explicitly allowed and denied to a given user
+func (p *BckPolicy) Perms(uid string) (allow, deny apc.AccessAttrs) {
+	for i := range p.Statements {
+		stmt := &p.Statements[i]
+		if !cos.StringInSlice(uid, stmt.Principals) {
+			continue
+		}
+		if stmt.Deny {
+			deny |= stmt.Access
+		} else {
+			allow |= stmt.Access
+		}
+	}
+	return allow, deny
+}
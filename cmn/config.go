@@ -58,6 +58,7 @@ type (
 		HostNet   LocalNetConfig `json:"host_net"`
 		FSP       FSPConf        `json:"fspaths"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
+		Labels    cos.StrKVs     `json:"labels,omitempty"` // node labels, e.g. {"rack": "r7", "zone": "z1"} (see meta.Snode)
 	}
 
 	// ais node: (local) network config
@@ -76,6 +77,12 @@ type (
 	// ais node: fspaths (a.k.a. mountpaths)
 	FSPConf struct {
 		Paths cos.StrSet `json:"paths,omitempty" list:"readonly"`
+		// mountpath => storage class (apc.TierHot | apc.TierCold); omitted: apc.TierHot
+		Classes cos.StrKVs `json:"classes,omitempty" list:"readonly"`
+	}
+	// (FSPConf JSON: {"/mpath1": {}, "/mpath2": {"class": "cold"}, ...})
+	fspath struct {
+		Class string `json:"class,omitempty"`
 	}
 
 	TestFSPConf struct {
@@ -172,17 +179,26 @@ type (
 		User                string   `json:"user"`
 		UseDatanodeHostname bool     `json:"use_datanode_hostname"`
 	}
-	BackendConfAIS map[string][]string // cluster alias -> [urls...]
+	BackendConfAIS  map[string][]string // cluster alias -> [urls...]
+	BackendConfFile struct {
+		Root string `json:"root"` // absolute path; file://abc is the directory <root>/abc
+	}
 
 	MirrorConf struct {
-		Copies  int64 `json:"copies"`       // num copies
-		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
-		Enabled bool  `json:"enabled"`      // enabled (to generate copies)
+		// where to place copies: enum { apc.MirrorPlaceMpath (default), apc.MirrorPlaceTarget }
+		Placement string `json:"placement,omitempty" list:"omitempty"`
+		// (target placement only) failure domain: Snode label key, e.g. "rack" or "zone" (see LocalConfig.Labels)
+		Domain  string `json:"domain,omitempty" list:"omitempty"`
+		Copies  int64  `json:"copies"`       // num copies
+		Burst   int    `json:"burst_buffer"` // xaction channel (buffer) size
+		Enabled bool   `json:"enabled"`      // enabled (to generate copies)
 	}
 	MirrorConfToSet struct {
-		Copies  *int64 `json:"copies,omitempty"`
-		Burst   *int   `json:"burst_buffer,omitempty"`
-		Enabled *bool  `json:"enabled,omitempty"`
+		Placement *string `json:"placement,omitempty"`
+		Domain    *string `json:"domain,omitempty"`
+		Copies    *int64  `json:"copies,omitempty"`
+		Burst     *int    `json:"burst_buffer,omitempty"`
+		Enabled   *bool   `json:"enabled,omitempty"`
 	}
 
 	ECConf struct {
@@ -193,15 +209,33 @@ type (
 		ParitySlices int    `json:"parity_slices"`     // number of parity slices/replicas
 		Enabled      bool   `json:"enabled"`           // EC is enabled
 		DiskOnly     bool   `json:"disk_only"`         // if true, EC does not use SGL - data goes directly to drives
+		// size-tiered layouts (optional); when specified, the tiers take precedence for objects
+		// of size >= Tiers[0].MinSize, while DataSlices, ParitySlices, and ObjSizeLimit apply to smaller ones
+		Tiers []ECTier `json:"tiers,omitempty" list:"omitempty"`
+		// how often to run ec-scrub (verify and repair slices and replicas); zero disables periodic scrubbing
+		ScrubInterval cos.Duration `json:"scrub_interval,omitempty" list:"omitempty"`
+		// node label (failure domain, e.g. "host", "rack", or "zone") to spread slices and replicas across;
+		// empty: HRW placement with no regard to failure domains (see meta.Smap.HrwTargetDomains)
+		Domain string `json:"domain,omitempty" list:"omitempty"`
 	}
 	ECConfToSet struct {
-		ObjSizeLimit *int64  `json:"objsize_limit,omitempty"`
-		Compression  *string `json:"compression,omitempty"`
-		SbundleMult  *int    `json:"bundle_multiplier,omitempty"`
-		DataSlices   *int    `json:"data_slices,omitempty"`
-		ParitySlices *int    `json:"parity_slices,omitempty"`
-		Enabled      *bool   `json:"enabled,omitempty"`
-		DiskOnly     *bool   `json:"disk_only,omitempty"`
+		ObjSizeLimit *int64    `json:"objsize_limit,omitempty"`
+		Compression  *string   `json:"compression,omitempty"`
+		SbundleMult  *int      `json:"bundle_multiplier,omitempty"`
+		DataSlices   *int      `json:"data_slices,omitempty"`
+		ParitySlices *int      `json:"parity_slices,omitempty"`
+		Enabled      *bool     `json:"enabled,omitempty"`
+		DiskOnly     *bool     `json:"disk_only,omitempty"`
+		Tiers        *[]ECTier `json:"tiers,omitempty"`
+
+		ScrubInterval *cos.Duration `json:"scrub_interval,omitempty"`
+		Domain        *string       `json:"domain,omitempty"`
+	}
+	// EC layout for objects of size in the range [MinSize, next tier's MinSize)
+	ECTier struct {
+		MinSize      int64 `json:"min_size"`
+		DataSlices   int   `json:"data_slices"`   // zero: replicate (ParitySlices replicas)
+		ParitySlices int   `json:"parity_slices"` // number of parity slices or replicas
 	}
 
 	LogConf struct {
@@ -306,12 +340,16 @@ type (
 		// CapacityUpdTimeStr denotes the frequency at which AIStore updates local capacity utilization
 		CapacityUpdTime cos.Duration `json:"capacity_upd_time"`
 
+		// Policy: eviction policy - one of apc.SupportedLRUPolicies (empty: apc.LRUPolicyLRU)
+		Policy string `json:"policy,omitempty" list:"omitempty"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
 	LRUConfToSet struct {
 		DontEvictTime   *cos.Duration `json:"dont_evict_time,omitempty"`
 		CapacityUpdTime *cos.Duration `json:"capacity_upd_time,omitempty"`
+		Policy          *string       `json:"policy,omitempty"`
 		Enabled         *bool         `json:"enabled,omitempty"`
 	}
 
@@ -403,11 +441,17 @@ type (
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
+
+		// Number of previous versions to retain when overwriting or deleting an object
+		// (zero - keep only the latest version, the default). Applies to ais:// buckets only.
+		// See also: ListObjectVersions, apc.QparamObjVersion
+		Retain int `json:"retain"`
 	}
 	VersionConfToSet struct {
 		Enabled         *bool `json:"enabled,omitempty"`
 		ValidateWarmGet *bool `json:"validate_warm_get,omitempty"`
 		Sync            *bool `json:"synchronize,omitempty"`
+		Retain          *int  `json:"retain,omitempty"`
 	}
 
 	NetConf struct {
@@ -579,6 +623,14 @@ type (
 		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	// write-back (asynchronous) PUT to remote backend (see core/lwback.go)
+	WriteBackConf struct {
+		Enabled bool `json:"enabled"`
+	}
+	WriteBackConfToSet struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	}
 )
 
 // assorted named fields that require (cluster | node) restart for changes to make an effect
@@ -638,6 +690,7 @@ var (
 	_ Validator = (*WritePolicyConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
+	_ PropsValidator = (*LRUConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
 	_ PropsValidator = (*MirrorConf)(nil)
 	_ PropsValidator = (*ECConf)(nil)
@@ -673,6 +726,9 @@ func (c *Config) Validate() error {
 	if err := c.LocalConfig.TestFSP.Validate(c); err != nil {
 		return err
 	}
+	if err := c.LocalConfig.validateLabels(); err != nil {
+		return err
+	}
 
 	opts := IterOpts{VisitAll: true}
 	return IterFields(c, vdate, opts)
@@ -739,6 +795,40 @@ func (c *LocalConfig) AddPath(mpath string) {
 func (c *LocalConfig) DelPath(mpath string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths.Delete(mpath)
+	delete(c.FSP.Classes, mpath)
+}
+
+func (c *LocalConfig) validateLabels() error {
+	for k, v := range c.Labels {
+		if err := validateLabel(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLabel(k, v string) error {
+	if k == "" || v == "" || strings.ContainsAny(k, " \t=,") || strings.ContainsAny(v, " \t=,") {
+		return fmt.Errorf("invalid node label %q=%q (expecting non-empty key and value without spaces, '=', and ',')", k, v)
+	}
+	return nil
+}
+
+// parse comma-separated node labels, e.g. "rack=r7,zone=z1" (see env.AIS.NodeLabels)
+func ParseLabels(s string) (cos.StrKVs, error) {
+	labels := make(cos.StrKVs, 2)
+	for _, kv := range strings.Split(s, ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		k, v, _ := strings.Cut(kv, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if err := validateLabel(k, v); err != nil {
+			return nil, err
+		}
+		labels[k] = v
+	}
+	return labels, nil
 }
 
 ////////////////
@@ -870,6 +960,17 @@ func (c *BackendConf) Validate() (err error) {
 
 			c.Conf[provider] = hdfsConf
 			c.setProvider(provider)
+		case apc.File:
+			var fileConf BackendConfFile
+			if err := jsoniter.Unmarshal(b, &fileConf); err != nil {
+				return fmt.Errorf("invalid file:// backend specification: %v", err)
+			}
+			if !filepath.IsAbs(fileConf.Root) {
+				return fmt.Errorf("invalid file:// backend root %q (expecting absolute path)", fileConf.Root)
+			}
+			fileConf.Root = filepath.Clean(fileConf.Root)
+			c.Conf[provider] = fileConf
+			c.setProvider(provider)
 		case "":
 			continue
 		default:
@@ -882,7 +983,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.HDFS:
+	case apc.AWS, apc.Azure, apc.GCP, apc.HDFS, apc.File:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -1002,14 +1103,34 @@ func (c *LRUConf) String() string {
 	if !c.Enabled {
 		return "Disabled"
 	}
-	return fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	return fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v, lru.policy=%s",
+		c.DontEvictTime, c.CapacityUpdTime, c.EvictPolicy())
 }
 
 func (c *LRUConf) Validate() (err error) {
 	if c.CapacityUpdTime.D() < 10*time.Second {
-		err = fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
+		return fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
 	}
-	return
+	return c.ValidateAsProps()
+}
+
+func (c *LRUConf) ValidateAsProps(...any) error {
+	if c.Policy != "" && !apc.IsValidLRUPolicy(c.Policy) {
+		return fmt.Errorf("invalid lru.policy %q (expecting one of: %v)", c.Policy, apc.SupportedLRUPolicies)
+	}
+	return nil
+}
+
+func (c *LRUConf) EvictPolicy() string {
+	if c.Policy == "" {
+		return apc.LRUPolicyLRU
+	}
+	return c.Policy
+}
+
+// whether the policy requires per-object access counting (see core.LOM.IncAccessCnt)
+func (c *LRUConf) TrackFreq() bool {
+	return c.Enabled && (c.Policy == apc.LRUPolicyLFU || c.Policy == apc.LRUPolicyARC)
 }
 
 ///////////////
@@ -1052,10 +1173,18 @@ func (c *CksumConf) String() string {
 // VersionConf //
 /////////////////
 
+const MaxRetainVersions = 1000
+
 func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if c.Retain < 0 || c.Retain > MaxRetainVersions {
+		return fmt.Errorf("invalid versioning.retain %d (expecting range [0, %d])", c.Retain, MaxRetainVersions)
+	}
+	if !c.Enabled && c.Retain > 0 {
+		return errors.New("versioning.retain requires versioning to be enabled")
+	}
 	return nil
 }
 
@@ -1070,6 +1199,9 @@ func (c *VersionConf) String() string {
 	} else {
 		text += "no"
 	}
+	if c.Retain > 0 {
+		text += " | Retain: " + strconv.Itoa(c.Retain)
+	}
 
 	return text
 }
@@ -1085,9 +1217,22 @@ func (c *MirrorConf) Validate() error {
 	if c.Copies < 2 || c.Copies > 32 {
 		return fmt.Errorf("invalid mirror.copies: %d (expected value in range [2, 32])", c.Copies)
 	}
+	switch c.Placement {
+	case "", apc.MirrorPlaceMpath:
+		if c.Domain != "" {
+			return fmt.Errorf("invalid mirror.domain %q: failure domains require %q placement", c.Domain, apc.MirrorPlaceTarget)
+		}
+	case apc.MirrorPlaceTarget:
+	default:
+		return fmt.Errorf("invalid mirror.placement %q (expecting one of: %q, %q)",
+			c.Placement, apc.MirrorPlaceMpath, apc.MirrorPlaceTarget)
+	}
 	return nil
 }
 
+// copies are placed on different targets (rather than different mountpaths of the same target)
+func (c *MirrorConf) OnTargets() bool { return c.Placement == apc.MirrorPlaceTarget }
+
 func (c *MirrorConf) ValidateAsProps(...any) error {
 	if !c.Enabled {
 		return nil
@@ -1099,8 +1244,13 @@ func (c *MirrorConf) String() string {
 	if !c.Enabled {
 		return "Disabled"
 	}
-
-	return fmt.Sprintf("%d copies", c.Copies)
+	if !c.OnTargets() {
+		return fmt.Sprintf("%d copies", c.Copies)
+	}
+	if c.Domain == "" {
+		return fmt.Sprintf("%d copies (across targets)", c.Copies)
+	}
+	return fmt.Sprintf("%d copies (across %s domains)", c.Copies, c.Domain)
 }
 
 ////////////
@@ -1110,6 +1260,9 @@ func (c *MirrorConf) String() string {
 const (
 	MinSliceCount = 1  // minimum number of data or parity slices
 	MaxSliceCount = 32 // maximum --/--
+	MaxECTiers    = 8  // maximum number of size tiers (see ECConf.Tiers)
+
+	MinECScrubInterval = time.Hour // (see ECConf.ScrubInterval)
 )
 
 func (c *ECConf) Validate() error {
@@ -1130,6 +1283,34 @@ func (c *ECConf) Validate() error {
 	if !apc.IsValidCompression(c.Compression) {
 		return fmt.Errorf("invalid ec.compression: %q (expecting one of: %v)", c.Compression, apc.SupportedCompression)
 	}
+	if c.ScrubInterval != 0 && c.ScrubInterval.D() < MinECScrubInterval {
+		return fmt.Errorf("invalid ec.scrub_interval: %v (expecting zero (disabled) or >= %v)", c.ScrubInterval, MinECScrubInterval)
+	}
+	if c.Domain != "" && strings.ContainsAny(c.Domain, " \t=,") {
+		return fmt.Errorf("invalid ec.domain %q (expecting node label without spaces, '=', and ',')", c.Domain)
+	}
+	return c.validateTiers()
+}
+
+func (c *ECConf) validateTiers() error {
+	if len(c.Tiers) > MaxECTiers {
+		return fmt.Errorf("invalid ec.tiers: too many tiers (%d, max %d)", len(c.Tiers), MaxECTiers)
+	}
+	for i := range c.Tiers {
+		tier := &c.Tiers[i]
+		if tier.MinSize < 0 || (i > 0 && tier.MinSize <= c.Tiers[i-1].MinSize) {
+			return fmt.Errorf("invalid ec.tiers[%d]: min_size %d (expecting non-negative values in strictly ascending order)",
+				i, tier.MinSize)
+		}
+		if tier.DataSlices < 0 || tier.DataSlices > MaxSliceCount {
+			return fmt.Errorf("invalid ec.tiers[%d]: data_slices %d (expected value in range [0, %d])",
+				i, tier.DataSlices, MaxSliceCount)
+		}
+		if tier.ParitySlices < MinSliceCount || tier.ParitySlices > MaxSliceCount {
+			return fmt.Errorf("invalid ec.tiers[%d]: parity_slices %d (expected value in range [%d, %d])",
+				i, tier.ParitySlices, MinSliceCount, MaxSliceCount)
+		}
+	}
 	return nil
 }
 
@@ -1146,8 +1327,8 @@ func (c *ECConf) ValidateAsProps(arg ...any) (err error) {
 	if required <= targetCnt {
 		return
 	}
-	err = fmt.Errorf("%v: EC configuration (d=%d, p=%d slices) requires at least %d targets (have %d)",
-		ErrNotEnoughTargets, c.DataSlices, c.ParitySlices, required, targetCnt)
+	err = fmt.Errorf("%v: EC configuration (%s) requires at least %d targets (have %d)",
+		ErrNotEnoughTargets, c.layouts(), required, targetCnt)
 	if c.ParitySlices > targetCnt {
 		return
 	}
@@ -1159,12 +1340,64 @@ func (c *ECConf) String() string {
 		return "Disabled"
 	}
 	objSizeLimit := c.ObjSizeLimit
-	return fmt.Sprintf("%d:%d (%s)", c.DataSlices, c.ParitySlices, cos.ToSizeIEC(objSizeLimit, 0))
+	s := fmt.Sprintf("%d:%d (%s)", c.DataSlices, c.ParitySlices, cos.ToSizeIEC(objSizeLimit, 0))
+	for i := range c.Tiers {
+		tier := &c.Tiers[i]
+		s += fmt.Sprintf(", >=%s: %d:%d", cos.ToSizeIEC(tier.MinSize, 0), tier.DataSlices, tier.ParitySlices)
+	}
+	if c.Domain != "" {
+		s += fmt.Sprintf(" (across %s domains)", c.Domain)
+	}
+	return s
+}
+
+func (c *ECConf) layouts() string {
+	s := fmt.Sprintf("d=%d, p=%d slices", c.DataSlices, c.ParitySlices)
+	if len(c.Tiers) > 0 {
+		s += fmt.Sprintf(", %d size tiers", len(c.Tiers))
+	}
+	return s
+}
+
+// Layout returns EC layout (number of data and parity slices, or replicas) for
+// an object of a given size
+func (c *ECConf) Layout(size int64) (data, parity int, isCopy bool) {
+	for i := len(c.Tiers) - 1; i >= 0; i-- {
+		if tier := &c.Tiers[i]; size >= tier.MinSize {
+			return tier.DataSlices, tier.ParitySlices, tier.DataSlices == 0
+		}
+	}
+	return c.DataSlices, c.ParitySlices, size < c.ObjSizeLimit
 }
 
+// SameLayout returns true if all objects retain their respective EC layouts
+// (including placement across failure domains)
+func (c *ECConf) SameLayout(other *ECConf) bool {
+	if c.DataSlices != other.DataSlices || c.ParitySlices != other.ParitySlices || c.ObjSizeLimit != other.ObjSizeLimit {
+		return false
+	}
+	if c.Domain != other.Domain {
+		return false
+	}
+	if len(c.Tiers) != len(other.Tiers) {
+		return false
+	}
+	for i := range c.Tiers {
+		if c.Tiers[i] != other.Tiers[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// (the maximum across all layouts)
 func (c *ECConf) RequiredEncodeTargets() int {
 	// data slices + parity slices + 1 target for original object
-	return c.DataSlices + c.ParitySlices + 1
+	required := c.DataSlices + c.ParitySlices + 1
+	for i := range c.Tiers {
+		required = max(required, c.Tiers[i].DataSlices+c.Tiers[i].ParitySlices+1)
+	}
+	return required
 }
 
 func (c *ECConf) RequiredRestoreTargets() int {
@@ -1347,17 +1580,38 @@ func (c *DsortConf) ValidateWithOpts(allowEmpty bool) (err error) {
 /////////////
 
 func (c *FSPConf) UnmarshalJSON(data []byte) (err error) {
-	m := cos.NewStrSet()
+	var m map[string]fspath
 	err = jsoniter.Unmarshal(data, &m)
 	if err != nil {
 		return
 	}
-	c.Paths = m
+	c.Paths, c.Classes = make(cos.StrSet, len(m)), nil
+	for mpath, v := range m {
+		c.Paths.Set(mpath)
+		if v.Class != "" {
+			if c.Classes == nil {
+				c.Classes = make(cos.StrKVs, len(m))
+			}
+			c.Classes[mpath] = v.Class
+		}
+	}
 	return
 }
 
 func (c *FSPConf) MarshalJSON() (data []byte, err error) {
-	return cos.MustMarshal(c.Paths), nil
+	m := make(map[string]fspath, len(c.Paths))
+	for mpath := range c.Paths {
+		m[mpath] = fspath{Class: c.Classes[mpath]}
+	}
+	return cos.MustMarshal(m), nil
+}
+
+// returns storage class of a given (clean) mountpath
+func (c *FSPConf) Class(mpath string) string {
+	if class, ok := c.Classes[mpath]; ok {
+		return class
+	}
+	return apc.TierHot
 }
 
 func (c *FSPConf) Validate(contextConfig *Config) error {
@@ -1372,12 +1626,25 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		return NewErrInvalidFSPathsConf(ErrNoMountpaths)
 	}
 
-	cleanMpaths := make(map[string]struct{})
+	var (
+		cleanMpaths = make(map[string]struct{})
+		classes     cos.StrKVs
+	)
 	for fspath := range c.Paths {
 		mpath, err := ValidateMpath(fspath)
 		if err != nil {
 			return err
 		}
+		if class, ok := c.Classes[fspath]; ok {
+			if !apc.IsValidTier(class) {
+				err := fmt.Errorf("%q: invalid class %q (expecting %q or %q)", fspath, class, apc.TierHot, apc.TierCold)
+				return NewErrInvalidFSPathsConf(err)
+			}
+			if classes == nil {
+				classes = make(cos.StrKVs, len(c.Classes))
+			}
+			classes[mpath] = class
+		}
 		l := len(mpath)
 		// disallow mountpath nesting
 		for mpath2 := range cleanMpaths {
@@ -1391,7 +1658,7 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		}
 		cleanMpaths[mpath] = struct{}{}
 	}
-	c.Paths = cleanMpaths
+	c.Paths, c.Classes = cleanMpaths, classes
 	return nil
 }
 
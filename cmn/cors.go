@@ -0,0 +1,158 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Per-bucket CORS (cross-origin resource sharing) rules, S3 compatible.
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/cors.html
+// - ais/s3/cors.go (XML <=> JSON, and response headers)
+
+const (
+	CORSMaxRules = 100 // (S3 limit)
+	corsWildcard = "*"
+)
+
+type (
+	CORSConf struct {
+		Rules []CORSRule `json:"rules,omitempty"`
+	}
+	CORSConfToSet struct {
+		Rules *[]CORSRule `json:"rules,omitempty"`
+	}
+	CORSRule struct {
+		ID             string   `json:"id,omitempty"`
+		AllowedOrigins []string `json:"allowed_origins"` // may contain at most one "*" wildcard
+		AllowedMethods []string `json:"allowed_methods"` // GET, PUT, POST, DELETE, HEAD
+		AllowedHeaders []string `json:"allowed_headers,omitempty"`
+		ExposeHeaders  []string `json:"expose_headers,omitempty"`
+		MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*CORSConf)(nil)
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > CORSMaxRules {
+		return fmt.Errorf("too many CORS rules: %d (max %d)", len(c.Rules), CORSMaxRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CORSConf) String() string {
+	if len(c.Rules) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d rule(s)", len(c.Rules))
+}
+
+// Returns the first rule that allows a given (origin, method, request headers) - or nil.
+// NOTE: with no rules configured, cross-origin requests are not allowed (and CORS headers are not returned).
+func (c *CORSConf) Match(origin, method string, reqHeaders []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchOrigin(origin) && rule.matchMethod(method) && rule.matchHeaders(reqHeaders) {
+			return rule
+		}
+	}
+	return nil
+}
+
+//////////////
+// CORSRule //
+//////////////
+
+func (r *CORSRule) validate() error {
+	if len(r.AllowedOrigins) == 0 {
+		return fmt.Errorf("CORS rule %q: no allowed origins", r.ID)
+	}
+	for _, origin := range r.AllowedOrigins {
+		if strings.Count(origin, corsWildcard) > 1 {
+			return fmt.Errorf("CORS rule %q: origin %q contains more than one wildcard", r.ID, origin)
+		}
+	}
+	if len(r.AllowedMethods) == 0 {
+		return fmt.Errorf("CORS rule %q: no allowed methods", r.ID)
+	}
+	for _, method := range r.AllowedMethods {
+		switch method {
+		case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodHead:
+		default:
+			return fmt.Errorf("CORS rule %q: invalid method %q", r.ID, method)
+		}
+	}
+	if r.MaxAgeSeconds < 0 {
+		return fmt.Errorf("CORS rule %q: negative max-age", r.ID)
+	}
+	return nil
+}
+
+func (r *CORSRule) matchOrigin(origin string) bool {
+	for _, o := range r.AllowedOrigins {
+		if matchWildcard(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CORSRule) matchMethod(method string) bool {
+	for _, m := range r.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// all requested headers must be allowed
+func (r *CORSRule) matchHeaders(reqHeaders []string) bool {
+outer:
+	for _, h := range reqHeaders {
+		for _, a := range r.AllowedHeaders {
+			if matchWildcard(strings.ToLower(a), strings.ToLower(h)) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// whether any origin is allowed
+func (r *CORSRule) AnyOrigin() bool {
+	for _, o := range r.AllowedOrigins {
+		if o == corsWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// pattern with at most one "*"
+func matchWildcard(pattern, s string) bool {
+	i := strings.Index(pattern, corsWildcard)
+	if i < 0 {
+		return pattern == s
+	}
+	prefix, suffix := pattern[:i], pattern[i+1:]
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/OneOfOne/xxhash"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/zeebo/blake3"
+	"github.com/zeebo/xxh3"
 )
 
 // NOTE: not supporting SHA-3 family is its current golang.org/x/crypto/sha3 source
@@ -33,6 +35,8 @@ const (
 	ChecksumCRC32C = "crc32c"
 	ChecksumSHA256 = "sha256" // crypto.SHA512_256 (SHA-2)
 	ChecksumSHA512 = "sha512" // crypto.SHA512 (SHA-2)
+	ChecksumXXH128 = "xxh128" // XXH3 128-bit
+	ChecksumBLAKE3 = "blake3" // BLAKE3 256-bit
 )
 
 const (
@@ -43,6 +47,11 @@ const (
 type (
 	noopHash struct{}
 
+	// XXH3 128-bit digest (big-endian) as hash.Hash
+	xxh128 struct {
+		*xxh3.Hasher
+	}
+
 	ErrBadCksum struct {
 		prefix  string
 		a, b    any
@@ -70,6 +79,8 @@ var checksums = StrSet{
 	ChecksumCRC32C: {},
 	ChecksumSHA256: {},
 	ChecksumSHA512: {},
+	ChecksumXXH128: {},
+	ChecksumBLAKE3: {},
 }
 
 // interface guard
@@ -119,6 +130,10 @@ func (ck *CksumHash) Init(ty string) {
 		ck.H = sha256.New()
 	case ChecksumSHA512:
 		ck.H = sha512.New()
+	case ChecksumXXH128:
+		ck.H = xxh128{xxh3.New()}
+	case ChecksumBLAKE3:
+		ck.H = blake3.New()
 	default:
 		Assert(false)
 	}
@@ -238,6 +253,22 @@ func ValidateCksumType(ty string, emptyOK ...bool) (err error) {
 	return
 }
 
+// NOTE: unlike the rest of supported checksums, xxh128 and blake3 cannot save and restore
+// their intermediate state (see encoding.BinaryMarshaler), and therefore cannot be used
+// with multi-request append (apc.AppendOp)
+func IsResumableCksum(ty string) bool { return ty != ChecksumXXH128 && ty != ChecksumBLAKE3 }
+
+//
+// xxh128
+//
+
+func (h xxh128) Size() int { return 16 }
+
+func (h xxh128) Sum(b []byte) []byte {
+	sum := h.Sum128().Bytes()
+	return append(b, sum[:]...)
+}
+
 //
 // noopHash
 //
@@ -0,0 +1,36 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/zeebo/xxh3"
+)
+
+func TestCksumXXH128BLAKE3(t *testing.T) {
+	data := []byte("abc")
+	sum128 := xxh3.Hash128(data).Bytes()
+	tests := []struct {
+		ty, value string
+	}{
+		{ty: cos.ChecksumXXH128, value: hex.EncodeToString(sum128[:])},
+		{ty: cos.ChecksumBLAKE3, value: "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85"},
+	}
+	for _, test := range tests {
+		tassert.CheckFatal(t, cos.ValidateCksumType(test.ty))
+		tassert.Errorf(t, !cos.IsResumableCksum(test.ty), "%s: expecting non-resumable", test.ty)
+
+		ck := cos.NewCksumHash(test.ty)
+		ck.H.Write(data)
+		ck.Finalize()
+		tassert.Errorf(t, ck.Value() == test.value, "%s: expected %s, got %s", test.ty, test.value, ck.Value())
+		tassert.Errorf(t, len(ck.Sum()) == ck.H.Size(), "%s: size %d vs %d", test.ty, len(ck.Sum()), ck.H.Size())
+	}
+	tassert.Errorf(t, cos.IsResumableCksum(cos.ChecksumXXHash), "expecting xxhash resumable")
+}
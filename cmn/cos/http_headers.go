@@ -40,6 +40,18 @@ const (
 	HdrLocation  = "Location"
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Hdrs/ETag
+	HdrVary      = "Vary"
+
+	// CORS: https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS
+	HdrOrigin             = "Origin"
+	HdrACRequestMethod    = "Access-Control-Request-Method"
+	HdrACRequestHeaders   = "Access-Control-Request-Headers"
+	HdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	HdrACAllowMethods     = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	HdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	HdrACMaxAge           = "Access-Control-Max-Age"
+	HdrACAllowCredentials = "Access-Control-Allow-Credentials"
 )
 
 // provider-specific headers (=> custom props, and more)
@@ -50,20 +62,43 @@ const (
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/dev/UsingMetadata.html
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
-	S3CksumHeader   = "ETag"
-	S3VersionHeader = "x-amz-version-id"
+	S3CksumHeader     = "ETag"
+	S3VersionHeader   = "x-amz-version-id"
+	S3HdrDeleteMarker = "x-amz-delete-marker"
 
 	// s3 api request headers
 	S3HdrObjSrc        = "x-amz-copy-source"
 	S3HdrMptCnt        = "x-amz-mp-parts-count"
 	S3HdrContentSHA256 = "x-amz-content-sha256"
 	S3HdrBckRegion     = "x-amz-bucket-region"
+	S3HdrACL           = "x-amz-acl" // canned ACL
+
+	// server-side encryption (request and response)
+	S3HdrSSE         = "x-amz-server-side-encryption" // AES256 | aws:kms
+	S3HdrSSEKMSKeyID = "x-amz-server-side-encryption-aws-kms-key-id"
+
+	// object lock
+	S3HdrBckObjLockEnabled  = "x-amz-bucket-object-lock-enabled" // CreateBucket
+	S3HdrObjLockMode        = "x-amz-object-lock-mode"
+	S3HdrObjLockRetainUntil = "x-amz-object-lock-retain-until-date"
+	S3HdrObjLockLegalHold   = "x-amz-object-lock-legal-hold"
+	S3HdrBypassGovernance   = "x-amz-bypass-governance-retention"
+
+	// object tagging and user-defined metadata
+	S3HdrTagging           = "x-amz-tagging" // URL-encoded, e.g. "k1=v1&k2=v2"
+	S3HdrTaggingCount      = "x-amz-tagging-count"
+	S3HdrTaggingDirective  = "x-amz-tagging-directive"  // COPY (default) | REPLACE
+	S3HdrMetadataDirective = "x-amz-metadata-directive" // ditto
+	S3MetadataPrefix       = "x-amz-meta-"
 
-	S3ChecksumCRC32  = "x-amz-checksum-crc32"
-	S3ChecksumCRC32C = "x-amz-checksum-crc32c"
-	S3ChecksumSHA1   = "x-amz-checksum-sha1"
-	S3ChecksumSHA256 = "x-amz-checksum-sha256"
-	S3LastModified   = "Last-Modified"
+	S3ChecksumCRC32   = "x-amz-checksum-crc32"
+	S3ChecksumCRC32C  = "x-amz-checksum-crc32c"
+	S3ChecksumSHA1    = "x-amz-checksum-sha1"
+	S3ChecksumSHA256  = "x-amz-checksum-sha256"
+	S3ChecksumAlgo    = "x-amz-checksum-algorithm"     // CRC32 | CRC32C | SHA1 | SHA256
+	S3SDKChecksumAlgo = "x-amz-sdk-checksum-algorithm" // ditto
+	S3ChecksumMode    = "x-amz-checksum-mode"          // GET and HEAD: ENABLED
+	S3LastModified    = "Last-Modified"
 
 	S3MetadataChecksumType = "x-amz-meta-ais-cksum-type"
 	S3MetadataChecksumVal  = "x-amz-meta-ais-cksum-val"
@@ -997,6 +997,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		status = opts[0]
 	} else if errf, ok := err.(*ErrFailedTo); ok {
 		status = errf.status
+	} else if IsErrObjLocked(err) || IsErrQuotaExceeded(err) {
+		status = http.StatusForbidden
 	} else if isErrNotFoundExtended(err, status) {
 		status = http.StatusNotFound
 	}
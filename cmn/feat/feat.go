@@ -28,6 +28,7 @@ const (
 	DontAllowPassingFQNtoETL  // do not allow passing fully-qualified name of a locally stored object to (local) ETL containers
 	IgnoreLimitedCoexistence  // run in presence of "limited coexistence" type conflicts (same as e.g. CopyBckMsg.Force but globally)
 	DisableFastColdGET        // use regular datapath to execute cold-GET operations
+	AllowLocalETL             // when not running in Kubernetes, execute init-code ETLs as target-local processes
 )
 
 var All = []string{
@@ -41,6 +42,7 @@ var All = []string{
 	"Dont-Allow-Passing-FQN-to-ETL",
 	"Ignore-LimitedCoexistence-Conflicts",
 	"Disable-Fast-Cold-GET",
+	"Allow-Local-ETL",
 }
 
 func (f Flags) IsSet(flag Flags) bool { return cos.BitFlags(f).IsSet(cos.BitFlags(flag)) }
@@ -33,6 +33,9 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// target's write-back journal
+	WbJournal = ".ais.wbj"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
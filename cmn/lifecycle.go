@@ -0,0 +1,178 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket lifecycle: S3-compatible (subset of) rules to expire objects and abort stale multipart uploads.
+// See:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lifecycle-mgmt.html
+// - ais/s3/lifecycle.go (XML <=> JSON)
+// - xact/xs/lcycle.go (the xaction that enforces the rules)
+
+const (
+	LcycleMaxRules   = 1000 // (S3 limit)
+	LcycleMaxIDLen   = 255  // ditto
+	lcycleDayDur     = 24 * time.Hour
+	lcycleRuleNoName = "<unnamed>"
+)
+
+type (
+	LifecycleConf struct {
+		Rules   []LifecycleRule `json:"rules,omitempty"`
+		Enabled bool            `json:"enabled"`
+	}
+	LifecycleConfToSet struct {
+		Rules   *[]LifecycleRule `json:"rules,omitempty"`
+		Enabled *bool            `json:"enabled,omitempty"`
+	}
+
+	// single rule; filtering (prefix and tags) is AND-ed
+	LifecycleRule struct {
+		Tags        cos.StrKVs            `json:"tags,omitempty"`
+		ID          string                `json:"id"`
+		Prefix      string                `json:"prefix,omitempty"`
+		Transitions []LifecycleTransition `json:"transitions,omitempty"` // (not supported - see validate)
+		// expiration: either days since the last modification or absolute date (Unix nanoseconds)
+		ExpirationDays int   `json:"expiration_days,omitempty"`
+		ExpirationDate int64 `json:"expiration_date,omitempty"`
+		// abort incomplete multipart uploads initiated that many days ago
+		AbortMptDays int  `json:"abort_mpt_days,omitempty"`
+		Disabled     bool `json:"disabled,omitempty"`
+	}
+	LifecycleTransition struct {
+		StorageClass string `json:"storage_class"`
+		Days         int    `json:"days,omitempty"`
+		Date         int64  `json:"date,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*LifecycleConf)(nil)
+
+///////////////////
+// LifecycleConf //
+///////////////////
+
+func (c *LifecycleConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if len(c.Rules) > LcycleMaxRules {
+		return fmt.Errorf("too many lifecycle rules: %d (max %d)", len(c.Rules), LcycleMaxRules)
+	}
+	ids := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if rule.ID == "" {
+			continue
+		}
+		if ids.Contains(rule.ID) {
+			return fmt.Errorf("duplicate lifecycle rule ID %q", rule.ID)
+		}
+		ids.Set(rule.ID)
+	}
+	return nil
+}
+
+func (c *LifecycleConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	var n int
+	for i := range c.Rules {
+		if !c.Rules[i].Disabled {
+			n++
+		}
+	}
+	return fmt.Sprintf("%d rule(s), %d enabled", len(c.Rules), n)
+}
+
+// whether there's anything to do
+func (c *LifecycleConf) IsActive() bool {
+	if !c.Enabled {
+		return false
+	}
+	for i := range c.Rules {
+		if !c.Rules[i].Disabled {
+			return true
+		}
+	}
+	return false
+}
+
+///////////////////
+// LifecycleRule //
+///////////////////
+
+func (r *LifecycleRule) validate() error {
+	if len(r.ID) > LcycleMaxIDLen {
+		return fmt.Errorf("lifecycle rule ID is too long (%d, max %d)", len(r.ID), LcycleMaxIDLen)
+	}
+	if r.ExpirationDays < 0 || r.AbortMptDays < 0 || r.ExpirationDate < 0 {
+		return fmt.Errorf("lifecycle rule %q: negative days or date", r.Name())
+	}
+	if r.ExpirationDays > 0 && r.ExpirationDate > 0 {
+		return fmt.Errorf("lifecycle rule %q: expiration days and date are mutually exclusive", r.Name())
+	}
+	// storage class transitions are parsed (S3 API) but not executed - reject rather than silently ignore
+	if len(r.Transitions) > 0 {
+		return fmt.Errorf("lifecycle rule %q: transitions (storage class %q) are not supported",
+			r.Name(), r.Transitions[0].StorageClass)
+	}
+	if r.ExpirationDays == 0 && r.ExpirationDate == 0 && r.AbortMptDays == 0 {
+		return fmt.Errorf("lifecycle rule %q must specify at least one action", r.Name())
+	}
+	return nil
+}
+
+func (r *LifecycleRule) Name() string {
+	if r.ID == "" {
+		return lcycleRuleNoName
+	}
+	return r.ID
+}
+
+func (r *LifecycleRule) Expires() bool { return r.ExpirationDays > 0 || r.ExpirationDate > 0 }
+
+// NOTE: tags are matched against object's custom metadata (see TagObjMDPrefix)
+func (r *LifecycleRule) Match(objName string, custom cos.StrKVs) bool {
+	if r.Disabled || !strings.HasPrefix(objName, r.Prefix) {
+		return false
+	}
+	for k, v := range r.Tags {
+		if vv, ok := custom[TagObjMDPrefix+k]; !ok || vv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// given object's last modification time; zero when the rule does not expire objects
+func (r *LifecycleRule) ExpiresAt(mtime time.Time) time.Time {
+	switch {
+	case r.ExpirationDate > 0:
+		return time.Unix(0, r.ExpirationDate)
+	case r.ExpirationDays > 0:
+		return mtime.Add(time.Duration(r.ExpirationDays) * lcycleDayDur)
+	default:
+		return time.Time{}
+	}
+}
+
+// zero when not configured
+func (r *LifecycleRule) AbortMptAge() time.Duration {
+	return time.Duration(r.AbortMptDays) * lcycleDayDur
+}
@@ -37,6 +37,22 @@ const (
 
 	// additional backend
 	LastModified = "LastModified"
+
+	// S3 object tags and user-defined metadata (`x-amz-meta-*`), respectively
+	TagObjMDPrefix  = "tag."
+	UserObjMDPrefix = cos.S3MetadataPrefix
+
+	// retained version that marks object deletion (see VersionConf.Retain)
+	DeleteMarkerObjMD = "delete-marker"
+
+	// ID of the key the object is encrypted with (see SSEConf)
+	SSEKeyObjMD = "sse-key"
+
+	// object lock: retention mode, retain-until date (RFC 3339), and legal hold ("ON"), respectively
+	// (see ObjLockConf)
+	RetentionModeObjMD = "retention-mode"
+	RetainUntilObjMD   = "retain-until"
+	LegalHoldObjMD     = "legal-hold"
 )
 
 // object properties
@@ -93,6 +109,27 @@ func (oa *ObjAttrs) SetSize(size int64) {
 
 func CustomMD2S(md cos.StrKVs) string { return fmt.Sprintf("%+v", md) }
 
+// system-maintained custom metadata that user-facing APIs can neither set nor remove
+var sysObjMD = [...]string{DeleteMarkerObjMD, SSEKeyObjMD, RetentionModeObjMD, RetainUntilObjMD, LegalHoldObjMD}
+
+func IsSysObjMD(key string) bool {
+	for _, k := range sysObjMD {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// carry system metadata over from `from` to `to` (e.g., when replacing custom metadata)
+func CopySysObjMD(to, from cos.StrKVs) {
+	for _, k := range sysObjMD {
+		if v, ok := from[k]; ok {
+			to[k] = v
+		}
+	}
+}
+
 func S2CustomMD(custom, version string) (md cos.StrKVs) {
 	if len(custom) < 8 || !strings.HasPrefix(custom, "map[") { // Sprintf above
 		return nil
@@ -108,6 +145,16 @@ func S2CustomMD(custom, version string) (md cos.StrKVs) {
 	return md
 }
 
+// tag filter: "key" (any value) or "key=value" (see also apc.LsoMsg.Tag)
+func MatchTag(md cos.StrKVs, tag string) bool {
+	key, val, hasVal := strings.Cut(tag, "=")
+	v, ok := md[TagObjMDPrefix+key]
+	if !ok {
+		return false
+	}
+	return !hasVal || v == val
+}
+
 func parseCustom(md cos.StrKVs, lst []string, key string) {
 	keyX := key + ":"
 	for _, kv := range lst {
@@ -0,0 +1,107 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket and namespace quotas:
+// - bucket quota limits the total size and/or number of objects in a given bucket;
+// - namespace quota limits the same across all buckets of a given namespace; when more than one
+//   bucket in the namespace specifies namespace quota, the smallest (non-zero) limits apply;
+// - quotas are enforced by targets when adding new content (PUT, APPEND, promote, copy, transform),
+//   whereby each target allows its (equal) share of the quota (see QuotaShare).
+
+type (
+	QuotaConf struct {
+		MaxSize      int64 `json:"max_size,omitempty"`       // bucket: max total size (bytes); zero - unlimited
+		MaxObjects   int64 `json:"max_objects,omitempty"`    // bucket: max number of objects; zero - unlimited
+		NsMaxSize    int64 `json:"ns_max_size,omitempty"`    // namespace: max total size of all buckets in the namespace
+		NsMaxObjects int64 `json:"ns_max_objects,omitempty"` // namespace: max number of objects in all buckets in the namespace
+		Enabled      bool  `json:"enabled"`
+	}
+	QuotaConfToSet struct {
+		MaxSize      *int64 `json:"max_size,omitempty"`
+		MaxObjects   *int64 `json:"max_objects,omitempty"`
+		NsMaxSize    *int64 `json:"ns_max_size,omitempty"`
+		NsMaxObjects *int64 `json:"ns_max_objects,omitempty"`
+		Enabled      *bool  `json:"enabled,omitempty"`
+	}
+
+	ErrQuotaExceeded struct {
+		name  string // bucket or namespace
+		what  string // "size" | "objects"
+		limit int64
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*QuotaConf)(nil)
+
+func (c *QuotaConf) ValidateAsProps(...any) error {
+	if c.MaxSize < 0 || c.MaxObjects < 0 || c.NsMaxSize < 0 || c.NsMaxObjects < 0 {
+		return fmt.Errorf("invalid %s (expecting non-negative limits)", c)
+	}
+	if !c.Enabled && (c.MaxSize > 0 || c.MaxObjects > 0 || c.NsMaxSize > 0 || c.NsMaxObjects > 0) {
+		return errors.New("quota limits require quota to be enabled")
+	}
+	return nil
+}
+
+func (c *QuotaConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	s := "size: " + _limit(c.MaxSize, true) + ", objects: " + _limit(c.MaxObjects, false)
+	if c.HasNs() {
+		s += " (namespace size: " + _limit(c.NsMaxSize, true) + ", objects: " + _limit(c.NsMaxObjects, false) + ")"
+	}
+	return s
+}
+
+func _limit(v int64, size bool) string {
+	switch {
+	case v == 0:
+		return "unlimited"
+	case size:
+		return cos.ToSizeIEC(v, 2)
+	default:
+		return strconv.FormatInt(v, 10)
+	}
+}
+
+func (c *QuotaConf) HasBck() bool { return c.Enabled && (c.MaxSize > 0 || c.MaxObjects > 0) }
+func (c *QuotaConf) HasNs() bool  { return c.Enabled && (c.NsMaxSize > 0 || c.NsMaxObjects > 0) }
+
+// a given target's share of the (cluster-wide) limit
+func QuotaShare(limit int64, numTargets int) int64 {
+	if limit == 0 || numTargets <= 1 {
+		return limit
+	}
+	return (limit + int64(numTargets) - 1) / int64(numTargets)
+}
+
+//
+// ErrQuotaExceeded
+//
+
+func NewErrQuotaExceeded(name, what string, limit int64) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{name: name, what: what, limit: limit}
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("%s: quota exceeded (max %s: %s)", e.name, e.what, _limit(e.limit, e.what == "size"))
+}
+
+func IsErrQuotaExceeded(err error) bool {
+	var e *ErrQuotaExceeded
+	return errors.As(err, &e)
+}
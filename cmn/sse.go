@@ -0,0 +1,49 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Per-bucket server-side encryption (SSE) of objects at rest.
+// Keys are provided by the (target's) configured key provider - see cmn/sse and
+// docs/environment-vars.md; objects store only the ID of the key they were encrypted with
+// (custom metadata SSEKeyObjMD).
+
+const sseMaxKeyIDLen = 256
+
+type (
+	SSEConf struct {
+		KeyID   string `json:"key_id,omitempty"` // empty: provider's default key
+		Enabled bool   `json:"enabled"`
+	}
+	SSEConfToSet struct {
+		KeyID   *string `json:"key_id,omitempty"`
+		Enabled *bool   `json:"enabled,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*SSEConf)(nil)
+
+func (c *SSEConf) ValidateAsProps(...any) error {
+	if len(c.KeyID) > sseMaxKeyIDLen || strings.ContainsAny(c.KeyID, "/ \t\n") {
+		return fmt.Errorf("invalid SSE key ID %q", c.KeyID)
+	}
+	return nil
+}
+
+func (c *SSEConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	if c.KeyID == "" {
+		return "Enabled (default key)"
+	}
+	return "Enabled (key " + c.KeyID + ")"
+}
@@ -0,0 +1,255 @@
+// Package sse provides server-side encryption of objects at rest: chunked AES-GCM
+// framing (that supports random access and, therefore, range reads) and pluggable key providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package sse
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Key providers:
+// - local JSON keyfile (env.SSE.Keyfile), e.g.:
+//   {"default": "k1", "keys": {"k1": "<base64-encoded 32 bytes>", "k2": "..."}}
+// - KMS-like HTTP service (env.SSE.KeyURL) that responds to `GET <url>/keys/<key-id>`
+//   (and `GET <url>/keys/default`) with `{"id": "<key-id>", "key": "<base64-encoded 32 bytes>"}`;
+//   retrieved keys are cached in memory
+// Keys are never stored with objects - only key IDs (see cmn.SSEKeyObjMD).
+
+const httpTimeout = 10 * time.Second
+
+type (
+	Provider interface {
+		Key(id string) ([]byte, error)
+		DefaultKeyID() (string, error)
+	}
+
+	keyfile struct {
+		keys  map[string][]byte
+		deflt string
+	}
+	keyfileJSON struct {
+		Default string            `json:"default"`
+		Keys    map[string]string `json:"keys"`
+	}
+
+	httpProvider struct {
+		client *http.Client
+		keys   sync.Map // id => key
+		url    string
+		mu     sync.Mutex
+		deflt  string
+	}
+	keyJSON struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+)
+
+var (
+	provider Provider
+	pmu      sync.RWMutex
+)
+
+var errNoProvider = errors.New("sse: key provider is not configured (see " + env.SSE.Keyfile + ", " + env.SSE.KeyURL + ")")
+
+// interface guard
+var (
+	_ Provider = (*keyfile)(nil)
+	_ Provider = (*httpProvider)(nil)
+)
+
+// Init key provider from environment (no-op when not configured)
+func Init() error {
+	var (
+		p   Provider
+		err error
+	)
+	switch {
+	case os.Getenv(env.SSE.Keyfile) != "":
+		p, err = NewKeyfile(os.Getenv(env.SSE.Keyfile))
+	case os.Getenv(env.SSE.KeyURL) != "":
+		p, err = NewHTTPProvider(os.Getenv(env.SSE.KeyURL))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	SetProvider(p)
+	return nil
+}
+
+func SetProvider(p Provider) {
+	pmu.Lock()
+	provider = p
+	pmu.Unlock()
+}
+
+func getProvider() (p Provider, err error) {
+	pmu.RLock()
+	p = provider
+	pmu.RUnlock()
+	if p == nil {
+		err = errNoProvider
+	}
+	return
+}
+
+func Key(id string) ([]byte, error) {
+	p, err := getProvider()
+	if err != nil {
+		return nil, err
+	}
+	return p.Key(id)
+}
+
+func DefaultKeyID() (string, error) {
+	p, err := getProvider()
+	if err != nil {
+		return "", err
+	}
+	return p.DefaultKeyID()
+}
+
+func decodeKey(id, s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("sse: key %q: %v", id, err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("sse: key %q: invalid size %d (expecting %d)", id, len(key), KeySize)
+	}
+	return key, nil
+}
+
+/////////////
+// keyfile //
+/////////////
+
+func NewKeyfile(fqn string) (Provider, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	var kj keyfileJSON
+	if err := jsoniter.Unmarshal(b, &kj); err != nil {
+		return nil, fmt.Errorf("sse: keyfile %q: %v", fqn, err)
+	}
+	kf := &keyfile{keys: make(map[string][]byte, len(kj.Keys)), deflt: kj.Default}
+	for id, s := range kj.Keys {
+		if kf.keys[id], err = decodeKey(id, s); err != nil {
+			return nil, err
+		}
+	}
+	if kf.deflt != "" {
+		if _, ok := kf.keys[kf.deflt]; !ok {
+			return nil, fmt.Errorf("sse: keyfile %q: default key %q not found", fqn, kf.deflt)
+		}
+	}
+	return kf, nil
+}
+
+func (kf *keyfile) Key(id string) ([]byte, error) {
+	if key, ok := kf.keys[id]; ok {
+		return key, nil
+	}
+	return nil, cos.NewErrNotFound(nil, "sse key "+id)
+}
+
+func (kf *keyfile) DefaultKeyID() (string, error) {
+	if kf.deflt == "" {
+		return "", errors.New("sse: keyfile does not specify default key")
+	}
+	return kf.deflt, nil
+}
+
+//////////////////
+// httpProvider //
+//////////////////
+
+func NewHTTPProvider(u string) (Provider, error) {
+	if _, err := url.ParseRequestURI(u); err != nil {
+		return nil, fmt.Errorf("sse: invalid key service URL %q: %v", u, err)
+	}
+	return &httpProvider{url: u, client: cmn.NewClient(cmn.TransportArgs{Timeout: httpTimeout})}, nil
+}
+
+func (hp *httpProvider) Key(id string) ([]byte, error) {
+	if v, ok := hp.keys.Load(id); ok {
+		return v.([]byte), nil
+	}
+	kj, err := hp.get(id)
+	if err != nil {
+		return nil, err
+	}
+	key, err := decodeKey(id, kj.Key)
+	if err != nil {
+		return nil, err
+	}
+	hp.keys.Store(id, key)
+	return key, nil
+}
+
+func (hp *httpProvider) DefaultKeyID() (string, error) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if hp.deflt != "" {
+		return hp.deflt, nil
+	}
+	kj, err := hp.get("default")
+	if err != nil {
+		return "", err
+	}
+	if kj.ID == "" {
+		return "", errors.New("sse: key service returned empty default key ID")
+	}
+	key, err := decodeKey(kj.ID, kj.Key)
+	if err != nil {
+		return "", err
+	}
+	hp.keys.Store(kj.ID, key)
+	hp.deflt = kj.ID
+	return hp.deflt, nil
+}
+
+func (hp *httpProvider) get(id string) (*keyJSON, error) {
+	resp, err := hp.client.Get(hp.url + "/keys/" + url.PathEscape(id))
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, cos.NewErrNotFound(nil, "sse key "+id)
+		}
+		return nil, fmt.Errorf("sse: key service %q: %s", id, resp.Status)
+	}
+	kj := &keyJSON{}
+	if err := jsoniter.Unmarshal(b, kj); err != nil {
+		return nil, fmt.Errorf("sse: key %q: %v", id, err)
+	}
+	if kj.ID != "" && id != "default" && kj.ID != id {
+		nlog.Warningln("sse: key service returned", kj.ID, "for", id)
+	}
+	return kj, nil
+}
@@ -0,0 +1,175 @@
+// Package sse provides server-side encryption of objects at rest: chunked AES-GCM
+// framing (that supports random access and, therefore, range reads) and pluggable key providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package sse_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, sse.KeySize)
+	_, err := rand.Read(key)
+	tassert.CheckFatal(t, err)
+	return key
+}
+
+func encrypt(t *testing.T, plain, key []byte) []byte {
+	var buf bytes.Buffer
+	w, err := sse.NewWriter(&buf, key)
+	tassert.CheckFatal(t, err)
+	// odd-sized writes
+	for p := plain; len(p) > 0; {
+		n := min(len(p), 7777)
+		_, err := w.Write(p[:n])
+		tassert.CheckFatal(t, err)
+		p = p[n:]
+	}
+	tassert.CheckFatal(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	key := newKey(t)
+	for _, size := range []int{0, 1, sse.ChunkSize - 1, sse.ChunkSize, sse.ChunkSize + 1, 3*sse.ChunkSize + 100} {
+		plain := make([]byte, size)
+		rand.Read(plain)
+		enc := encrypt(t, plain, key)
+		tassert.Fatalf(t, int64(len(enc)) == sse.EncSize(int64(size)), "size %d: encrypted %d != %d",
+			size, len(enc), sse.EncSize(int64(size)))
+
+		r, err := sse.NewReader(bytes.NewReader(enc), int64(len(enc)), key)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, r.Size() == int64(size), "size %d: decrypted size %d", size, r.Size())
+		got, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(got, plain), "size %d: content mismatch", size)
+	}
+}
+
+func TestRangeRead(t *testing.T) {
+	var (
+		key   = newKey(t)
+		plain = make([]byte, 5*sse.ChunkSize+123)
+	)
+	rand.Read(plain)
+	enc := encrypt(t, plain, key)
+
+	fqn := filepath.Join(t.TempDir(), "obj")
+	tassert.CheckFatal(t, os.WriteFile(fqn, enc, 0o644))
+	f, err := sse.OpenFile(fqn, key)
+	tassert.CheckFatal(t, err)
+	defer f.Close()
+
+	for _, rng := range [][2]int64{{0, 10}, {sse.ChunkSize - 5, 10}, {2*sse.ChunkSize + 1, 3 * sse.ChunkSize}, {int64(len(plain)) - 7, 7}} {
+		got := make([]byte, rng[1])
+		_, err := f.ReadAt(got, rng[0])
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(got, plain[rng[0]:rng[0]+rng[1]]), "range %v: content mismatch", rng)
+	}
+}
+
+func TestTamper(t *testing.T) {
+	var (
+		key   = newKey(t)
+		plain = make([]byte, 2*sse.ChunkSize+10)
+	)
+	enc := encrypt(t, plain, key)
+
+	// flipped bit
+	bad := bytes.Clone(enc)
+	bad[sse.HdrSize+sse.ChunkSize+100] ^= 1
+	r, err := sse.NewReader(bytes.NewReader(bad), int64(len(bad)), key)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+	tassert.Fatalf(t, err != nil, "expected authentication error")
+
+	// truncated at a chunk boundary (the new last chunk is not marked as such)
+	n := sse.HdrSize + 2*(sse.ChunkSize+sse.TagSize)
+	r, err = sse.NewReader(bytes.NewReader(enc[:n]), int64(n), key)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+	tassert.Fatalf(t, err != nil, "expected error reading truncated content")
+
+	// wrong key
+	r, err = sse.NewReader(bytes.NewReader(enc), int64(len(enc)), newKey(t))
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+	tassert.Fatalf(t, err != nil, "expected error reading with a wrong key")
+}
+
+func TestKeyfile(t *testing.T) {
+	var (
+		k1  = newKey(t)
+		k2  = newKey(t)
+		fqn = filepath.Join(t.TempDir(), "keys.json")
+		s   = fmt.Sprintf(`{"default": "k2", "keys": {"k1": %q, "k2": %q}}`,
+			base64.StdEncoding.EncodeToString(k1), base64.StdEncoding.EncodeToString(k2))
+	)
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(s), 0o600))
+	p, err := sse.NewKeyfile(fqn)
+	tassert.CheckFatal(t, err)
+
+	id, err := p.DefaultKeyID()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, id == "k2", "expected default k2, got %q", id)
+	key, err := p.Key("k1")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(key, k1), "k1 mismatch")
+	_, err = p.Key("k3")
+	tassert.Fatalf(t, err != nil, "expected k3 not found")
+
+	// invalid key size
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(`{"keys": {"k1": "YWJj"}}`), 0o600))
+	_, err = sse.NewKeyfile(fqn)
+	tassert.Fatalf(t, err != nil, "expected invalid key size error")
+}
+
+func TestHTTPProvider(t *testing.T) {
+	var (
+		k1    = newKey(t)
+		calls int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		id := strings.TrimPrefix(r.URL.Path, "/keys/")
+		if id == "default" {
+			id = "k1"
+		}
+		if id != "k1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %q, "key": %q}`, id, base64.StdEncoding.EncodeToString(k1))
+	}))
+	defer srv.Close()
+
+	p, err := sse.NewHTTPProvider(srv.URL)
+	tassert.CheckFatal(t, err)
+	id, err := p.DefaultKeyID()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, id == "k1", "expected default k1, got %q", id)
+
+	key, err := p.Key("k1")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(key, k1), "k1 mismatch")
+	tassert.Fatalf(t, calls == 1, "expected cached key (calls: %d)", calls)
+
+	_, err = p.Key("k2")
+	tassert.Fatalf(t, err != nil, "expected k2 not found")
+}
@@ -5,14 +5,18 @@
 package tests_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
 )
 
 func TestConfigTestEnv(t *testing.T) {
@@ -72,3 +76,181 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestECConfTiers(t *testing.T) {
+	conf := cmn.ECConf{
+		Enabled:      true,
+		DataSlices:   2,
+		ParitySlices: 2,
+		ObjSizeLimit: 256 * cos.KiB,
+		Tiers: []cmn.ECTier{
+			{MinSize: cos.MiB, DataSlices: 0, ParitySlices: 2},
+			{MinSize: 16 * cos.MiB, DataSlices: 4, ParitySlices: 2},
+			{MinSize: cos.GiB, DataSlices: 10, ParitySlices: 4},
+		},
+	}
+	tassert.CheckFatal(t, conf.Validate())
+	tests := []struct {
+		size         int64
+		data, parity int
+		isCopy       bool
+	}{
+		{size: cos.KiB, data: 2, parity: 2, isCopy: true},
+		{size: 512 * cos.KiB, data: 2, parity: 2},
+		{size: cos.MiB, data: 0, parity: 2, isCopy: true},
+		{size: 100 * cos.MiB, data: 4, parity: 2},
+		{size: 10 * cos.GiB, data: 10, parity: 4},
+	}
+	for _, test := range tests {
+		data, parity, isCopy := conf.Layout(test.size)
+		if data != test.data || parity != test.parity || isCopy != test.isCopy {
+			t.Errorf("size %d: expected (%d, %d, %t), got (%d, %d, %t)",
+				test.size, test.data, test.parity, test.isCopy, data, parity, isCopy)
+		}
+	}
+	if n := conf.RequiredEncodeTargets(); n != 15 {
+		t.Errorf("expected 15 required targets, got %d", n)
+	}
+
+	other := conf
+	other.Tiers = append([]cmn.ECTier{}, conf.Tiers...)
+	tassert.Errorf(t, conf.SameLayout(&other), "expected same layout")
+	other.Tiers[1].ParitySlices = 3
+	tassert.Errorf(t, !conf.SameLayout(&other), "expected different layout")
+
+	other.Tiers[1].MinSize = other.Tiers[2].MinSize // not ascending
+	tassert.Errorf(t, other.Validate() != nil, "expected validation error (tiers order)")
+	other.Tiers[1].MinSize = 16 * cos.MiB
+	other.Tiers[1].ParitySlices = 0
+	tassert.Errorf(t, other.Validate() != nil, "expected validation error (zero parity)")
+}
+
+func TestECConfScrubInterval(t *testing.T) {
+	conf := cmn.ECConf{DataSlices: 2, ParitySlices: 2}
+	tassert.CheckFatal(t, conf.Validate())
+	conf.ScrubInterval = cos.Duration(time.Minute)
+	tassert.Errorf(t, conf.Validate() != nil, "expected validation error (scrub interval too short)")
+	conf.ScrubInterval = cos.Duration(24 * time.Hour)
+	tassert.CheckFatal(t, conf.Validate())
+
+	// not a part of EC layout
+	other := conf
+	other.ScrubInterval = 0
+	tassert.Errorf(t, conf.SameLayout(&other), "expected same layout")
+}
+
+func TestMirrorConfPlacement(t *testing.T) {
+	conf := cmn.MirrorConf{Copies: 3, Enabled: true}
+	tassert.CheckFatal(t, conf.Validate())
+	tassert.Errorf(t, !conf.OnTargets(), "expected mountpath placement by default")
+
+	conf.Domain = "rack"
+	tassert.Errorf(t, conf.Validate() != nil, "expected validation error (failure domain with mountpath placement)")
+	conf.Placement = apc.MirrorPlaceTarget
+	tassert.CheckFatal(t, conf.Validate())
+	tassert.Errorf(t, conf.OnTargets(), "expected target placement")
+
+	conf.Placement = "rack"
+	tassert.Errorf(t, conf.Validate() != nil, "expected validation error (invalid placement)")
+
+	bprops := cmn.Bprops{Provider: apc.AIS, Mirror: cmn.MirrorConf{Copies: 2, Enabled: true, Placement: apc.MirrorPlaceTarget}}
+	bprops.EC = cmn.ECConf{DataSlices: 1, ParitySlices: 1, Enabled: true}
+	tassert.Errorf(t, bprops.Validate(4) != nil, "expected validation error (mirroring across targets and EC)")
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := cmn.ParseLabels("rack=r7, zone=z1,,host=h2")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(labels) == 3, "expected 3 labels, got %v", labels)
+	tassert.Errorf(t, labels["rack"] == "r7" && labels["zone"] == "z1" && labels["host"] == "h2", "unexpected labels %v", labels)
+
+	for _, s := range []string{"rack", "rack=", "=r7", "rack=r 7", "rack=r7=r8"} {
+		_, err := cmn.ParseLabels(s)
+		tassert.Errorf(t, err != nil, "expected error parsing %q", s)
+	}
+}
+
+func TestECConfDomain(t *testing.T) {
+	conf := cmn.ECConf{DataSlices: 2, ParitySlices: 2, Enabled: true}
+	tassert.CheckFatal(t, conf.Validate())
+	other := conf
+	other.Domain = "host"
+	tassert.CheckFatal(t, other.Validate())
+	tassert.Errorf(t, !conf.SameLayout(&other), "expected different layouts (failure domains)")
+
+	other.Domain = "rack=r7"
+	tassert.Errorf(t, other.Validate() != nil, "expected validation error (invalid domain)")
+}
+
+func TestFSPConfClasses(t *testing.T) {
+	var conf cmn.FSPConf
+	err := jsoniter.Unmarshal([]byte(`{"/tmp/mp1": {}, "/tmp/mp2": {"class": "cold"}}`), &conf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(conf.Paths) == 2, "expected 2 mountpaths, got %d", len(conf.Paths))
+	tassert.Errorf(t, conf.Class("/tmp/mp1") == apc.TierHot, "expected %q, got %q", apc.TierHot, conf.Class("/tmp/mp1"))
+	tassert.Errorf(t, conf.Class("/tmp/mp2") == apc.TierCold, "expected %q, got %q", apc.TierCold, conf.Class("/tmp/mp2"))
+
+	b, err := jsoniter.Marshal(&conf)
+	tassert.CheckFatal(t, err)
+	var other cmn.FSPConf
+	tassert.CheckFatal(t, jsoniter.Unmarshal(b, &other))
+	tassert.Errorf(t, other.Class("/tmp/mp2") == apc.TierCold, "class lost in round-trip: %s", string(b))
+	tassert.Errorf(t, other.Class("/tmp/mp1") == apc.TierHot, "unexpected class in round-trip: %s", string(b))
+}
+
+func TestTierConf(t *testing.T) {
+	var (
+		now  = time.Now().UnixNano()
+		conf = cmn.TierConf{Enabled: true, ColdAge: cos.Duration(time.Hour), ColdSize: cos.MiB}
+	)
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+	tassert.Errorf(t, conf.Desired(cos.KiB, now, now) == apc.TierHot, "expected hot (recent and small)")
+	tassert.Errorf(t, conf.Desired(cos.MiB, now, now) == apc.TierCold, "expected cold (large)")
+	tassert.Errorf(t, conf.Desired(cos.KiB, now-2*int64(time.Hour), now) == apc.TierCold, "expected cold (not accessed)")
+
+	conf.Preferred = apc.TierCold
+	tassert.Errorf(t, conf.Desired(cos.KiB, now, now) == apc.TierCold, "expected cold (preferred)")
+
+	conf.Preferred = "warm"
+	tassert.Errorf(t, conf.ValidateAsProps() != nil, "expected validation error (invalid tier)")
+}
+
+func TestQuotaConf(t *testing.T) {
+	conf := cmn.QuotaConf{MaxSize: cos.GiB}
+	tassert.Errorf(t, conf.ValidateAsProps() != nil, "expected validation error (not enabled)")
+	conf.Enabled = true
+	tassert.CheckFatal(t, conf.ValidateAsProps())
+	tassert.Errorf(t, conf.HasBck() && !conf.HasNs(), "expected bucket (only) quota: %s", conf.String())
+
+	conf.NsMaxObjects = -1
+	tassert.Errorf(t, conf.ValidateAsProps() != nil, "expected validation error (negative limit)")
+	conf.NsMaxObjects = 1000
+	tassert.Errorf(t, conf.HasNs(), "expected namespace quota: %s", conf.String())
+
+	tassert.Errorf(t, cmn.QuotaShare(1000, 3) == 334, "expected 334, got %d", cmn.QuotaShare(1000, 3))
+	tassert.Errorf(t, cmn.QuotaShare(1000, 1) == 1000, "expected 1000, got %d", cmn.QuotaShare(1000, 1))
+
+	summ := &cmn.BsummResult{}
+	summ.TotalSize.PresentObjs = cos.GiB / 4
+	summ.SetQuota(&conf)
+	tassert.Fatalf(t, summ.Quota != nil, "expected quota in summary")
+	tassert.Errorf(t, summ.Quota.SizePct == 25, "expected 25%%, got %d%%", summ.Quota.SizePct)
+
+	err := cmn.NewErrQuotaExceeded("ais://abc", "size", cos.GiB)
+	tassert.Errorf(t, cmn.IsErrQuotaExceeded(fmt.Errorf("wrapped: %w", err)), "expected quota error")
+}
+
+func TestExtraPropsAWS(t *testing.T) {
+	extra := cmn.ExtraProps{AWS: cmn.ExtraPropsAWS{Endpoint: "http://localhost:9000", PathStyle: true}}
+	tassert.Errorf(t, extra.ValidateAsProps(apc.AWS) != nil, "expected validation error (not in s3compat mode)")
+
+	extra.AWS.S3Compat = true
+	extra.AWS.SigVersion = cmn.S3SigV2
+	tassert.CheckFatal(t, extra.ValidateAsProps(apc.AWS))
+
+	extra.AWS.PathStyle = false
+	tassert.Errorf(t, extra.ValidateAsProps(apc.AWS) != nil, "expected validation error (v2 requires path-style)")
+
+	extra.AWS.SigVersion = "v3"
+	tassert.Errorf(t, extra.ValidateAsProps(apc.AWS) != nil, "expected validation error (invalid signature version)")
+}
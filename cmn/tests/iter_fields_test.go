@@ -80,6 +80,7 @@ var _ = Describe("IterFields", func() {
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
 					"versioning.synchronize":       false,
+					"versioning.retain":            0,
 
 					"checksum.type":              cos.ChecksumXXHash,
 					"checksum.validate_warm_get": false,
@@ -94,6 +95,11 @@ var _ = Describe("IterFields", func() {
 					"extra.aws.cloud_region": "us-central",
 					"extra.aws.endpoint":     "",
 					"extra.aws.profile":      "",
+					"extra.aws.s3compat":     false,
+					"extra.aws.path_style":   false,
+					"extra.aws.ca_cert":      "",
+					"extra.aws.creds_id":     "",
+					"extra.aws.sig_version":  "",
 
 					"access":  apc.AccessAttrs(0),
 					"created": int64(0),
@@ -124,6 +130,8 @@ var _ = Describe("IterFields", func() {
 					"mirror.enabled":      (*bool)(nil),
 					"mirror.copies":       (*int64)(nil),
 					"mirror.burst_buffer": (*int)(nil),
+					"mirror.placement":    (*string)(nil),
+					"mirror.domain":       (*string)(nil),
 
 					"ec.enabled":           apc.Bool(true),
 					"ec.parity_slices":     apc.Int(1024),
@@ -132,10 +140,14 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       (*string)(nil),
 					"ec.bundle_multiplier": (*int)(nil),
 					"ec.disk_only":         (*bool)(nil),
+					"ec.tiers":             (*[]cmn.ECTier)(nil),
+					"ec.scrub_interval":    (*cos.Duration)(nil),
+					"ec.domain":            (*string)(nil),
 
 					"versioning.enabled":           (*bool)(nil),
 					"versioning.validate_warm_get": (*bool)(nil),
 					"versioning.synchronize":       (*bool)(nil),
+					"versioning.retain":            (*int)(nil),
 
 					"checksum.type":              apc.String(cos.ChecksumXXHash),
 					"checksum.validate_warm_get": (*bool)(nil),
@@ -146,6 +158,7 @@ var _ = Describe("IterFields", func() {
 					"lru.enabled":           (*bool)(nil),
 					"lru.dont_evict_time":   (*cos.Duration)(nil),
 					"lru.capacity_upd_time": (*cos.Duration)(nil),
+					"lru.policy":            (*string)(nil),
 
 					"access": apc.AccAttrs(1024),
 
@@ -156,7 +169,40 @@ var _ = Describe("IterFields", func() {
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
 					"extra.aws.profile":        (*string)(nil),
+					"extra.aws.s3compat":       (*bool)(nil),
+					"extra.aws.path_style":     (*bool)(nil),
+					"extra.aws.ca_cert":        (*string)(nil),
+					"extra.aws.creds_id":       (*string)(nil),
+					"extra.aws.sig_version":    (*string)(nil),
 					"extra.http.original_url":  (*string)(nil),
+
+					"lifecycle.rules":   (*[]cmn.LifecycleRule)(nil),
+					"lifecycle.enabled": (*bool)(nil),
+
+					"policy.id":         (*string)(nil),
+					"policy.statements": (*[]cmn.BckPolicyStmt)(nil),
+
+					"cors.rules": (*[]cmn.CORSRule)(nil),
+
+					"sse.key_id":  (*string)(nil),
+					"sse.enabled": (*bool)(nil),
+
+					"object_lock.mode":    (*string)(nil),
+					"object_lock.days":    (*int)(nil),
+					"object_lock.enabled": (*bool)(nil),
+
+					"tier.preferred": (*string)(nil),
+					"tier.cold_age":  (*cos.Duration)(nil),
+					"tier.cold_size": (*int64)(nil),
+					"tier.enabled":   (*bool)(nil),
+
+					"quota.max_size":       (*int64)(nil),
+					"quota.max_objects":    (*int64)(nil),
+					"quota.ns_max_size":    (*int64)(nil),
+					"quota.ns_max_objects": (*int64)(nil),
+					"quota.enabled":        (*bool)(nil),
+
+					"write_back.enabled": (*bool)(nil),
 				},
 			),
 			Entry("check for omit tag",
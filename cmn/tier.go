@@ -0,0 +1,93 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Tiered storage:
+// - each mountpath belongs to one of the two classes: apc.TierHot (default) or apc.TierCold (see FSPConf);
+// - new objects are stored on the bucket's preferred tier;
+// - tier-migrate xaction moves objects between tiers based on access time and size (see TierConf.Desired);
+// - objects that reside on the non-preferred tier are located via redirection markers (see core/ltier.go).
+
+type (
+	TierConf struct {
+		Preferred string       `json:"preferred,omitempty"` // apc.TierHot (default) | apc.TierCold - where new objects are stored
+		ColdAge   cos.Duration `json:"cold_age,omitempty"`  // move to cold tier objects not accessed for this long (zero: never)
+		ColdSize  int64        `json:"cold_size,omitempty"` // move to cold tier objects of this size or larger (zero: never)
+		Enabled   bool         `json:"enabled"`
+	}
+	TierConfToSet struct {
+		Preferred *string       `json:"preferred,omitempty"`
+		ColdAge   *cos.Duration `json:"cold_age,omitempty"`
+		ColdSize  *int64        `json:"cold_size,omitempty"`
+		Enabled   *bool         `json:"enabled,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*TierConf)(nil)
+
+func (c *TierConf) ValidateAsProps(...any) error {
+	if c.Preferred != "" && !apc.IsValidTier(c.Preferred) {
+		return fmt.Errorf("invalid tier.preferred %q (expecting %q or %q)", c.Preferred, apc.TierHot, apc.TierCold)
+	}
+	if c.ColdAge < 0 {
+		return fmt.Errorf("invalid tier.cold_age %v (expecting non-negative duration)", c.ColdAge)
+	}
+	if c.ColdSize < 0 {
+		return fmt.Errorf("invalid tier.cold_size %d (expecting non-negative size)", c.ColdSize)
+	}
+	return nil
+}
+
+func (c *TierConf) PreferredTier() string {
+	if c.Preferred == "" {
+		return apc.TierHot
+	}
+	return c.Preferred
+}
+
+// Desired returns the tier an object with a given size and access time (ns) must reside on
+func (c *TierConf) Desired(size, atime, now int64) string {
+	if c.PreferredTier() == apc.TierCold {
+		return apc.TierCold
+	}
+	if c.ColdAge > 0 && atime > 0 && now-atime > int64(c.ColdAge) {
+		return apc.TierCold
+	}
+	if c.ColdSize > 0 && size >= c.ColdSize {
+		return apc.TierCold
+	}
+	return apc.TierHot
+}
+
+func (c *TierConf) String() string {
+	if !c.Enabled {
+		return "Disabled"
+	}
+	s := "preferred " + c.PreferredTier()
+	if c.ColdAge > 0 {
+		s += fmt.Sprintf(", cold after %v", c.ColdAge)
+	}
+	if c.ColdSize > 0 {
+		s += ", cold size >= " + cos.ToSizeIEC(c.ColdSize, 0)
+	}
+	return s
+}
+
+// returns the other one
+func OtherTier(tier string) string {
+	if tier == apc.TierCold {
+		return apc.TierHot
+	}
+	return apc.TierCold
+}
@@ -39,7 +39,7 @@ const (
 	MetaverVMD   = 1 // Volume MD (jsp)
 	MetaverEtlMD = 1 // ETL MD (jsp)
 
-	MetaverLOM = 1 // LOM
+	MetaverLOM = 2 // LOM (v2: per-object access count; v1 remains readable - see core/lom_xattr.go)
 
 	MetaverConfig      = 3 // Global Configuration (jsp)
 	MetaverAuthNConfig = 1 // Authn config (jsp) // ditto
@@ -284,8 +284,19 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 		dstFQN    = dst.FQN
 		srcCksum  = lom.Checksum()
 		cksumType = cos.ChecksumNone
+		dstKeyID  string
+		asis      = true // (encrypted: copying ciphertext as is)
 	)
-	if !srcCksum.IsEmpty() {
+	// SSE: destination bucket that has no key or a different one - decrypt and (re)encrypt
+	if lom.IsEncrypted() || dst.Bprops().SSE.Enabled {
+		if !dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/) {
+			if dstKeyID, err = dst.SSEKeyToUse(); err != nil {
+				return err
+			}
+			asis = dstKeyID == lom.SSEKeyID()
+		}
+	}
+	if !srcCksum.IsEmpty() && (!lom.IsEncrypted() || !asis) {
 		cksumType = srcCksum.Ty()
 	}
 	if dst.isMirror(lom) && lom.md.copies != nil {
@@ -300,7 +311,11 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	}
 
 	workFQN := fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
+	if asis {
+		_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
+	} else {
+		dstCksum, err = lom.copyCrypt(dst, workFQN, dstKeyID, buf, cksumType)
+	}
 	if err != nil {
 		return
 	}
@@ -416,6 +431,9 @@ func (lom *LOM) ToMpath() (mi *fs.Mountpath, isHrw bool) {
 		availablePaths = fs.GetAvail()
 		hrwMi, _, err  = fs.Hrw(lom.md.uname)
 	)
+	if lom.tiered() {
+		hrwMi, _, err = fs.HrwTier(lom.md.uname, lom.mi.Class) // (stay on the same tier)
+	}
 	if err != nil {
 		nlog.Errorln(err)
 		return
@@ -42,7 +42,7 @@ func (r *deferROC) Close() (err error) {
 
 // is called under rlock; unlocks on fail
 func (lom *LOM) NewDeferROC() (cos.ReadOpenCloser, error) {
-	fh, err := cos.NewFileHandle(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err == nil {
 		return &deferROC{fh, lom.LIF()}, nil
 	}
@@ -115,6 +115,10 @@ func (lom *LOM) CheckRemoteMD(locked, sync bool) (bool /*equal*/, int, error) {
 		// that doesn't provide any versioning metadata
 		return true, 0, nil
 	}
+	if WbPending(lom.Uname()) {
+		// not uploaded yet (write-back): local copy is the latest
+		return true, 0, nil
+	}
 
 	oa, errCode, err := T.Backend(bck).HeadObj(context.Background(), lom)
 	if err == nil {
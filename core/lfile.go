@@ -52,10 +52,14 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 		return exclusive || (len(force) > 0 && force[0] && rc > 0)
 	})
 	lom.Uncache()
+	u, size := lom.usageRm()
 	err = cos.RemoveFile(lom.FQN)
 	if os.IsNotExist(err) {
 		err = nil
+	} else if err == nil && u != nil {
+		u.Add(-size, -1)
 	}
+	lom.delRedir()
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !os.IsNotExist(erc) {
 			err = erc
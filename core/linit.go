@@ -61,7 +61,10 @@ func (lom *LOM) InitFQN(fqn string, expbck *cmn.Bck) (err error) {
 		return
 	}
 	lom.md.uname = lom.bck.MakeUname(lom.ObjName)
-	return nil
+	if lom.tiered() {
+		lom.HrwFQN, err = lom.tierHrwFQN()
+	}
+	return
 }
 
 func (lom *LOM) InitCT(ct *CT) {
@@ -82,7 +85,7 @@ func (lom *LOM) InitBck(bck *cmn.Bck) (err error) {
 		return
 	}
 	lom.md.uname = lom.bck.MakeUname(lom.ObjName)
-	lom.mi, lom.digest, err = fs.Hrw(lom.md.uname)
+	lom.mi, lom.digest, err = lom.hrwMpath()
 	if err != nil {
 		return
 	}
@@ -0,0 +1,123 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Object lock (WORM) - see cmn.ObjLockConf
+// - retention and legal hold are kept in custom metadata;
+// - all methods in this source require the object to be loaded (and locked, when modifying).
+
+func (lom *LOM) Retention() (mode string, until time.Time) {
+	mode, ok := lom.GetCustomKey(cmn.RetentionModeObjMD)
+	if !ok {
+		return "", until
+	}
+	s, _ := lom.GetCustomKey(cmn.RetainUntilObjMD)
+	until, err := cmn.ParseRetainUntil(s)
+	if err != nil {
+		nlog.Errorln(lom.String()+":", err)
+	}
+	return mode, until
+}
+
+func (lom *LOM) LegalHold() bool {
+	v, _ := lom.GetCustomKey(cmn.LegalHoldObjMD)
+	return v == cmn.LegalHoldOn
+}
+
+// returns cmn.ErrObjLocked if the object cannot be overwritten, deleted, renamed, or evicted
+func (lom *LOM) CheckObjLock(bypassGovernance bool) error {
+	if !lom.Bprops().ObjLock.Enabled {
+		return nil
+	}
+	if lom.LegalHold() {
+		return cmn.NewErrObjLocked(lom.Cname(), "", time.Time{}, true)
+	}
+	mode, until := lom.Retention()
+	if mode == "" || !time.Now().Before(until) {
+		return nil
+	}
+	if mode == apc.ObjLockGovernance && bypassGovernance {
+		return nil
+	}
+	return cmn.NewErrObjLocked(lom.Cname(), mode, until, false)
+}
+
+// new objects inherit bucket's default retention (unless specified otherwise)
+func (lom *LOM) SetDefaultRetention(now time.Time) {
+	conf := &lom.Bprops().ObjLock
+	if _, ok := lom.GetCustomKey(cmn.RetentionModeObjMD); ok {
+		return
+	}
+	if until := conf.DefaultRetainUntil(now); !until.IsZero() {
+		lom.SetCustomKey(cmn.RetentionModeObjMD, conf.Mode)
+		lom.SetCustomKey(cmn.RetainUntilObjMD, cmn.FormatRetainUntil(until))
+	}
+}
+
+// set, extend, shorten, or remove (empty mode and date) object retention, whereby:
+// - compliance-mode retention can only be extended;
+// - governance-mode retention can be shortened or removed only when bypassing governance
+func (lom *LOM) SetRetention(r *apc.ObjRetention, bypassGovernance bool) error {
+	if !lom.Bprops().ObjLock.Enabled {
+		return errObjLockDisabled(lom)
+	}
+	var (
+		newUntil time.Time
+		now      = time.Now()
+	)
+	if r.Mode != "" || r.RetainUntil != "" {
+		if !cmn.IsValidRetentionMode(r.Mode) {
+			return errors.New("invalid retention mode \"" + r.Mode + "\"")
+		}
+		var err error
+		if newUntil, err = cmn.ParseRetainUntil(r.RetainUntil); err != nil {
+			return err
+		}
+		if !newUntil.After(now) {
+			return errors.New("retain-until date must be in the future")
+		}
+	}
+	if mode, until := lom.Retention(); mode != "" && now.Before(until) {
+		weaker := r.Mode == "" || newUntil.Before(until)
+		switch {
+		case mode == apc.ObjLockCompliance && (weaker || r.Mode != apc.ObjLockCompliance):
+			return cmn.NewErrObjLocked(lom.Cname(), mode, until, false)
+		case mode == apc.ObjLockGovernance && weaker && !bypassGovernance:
+			return cmn.NewErrObjLocked(lom.Cname(), mode, until, false)
+		}
+	}
+	if r.Mode == "" {
+		lom.md.DelCustomKeys(cmn.RetentionModeObjMD, cmn.RetainUntilObjMD)
+	} else {
+		lom.SetCustomKey(cmn.RetentionModeObjMD, r.Mode)
+		lom.SetCustomKey(cmn.RetainUntilObjMD, cmn.FormatRetainUntil(newUntil))
+	}
+	return nil
+}
+
+func (lom *LOM) SetLegalHold(on bool) error {
+	if !lom.Bprops().ObjLock.Enabled {
+		return errObjLockDisabled(lom)
+	}
+	if on {
+		lom.SetCustomKey(cmn.LegalHoldObjMD, cmn.LegalHoldOn)
+	} else {
+		lom.md.DelCustomKeys(cmn.LegalHoldObjMD)
+	}
+	return nil
+}
+
+func errObjLockDisabled(lom *LOM) error {
+	return errors.New(lom.Bck().Cname("") + ": object lock is not enabled")
+}
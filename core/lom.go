@@ -50,8 +50,9 @@ type (
 		copies fs.MPI
 		uname  string
 		cmn.ObjAttrs
-		atimefs uint64 // NOTE: high bit is reserved for `dirty`
-		bckID   uint64
+		atimefs   uint64 // NOTE: high bit is reserved for `dirty`
+		bckID     uint64
+		accessCnt uint64 // number of GETs (only when tracked - see cmn.LRUConf.TrackFreq)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -165,6 +166,17 @@ func (lom *LOM) Atime() time.Time      { return time.Unix(0, lom.md.Atime) }
 func (lom *LOM) AtimeUnix() int64      { return lom.md.Atime }
 func (lom *LOM) SetAtimeUnix(tu int64) { lom.md.Atime = tu }
 
+func (lom *LOM) AccessCnt() uint64     { return lom.md.accessCnt }
+func (lom *LOM) SetAccessCnt(n uint64) { lom.md.accessCnt = n }
+
+// count GETs for frequency-based eviction policies; the counter is kept in lcache
+// and persisted along with atime (see flushCold) - without making metadata dirty
+func (lom *LOM) IncAccessCnt() {
+	if bprops := lom.Bprops(); bprops != nil && bprops.LRU.TrackFreq() {
+		lom.md.accessCnt++
+	}
+}
+
 // custom metadata
 func (lom *LOM) GetCustomMD() cos.StrKVs   { return lom.md.GetCustomMD() }
 func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
@@ -371,11 +383,11 @@ func (lom *LOM) ComputeSetCksum() (*cos.Cksum, error) {
 }
 
 func (lom *LOM) ComputeCksum(cksumType string) (cksum *cos.CksumHash, err error) {
-	var file *os.File
+	var file LomHandle
 	if cksumType == cos.ChecksumNone {
 		return
 	}
-	if file, err = os.Open(lom.FQN); err != nil {
+	if file, err = lom.NewHandle(); err != nil {
 		return
 	}
 	// No need to allocate `buf` as `io.Discard` has efficient `io.ReaderFrom` implementation.
@@ -559,7 +571,7 @@ func (lom *LOM) FromFS() error {
 		return err
 	}
 	// fstat & atime
-	if lom.md.Size != finfo.Size() { // corruption or tampering
+	if lom.SizeOnDisk() != finfo.Size() { // corruption or tampering
 		return cmn.NewErrLmetaCorrupted(lom.whingeSize(finfo.Size()))
 	}
 	lom.md.Atime = atimefs
@@ -568,7 +580,7 @@ func (lom *LOM) FromFS() error {
 }
 
 func (lom *LOM) whingeSize(size int64) error {
-	return fmt.Errorf("errsize (%d != %d)", lom.md.Size, size)
+	return fmt.Errorf("errsize (%d != %d)", lom.SizeOnDisk(), size)
 }
 
 func lomCaches() []*sync.Map {
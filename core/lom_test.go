@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
@@ -33,6 +34,8 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketLocalL = "LOM_TEST_Local_Lock"
+		bucketLocalT = "LOM_TEST_Local_Tier"
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -43,6 +46,8 @@ var _ = Describe("LOM", func() {
 	var (
 		localBckA = cmn.Bck{Name: bucketLocalA, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		localBckB = cmn.Bck{Name: bucketLocalB, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckL = cmn.Bck{Name: bucketLocalL, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckT = cmn.Bck{Name: bucketLocalT, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		cloudBckA = cmn.Bck{Name: bucketCloudA, Provider: apc.AWS, Ns: cmn.NsGlobal}
 	)
 
@@ -72,6 +77,7 @@ var _ = Describe("LOM", func() {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
 
 	bmd := mock.NewBaseBownerMock(
 		meta.NewBck(
@@ -95,6 +101,14 @@ var _ = Describe("LOM", func() {
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 6}),
 		meta.NewBck(sameBucketName, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 7}),
+		meta.NewBck(
+			bucketLocalL, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{ObjLock: cmn.ObjLockConf{Enabled: true, Mode: apc.ObjLockGovernance, Days: 1}, BID: 8},
+		),
+		meta.NewBck(
+			bucketLocalT, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{Tier: cmn.TierConf{Enabled: true, ColdSize: cos.KiB}, BID: 9},
+		),
 	)
 
 	BeforeEach(func() {
@@ -562,6 +576,122 @@ var _ = Describe("LOM", func() {
 			})
 		})
 
+		Describe("Retained versions", func() {
+			testObject := "foldr/test-obj-ver.ext"
+			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
+
+			It("should retain, list, prune, and restore previous versions", func() {
+				for i := 1; i <= 2; i++ {
+					lom := filePut(localFQN, i*10)
+					lom.SetVersion(strconv.Itoa(i))
+					Expect(persist(lom)).NotTo(HaveOccurred())
+					Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+					Expect(lom.RetainVersion(2)).NotTo(HaveOccurred())
+					Expect(localFQN).NotTo(BeAnExistingFile())
+					Expect(lom.VersionFQN(strconv.Itoa(i))).To(BeAnExistingFile())
+				}
+
+				lom := NewBasicLom(localFQN)
+				ver, err := lom.AddDeleteMarker(2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ver).To(Equal("3"))
+
+				versions, err := lom.Versions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions).To(HaveLen(2)) // version 1 pruned
+				Expect(versions[0].DeleteMarker).To(BeTrue())
+				Expect(versions[1].Version).To(Equal("2"))
+				Expect(versions[1].Size).To(BeEquivalentTo(20))
+
+				// delete marker is the latest - nothing to restore
+				Expect(lom.RestoreLatest()).NotTo(HaveOccurred())
+				Expect(localFQN).NotTo(BeAnExistingFile())
+
+				Expect(lom.DelVersion(ver)).NotTo(HaveOccurred())
+				Expect(lom.RestoreLatest()).NotTo(HaveOccurred())
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.Version()).To(Equal("2"))
+				Expect(lom.SizeBytes()).To(BeEquivalentTo(20))
+			})
+		})
+
+		Describe("Server-side encryption", func() {
+			testObject := "foldr/test-obj-sse.ext"
+			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
+
+			putEncrypted := func(key []byte, size int) (plain []byte) {
+				plain = make([]byte, size)
+				_, _ = cryptorand.Read(plain)
+				fh, err := cos.CreateFile(localFQN)
+				Expect(err).NotTo(HaveOccurred())
+				sw, err := sse.NewWriter(fh, key)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = sw.Write(plain)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sw.Close()).NotTo(HaveOccurred())
+				Expect(fh.Close()).NotTo(HaveOccurred())
+
+				lom := NewBasicLom(localFQN)
+				lom.SetSize(int64(len(plain)))
+				lom.SetCustomKey(cmn.SSEKeyObjMD, "k1")
+				lom.IncVersion()
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				lom.UncacheUnless()
+				return plain
+			}
+
+			It("should load and read encrypted object (plaintext size and checksum)", func() {
+				key := make([]byte, sse.KeySize)
+				_, _ = cryptorand.Read(key)
+				sse.SetProvider(testKeys{"k1": key})
+				defer sse.SetProvider(nil)
+
+				plain := putEncrypted(key, sse.ChunkSize+100)
+
+				lom := NewBasicLom(localFQN)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				Expect(lom.IsEncrypted()).To(BeTrue())
+				Expect(lom.SizeBytes()).To(BeEquivalentTo(len(plain)))
+
+				lh, err := lom.NewHandle()
+				Expect(err).NotTo(HaveOccurred())
+				got, err := io.ReadAll(lh)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lh.Close()).NotTo(HaveOccurred())
+				Expect(got).To(Equal(plain))
+
+				cksum, err := lom.ComputeCksum(cos.ChecksumXXHash)
+				Expect(err).NotTo(HaveOccurred())
+				expected := cos.NewCksumHash(cos.ChecksumXXHash)
+				expected.H.Write(plain)
+				expected.Finalize()
+				Expect(cksum.Value()).To(Equal(expected.Value()))
+			})
+
+			It("should decrypt when copying to a bucket without encryption", func() {
+				key := make([]byte, sse.KeySize)
+				_, _ = cryptorand.Read(key)
+				sse.SetProvider(testKeys{"k1": key})
+				defer sse.SetProvider(nil)
+
+				plain := putEncrypted(key, sse.ChunkSize+100)
+				lom := NewBasicLom(localFQN)
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+
+				dstFQN := mis[0].MakePathFQN(&localBckB, fs.ObjectType, testObject)
+				lom.Lock(true)
+				dst, err := lom.Copy2FQN(dstFQN, make([]byte, cos.KiB))
+				lom.Unlock(true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dst.IsEncrypted()).To(BeFalse())
+				core.FreeLOM(dst)
+
+				got, err := os.ReadFile(dstFQN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(got).To(Equal(plain))
+			})
+		})
+
 		Describe("CustomMD", func() {
 			testObject := "foldr/test-obj.ext"
 			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
@@ -580,6 +710,225 @@ var _ = Describe("LOM", func() {
 				Expect(exists).To(BeFalse())
 			})
 		})
+
+		Describe("Object lock", func() {
+			testObject := "foldr/test-obj-lock.ext"
+			localFQN := mis[0].MakePathFQN(&localBckL, fs.ObjectType, testObject)
+			future := func(d time.Duration) string { return cmn.FormatRetainUntil(time.Now().Add(d)) }
+
+			It("should inherit default retention and enforce governance mode", func() {
+				lom := filePut(localFQN, 0)
+				lom.SetDefaultRetention(time.Now())
+				mode, until := lom.Retention()
+				Expect(mode).To(Equal(apc.ObjLockGovernance))
+				Expect(until.After(time.Now().Add(23 * time.Hour))).To(BeTrue())
+
+				err := lom.CheckObjLock(false /*bypass governance*/)
+				Expect(cmn.IsErrObjLocked(err)).To(BeTrue())
+				Expect(lom.CheckObjLock(true)).NotTo(HaveOccurred())
+
+				// shorten or remove: bypass required
+				shorter := &apc.ObjRetention{Mode: apc.ObjLockGovernance, RetainUntil: future(time.Hour)}
+				Expect(cmn.IsErrObjLocked(lom.SetRetention(shorter, false))).To(BeTrue())
+				Expect(lom.SetRetention(shorter, true)).NotTo(HaveOccurred())
+				Expect(lom.SetRetention(&apc.ObjRetention{}, true)).NotTo(HaveOccurred())
+				Expect(lom.CheckObjLock(false)).NotTo(HaveOccurred())
+			})
+
+			It("should only extend compliance-mode retention", func() {
+				lom := filePut(localFQN, 0)
+				r := &apc.ObjRetention{Mode: apc.ObjLockCompliance, RetainUntil: future(2 * time.Hour)}
+				Expect(lom.SetRetention(r, false)).NotTo(HaveOccurred())
+
+				for _, weaker := range []*apc.ObjRetention{
+					{Mode: apc.ObjLockCompliance, RetainUntil: future(time.Hour)},
+					{Mode: apc.ObjLockGovernance, RetainUntil: future(3 * time.Hour)},
+					{},
+				} {
+					Expect(cmn.IsErrObjLocked(lom.SetRetention(weaker, true /*bypass*/))).To(BeTrue())
+				}
+				r.RetainUntil = future(3 * time.Hour)
+				Expect(lom.SetRetention(r, false)).NotTo(HaveOccurred())
+				Expect(cmn.IsErrObjLocked(lom.CheckObjLock(true))).To(BeTrue())
+			})
+
+			It("should enforce legal hold regardless of retention", func() {
+				lom := filePut(localFQN, 0)
+				Expect(lom.SetLegalHold(true)).NotTo(HaveOccurred())
+				Expect(lom.LegalHold()).To(BeTrue())
+				Expect(cmn.IsErrObjLocked(lom.CheckObjLock(true))).To(BeTrue())
+				Expect(lom.SetLegalHold(false)).NotTo(HaveOccurred())
+				Expect(lom.CheckObjLock(false)).NotTo(HaveOccurred())
+			})
+
+			It("should fail when object lock is not enabled", func() {
+				lom := filePut(mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject), 0)
+				Expect(lom.SetLegalHold(true)).To(HaveOccurred())
+				Expect(lom.CheckObjLock(false)).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Tiered storage", func() {
+		It("should migrate objects between tiers and redirect lookups", func() {
+			coldMi := fs.GetAvail()[mpaths[2]]
+			Expect(coldMi).NotTo(BeNil())
+			coldMi.Class = apc.TierCold
+			defer func() { coldMi.Class = apc.TierHot }()
+
+			testObject := "foldr/test-obj-tier.ext"
+			lom := &core.LOM{ObjName: testObject}
+			Expect(lom.InitBck(&localBckT)).NotTo(HaveOccurred())
+			Expect(lom.Tier()).To(Equal(apc.TierHot))
+			hotFQN := lom.FQN
+
+			lom = filePut(hotFQN, 2*cos.KiB)
+			Expect(lom.IsHRW()).To(BeTrue())
+			Expect(lom.TierPlaced(apc.TierHot)).To(BeTrue())
+			Expect(lom.TierPlaced(apc.TierCold)).To(BeFalse())
+
+			// hot => cold
+			buf := make([]byte, 32*cos.KiB)
+			lom.Lock(true)
+			moved, err := lom.MigrateTier(apc.TierCold, buf)
+			lom.Unlock(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeTrue())
+			Expect(cos.Stat(hotFQN)).To(HaveOccurred())
+
+			// lookup by name (redirected)
+			lom = &core.LOM{ObjName: testObject}
+			Expect(lom.InitBck(&localBckT)).NotTo(HaveOccurred())
+			Expect(lom.Mountpath().Path).To(Equal(mpaths[2]))
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.SizeBytes()).To(BeEquivalentTo(2 * cos.KiB))
+			Expect(lom.TierPlaced(apc.TierCold)).To(BeTrue())
+
+			// lookup by FQN (not misplaced)
+			coldLom := NewBasicLom(lom.FQN)
+			Expect(coldLom.IsHRW()).To(BeTrue())
+
+			// cold => hot
+			lom.Lock(true)
+			moved, err = lom.MigrateTier(apc.TierHot, buf)
+			lom.Unlock(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeTrue())
+
+			lom = &core.LOM{ObjName: testObject}
+			Expect(lom.InitBck(&localBckT)).NotTo(HaveOccurred())
+			Expect(lom.FQN).To(Equal(hotFQN))
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.TierPlaced(apc.TierHot)).To(BeTrue())
+
+			// removing cold object removes its redirection
+			lom.Lock(true)
+			_, err = lom.MigrateTier(apc.TierCold, buf)
+			Expect(err).NotTo(HaveOccurred())
+			lom = &core.LOM{ObjName: testObject}
+			Expect(lom.InitBck(&localBckT)).NotTo(HaveOccurred())
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(lom.Remove()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			lom = &core.LOM{ObjName: testObject}
+			Expect(lom.InitBck(&localBckT)).NotTo(HaveOccurred())
+			Expect(lom.FQN).To(Equal(hotFQN))
+		})
+	})
+
+	Describe("Quota usage", func() {
+		It("should track removals of objects in tracked buckets", func() {
+			lom := &core.LOM{ObjName: "foldr/test-obj-quota.ext"}
+			Expect(lom.InitBck(&localBckA)).NotTo(HaveOccurred())
+			bid := lom.Bprops().BID
+			u, added := core.TrackUsage(bid)
+			Expect(added).To(BeTrue())
+			defer core.UntrackUsage(bid)
+			u.Set(10*cos.KiB, 5)
+
+			lom = filePut(lom.FQN, 2*cos.KiB)
+			lom.Lock(true)
+			Expect(lom.Remove()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			size, objs, ready := u.Get()
+			Expect(ready).To(BeTrue())
+			Expect(size).To(BeEquivalentTo(8 * cos.KiB))
+			Expect(objs).To(BeEquivalentTo(4))
+			Expect(core.Usage(bid)).To(Equal(u))
+		})
+	})
+
+	Describe("Write-back journal", func() {
+		var fpath string
+		BeforeEach(func() {
+			dir, err := os.MkdirTemp("", "wbj")
+			Expect(err).NotTo(HaveOccurred())
+			fpath = filepath.Join(dir, "wbj")
+			n, err := core.WbInit(fpath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(BeZero())
+		})
+		AfterEach(func() {
+			core.WbTerm()
+			os.RemoveAll(filepath.Dir(fpath))
+		})
+
+		It("should supersede, retry, and replay pending entries", func() {
+			var (
+				unameA = cloudBckA.MakeUname("a")
+				unameB = cloudBckA.MakeUname("b")
+			)
+			Expect(core.WbAdd(unameA, 10)).NotTo(HaveOccurred())
+			Expect(core.WbAdd(unameB, 20)).NotTo(HaveOccurred())
+			Expect(core.WbAdd(unameA, 30)).NotTo(HaveOccurred())
+			cnt, size, _ := core.WbBacklog()
+			Expect(cnt).To(BeEquivalentTo(2))
+			Expect(size).To(BeEquivalentTo(50))
+			Expect(core.WbPendingBck(&cloudBckA)).To(Equal(2))
+
+			// oldest first; at most one upload per object
+			ens := core.WbNext(10)
+			Expect(ens).To(HaveLen(2))
+			Expect(ens[0].Uname).To(Equal(unameA))
+			Expect(ens[0].Size).To(BeEquivalentTo(30))
+			Expect(core.WbNext(10)).To(BeEmpty())
+
+			// superseded while being uploaded
+			Expect(core.WbAdd(unameA, 40)).NotTo(HaveOccurred())
+			Expect(core.WbDone(&ens[0], true)).To(BeFalse())
+			// failed upload gets retried later
+			core.WbFailed(&ens[1])
+			ens = core.WbNext(10)
+			Expect(ens).To(HaveLen(1))
+			Expect(ens[0].Size).To(BeEquivalentTo(40))
+
+			// replay (with torn last record)
+			core.WbTerm()
+			fh, err := os.OpenFile(fpath, os.O_APPEND|os.O_WRONLY, 0o644)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = fh.WriteString(`{"u":"` + unameB)
+			Expect(err).NotTo(HaveOccurred())
+			fh.Close()
+			n, err := core.WbInit(fpath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(2))
+
+			ens = core.WbNext(10)
+			Expect(ens).To(HaveLen(2))
+			Expect(core.WbDone(&ens[0], true)).To(BeTrue())
+			Expect(core.WbPending(unameA)).To(BeFalse())
+			Expect(core.WbCancel(unameB)).To(BeTrue())
+			cnt, size, _ = core.WbBacklog()
+			Expect(cnt).To(BeZero())
+			Expect(size).To(BeZero())
+
+			core.WbTerm()
+			n, err = core.WbInit(fpath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(BeZero())
+		})
 	})
 
 	Describe("copy object methods", func() {
@@ -1039,6 +1388,18 @@ var _ = Describe("LOM", func() {
 // HELPERS
 //
 
+// in-memory SSE key provider
+type testKeys map[string][]byte
+
+func (tk testKeys) Key(id string) ([]byte, error) {
+	if key, ok := tk[id]; ok {
+		return key, nil
+	}
+	return nil, cos.NewErrNotFound(nil, "key "+id)
+}
+
+func (testKeys) DefaultKeyID() (string, error) { return "k1", nil }
+
 // needs to be called inside of gomega scope like Describe/It
 func NewBasicLom(fqn string) *core.LOM {
 	lom := &core.LOM{}
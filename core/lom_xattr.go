@@ -58,6 +58,7 @@ const (
 	lomObjSize
 	lomObjCopies
 	lomCustomMD
+	lomAccessCnt
 )
 
 // packing format separators
@@ -68,7 +69,11 @@ const (
 	lenRecSepa   = len(recordSepa)
 )
 
-const prefLen = 10 // 10B prefix [ version = 1 | checksum-type | 64-bit xxhash ]
+const prefLen = 10 // 10B prefix [ version | checksum-type | 64-bit xxhash ]
+
+// v1 layout: all of the above except access count (that requires cmn.MetaverLOM = 2);
+// objects that don't have access count are still written as v1
+const lomMetaverV1 = 1
 
 const getxattr = "getxattr" // syscall
 
@@ -230,7 +235,11 @@ func (lom *LOM) flushCold(md *lmeta, atime time.Time) {
 	if err := lom.flushAtime(atime); err != nil {
 		return
 	}
-	if !md.isDirty() || lom.WritePolicy() == apc.WriteNever {
+	if lom.WritePolicy() == apc.WriteNever {
+		return
+	}
+	// not dirty but accessed (atime-wise) - persist access count, if tracked (see IncAccessCnt)
+	if !md.isDirty() && md.accessCnt == 0 {
 		return
 	}
 	lom.md = *md
@@ -305,8 +314,9 @@ func (md *lmeta) unmarshal(buf []byte) error {
 	if len(buf) < prefLen {
 		return fmt.Errorf("%s: too short (%d)", invalid, len(buf))
 	}
-	if buf[0] != cmn.MetaverLOM {
-		return fmt.Errorf("%s: unknown version %d", invalid, buf[0])
+	metaver := buf[0]
+	if metaver != cmn.MetaverLOM && metaver != lomMetaverV1 {
+		return fmt.Errorf("%s: unknown version %d", invalid, metaver)
 	}
 	if buf[1] != mdCksumTyXXHash {
 		return fmt.Errorf("%s: unknown checksum %d", invalid, buf[1])
@@ -390,6 +400,11 @@ func (md *lmeta) unmarshal(buf []byte) error {
 				custom[entries[i]] = entries[i+1]
 			}
 			md.SetCustomMD(custom)
+		case lomAccessCnt:
+			if metaver == lomMetaverV1 {
+				return errors.New(invalid + " #9")
+			}
+			md.accessCnt = binary.BigEndian.Uint64([]byte(val))
 		default:
 			return errors.New(invalid + " #6")
 		}
@@ -408,6 +423,7 @@ func (md *lmeta) marshal(mdSize int64) (buf []byte) {
 	var (
 		b8                    [cos.SizeofI64]byte
 		cksumType, cksumValue = md.Cksum.Get()
+		metaver               = byte(lomMetaverV1)
 	)
 	buf, _ = g.smm.AllocSize(mdSize)
 	buf = buf[:prefLen] // hold it for md-xattr checksum (below)
@@ -430,9 +446,15 @@ func (md *lmeta) marshal(mdSize int64) (buf []byte) {
 		buf = _marshRecord(buf, lomCustomMD, "", false)
 		buf = _marshCustomMD(buf, custom)
 	}
+	if md.accessCnt > 0 {
+		binary.BigEndian.PutUint64(b8[:], md.accessCnt)
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _marshRecord(buf, lomAccessCnt, string(b8[:]), false)
+		metaver = cmn.MetaverLOM
+	}
 
 	// checksum, prepend, and return
-	buf[0] = cmn.MetaverLOM
+	buf[0] = metaver
 	buf[1] = mdCksumTyXXHash
 	mdCksumValue := xxhash.Checksum64S(buf[prefLen:], cos.MLCG32)
 	binary.BigEndian.PutUint64(buf[2:], mdCksumValue)
@@ -484,8 +506,9 @@ func _marshCustomMD(buf []byte, md cos.StrKVs) []byte {
 	return buf
 }
 
-// copy atime IFF valid and more recent
+// copy atime IFF valid and more recent (ditto access count)
 func (md *lmeta) cpAtime(from *lmeta) {
+	md.accessCnt = max(md.accessCnt, from.accessCnt)
 	if !cos.IsValidAtime(from.Atime) {
 		return
 	}
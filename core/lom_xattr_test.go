@@ -117,6 +117,7 @@ var _ = Describe("LOM Xattributes", func() {
 					cmn.ETag:        "etag",
 					cmn.CRC32CObjMD: "crc32",
 				})
+				lom.SetAccessCnt(42)
 				Expect(lom.AddCopy(fqns[0], copyMpathInfo)).NotTo(HaveOccurred())
 				Expect(lom.AddCopy(fqns[1], copyMpathInfo)).NotTo(HaveOccurred())
 				Expect(persist(lom)).NotTo(HaveOccurred())
@@ -138,6 +139,28 @@ var _ = Describe("LOM Xattributes", func() {
 				Expect(lom.GetCopies()).To(BeEquivalentTo(newLom.GetCopies()))
 				Expect(lom.GetCustomMD()).To(HaveLen(3))
 				Expect(lom.GetCustomMD()).To(BeEquivalentTo(newLom.GetCustomMD()))
+				Expect(newLom.AccessCnt()).To(BeEquivalentTo(42))
+			})
+
+			It("should write v1 meta unless there's access count", func() {
+				lom := filePut(localFQN, testFileSize)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				b, err := fs.GetXattr(localFQN, core.XattrLOM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b[0]).To(BeEquivalentTo(1))
+
+				lom.SetAccessCnt(7)
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				b, err = fs.GetXattr(localFQN, core.XattrLOM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b[0]).To(BeEquivalentTo(cmn.MetaverLOM))
+
+				lom.UncacheUnless()
+				newLom := NewBasicLom(localFQN)
+				Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+				Expect(newLom.AccessCnt()).To(BeEquivalentTo(7))
 			})
 
 			It("should _not_ save meta to disk", func() {
@@ -0,0 +1,82 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+)
+
+// Local (this target's) usage of buckets with quotas (see cmn.QuotaConf):
+// - tracked only for the buckets that the target decides to track (see TrackUsage);
+// - size is the on-disk size (e.g., encrypted objects take more than their plaintext size);
+// - updated incrementally when objects are added (by the target) and removed (lom.Remove);
+// - (re)counted from scratch in the background, to initialize and to correct drift.
+
+type BckUsage struct {
+	size  atomic.Int64
+	objs  atomic.Int64
+	ready atomic.Bool // counted at least once
+}
+
+var usage sync.Map // bucket ID => *BckUsage
+
+// returns nil if not tracked
+func Usage(bid uint64) *BckUsage {
+	if v, ok := usage.Load(bid); ok {
+		return v.(*BckUsage)
+	}
+	return nil
+}
+
+func TrackUsage(bid uint64) (u *BckUsage, added bool) {
+	v, loaded := usage.LoadOrStore(bid, &BckUsage{})
+	return v.(*BckUsage), !loaded
+}
+
+func UntrackUsage(bid uint64) { usage.Delete(bid) }
+
+// visit all tracked buckets
+func RangeUsage(cb func(bid uint64, u *BckUsage) bool) {
+	usage.Range(func(k, v any) bool { return cb(k.(uint64), v.(*BckUsage)) })
+}
+
+func (u *BckUsage) Add(size, objs int64) {
+	u.size.Add(size)
+	u.objs.Add(objs)
+}
+
+func (u *BckUsage) Get() (size, objs int64, ready bool) {
+	return max(u.size.Load(), 0), max(u.objs.Load(), 0), u.ready.Load()
+}
+
+// (re)set upon counting
+func (u *BckUsage) Set(size, objs int64) {
+	u.size.Store(size)
+	u.objs.Store(objs)
+	u.ready.Store(true)
+}
+
+// returns the usage to update upon removal, and the object's contribution to it
+// (objects counted - see ais/tgtquota - are the ones at their HRW locations)
+// NOTE: must be called prior to removing the object
+func (lom *LOM) usageRm() (u *BckUsage, size int64) {
+	bprops := lom.Bprops()
+	if bprops == nil {
+		return nil, 0
+	}
+	if u = Usage(bprops.BID); u == nil || !lom.IsHRW() {
+		return nil, 0
+	}
+	if lom.loaded() {
+		return u, lom.SizeOnDisk()
+	}
+	if finfo, err := os.Lstat(lom.FQN); err == nil {
+		size = finfo.Size()
+	}
+	return u, size
+}
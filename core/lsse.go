@@ -0,0 +1,174 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"io"
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
+)
+
+// Server-side encryption at rest (see cmn.SSEConf and cmn/sse):
+// - encrypted object stores the ID of its key in custom metadata (cmn.SSEKeyObjMD);
+// - lom.md.Size and lom.md.Cksum are always plaintext size and checksum, respectively;
+// - local readers must use lom.NewHandle() (or lom.NewDeferROC()) rather than open lom.FQN
+
+// (decrypted) object content: read, read-at (range), and seek
+type LomHandle interface {
+	cos.ReadOpenCloser
+	io.ReaderAt
+	io.Seeker
+}
+
+// interface guard
+var (
+	_ LomHandle = (*cos.FileHandle)(nil)
+	_ LomHandle = (*sse.File)(nil)
+)
+
+func (lom *LOM) SSEKeyID() string {
+	id, _ := lom.GetCustomKey(cmn.SSEKeyObjMD)
+	return id
+}
+
+func (lom *LOM) IsEncrypted() bool { return lom.SSEKeyID() != "" }
+
+// ID of the key to encrypt new content with, as per bucket configuration
+// (empty when the bucket is not encrypted)
+func (lom *LOM) SSEKeyToUse() (string, error) {
+	conf := &lom.Bprops().SSE
+	if !conf.Enabled {
+		return "", nil
+	}
+	if conf.KeyID != "" {
+		return conf.KeyID, nil
+	}
+	return sse.DefaultKeyID()
+}
+
+// on-disk size (see also ais/tgtquota)
+func (lom *LOM) SizeOnDisk() int64 {
+	if lom.IsEncrypted() {
+		return sse.EncSize(lom.md.Size)
+	}
+	return lom.md.Size
+}
+
+// open object for reading (the caller must be holding at least rlock)
+func (lom *LOM) NewHandle() (LomHandle, error) { return lom.OpenFQN(lom.FQN) }
+
+// open (and decrypt, if need be) object's content stored at a given location -
+// the object itself, its retained version, or a workfile
+func (lom *LOM) OpenFQN(fqn string) (LomHandle, error) {
+	id := lom.SSEKeyID()
+	if id == "" {
+		fh, err := cos.NewFileHandle(fqn)
+		if err != nil {
+			return nil, err
+		}
+		return fh, nil
+	}
+	key, err := sse.Key(id)
+	if err != nil {
+		return nil, cmn.NewErrFailedTo(T, "get encryption key for", lom.Cname(), err)
+	}
+	f, err := sse.OpenFile(fqn, key)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			err = cmn.NewErrFailedTo(T, "decrypt", lom.Cname(), err)
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// copy (decrypted) content of the object to a given local destination, e.g. workfile
+// (compare with cos.CopyFile)
+func (lom *LOM) CopyPlain(dst string, buf []byte, cksumType string) (written int64, cksum *cos.CksumHash, err error) {
+	if !lom.IsEncrypted() {
+		return cos.CopyFile(lom.FQN, dst, buf, cksumType)
+	}
+	var (
+		lh  LomHandle
+		dfh *os.File
+	)
+	if lh, err = lom.NewHandle(); err != nil {
+		return
+	}
+	if dfh, err = cos.CreateFile(dst); err != nil {
+		cos.Close(lh)
+		return
+	}
+	written, cksum, err = cos.CopyAndChecksum(dfh, lh, buf, cksumType)
+	cos.Close(lh)
+	if err == nil {
+		err = cos.FlushClose(dfh)
+	} else {
+		cos.Close(dfh)
+	}
+	if err != nil {
+		if errRemove := cos.RemoveFile(dst); errRemove != nil {
+			nlog.Errorf(fmtNestedErr, errRemove)
+		}
+	}
+	return
+}
+
+// copy decrypted content of the object to a destination workfile and encrypt it
+// with a given key, if any (copying across buckets that have different SSE configs)
+func (lom *LOM) copyCrypt(dst *LOM, workFQN, keyID string, buf []byte, cksumType string) (cksum *cos.CksumHash, err error) {
+	var (
+		key []byte
+		lh  LomHandle
+		wfh *os.File
+		sw  *sse.Writer
+	)
+	if keyID != "" {
+		if key, err = sse.Key(keyID); err != nil {
+			return nil, cmn.NewErrFailedTo(T, "get encryption key for", dst.Cname(), err)
+		}
+	}
+	if lh, err = lom.NewHandle(); err != nil {
+		return nil, err
+	}
+	if wfh, err = cos.CreateFile(workFQN); err != nil {
+		cos.Close(lh)
+		return nil, err
+	}
+	var w io.Writer = wfh
+	if key != nil {
+		if sw, err = sse.NewWriter(wfh, key); err == nil {
+			w = sw
+		}
+	}
+	if err == nil {
+		_, cksum, err = cos.CopyAndChecksum(w, lh, buf, cksumType)
+		if err == nil && sw != nil {
+			err = sw.Close()
+		}
+	}
+	cos.Close(lh)
+	if err == nil {
+		err = cos.FlushClose(wfh)
+	} else {
+		cos.Close(wfh)
+	}
+	if err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			nlog.Errorf(fmtNestedErr, errRemove)
+		}
+		return nil, err
+	}
+	if keyID == "" {
+		dst.ObjAttrs().DelCustomKeys(cmn.SSEKeyObjMD)
+	} else {
+		dst.SetCustomKey(cmn.SSEKeyObjMD, keyID)
+	}
+	return cksum, nil
+}
@@ -0,0 +1,158 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Tiered storage (see cmn.TierConf and fs.HrwTier):
+// - object's "home" is its HRW mountpath among the mountpaths of the bucket's preferred tier;
+// - an object that resides on the other tier is stored at its HRW mountpath within that tier,
+//   while its home keeps a redirection marker (an empty fs.TierRedirType file);
+// - lookup by name (lom.InitBck) checks for the marker and, if present, redirects to the other tier;
+// - lookup by FQN (lom.InitFQN) computes HRW within the tier of the mountpath that stores the object -
+//   properly tiered objects are, therefore, never considered misplaced (see lom.IsHRW);
+// - tier-migrate (xact/xs/tiermigrate.go) maintains the invariant:
+//   "an object is stored on the non-preferred tier <=> its home has the marker".
+
+func (lom *LOM) tiered() bool {
+	bprops := lom.Bprops()
+	return bprops != nil && bprops.Tier.Enabled && fs.Tiered()
+}
+
+func (lom *LOM) redirFQN(home *fs.Mountpath) string {
+	return home.MakePathFQN(lom.Bucket(), fs.TierRedirType, lom.ObjName)
+}
+
+// lookup by name: home mountpath or, if redirected, HRW mountpath on the other tier
+func (lom *LOM) hrwMpath() (mi *fs.Mountpath, digest uint64, err error) {
+	if !lom.tiered() {
+		return fs.Hrw(lom.md.uname)
+	}
+	pref := lom.Bprops().Tier.PreferredTier()
+	if mi, digest, err = fs.HrwTier(lom.md.uname, pref); err != nil {
+		return
+	}
+	if cos.Stat(lom.redirFQN(mi)) == nil {
+		mi, digest, err = fs.HrwTier(lom.md.uname, cmn.OtherTier(pref))
+	}
+	return
+}
+
+// lookup by FQN: HRW mountpath within the tier of the mountpath that stores the object
+func (lom *LOM) tierHrwFQN() (string, error) {
+	mi, _, err := fs.HrwTier(lom.md.uname, lom.mi.Class)
+	if err != nil {
+		return "", err
+	}
+	return mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName), nil
+}
+
+// Tier returns the tier (apc.TierHot or apc.TierCold) of the mountpath that stores the object
+func (lom *LOM) Tier() string { return lom.mi.Class }
+
+// TierPlaced returns true if the object is properly placed on a given tier:
+// stored at its HRW mountpath within the tier and redirected if (and only if) not at home
+func (lom *LOM) TierPlaced(tier string) bool {
+	if lom.mi.Class != tier || !lom.IsHRW() {
+		return false
+	}
+	home, _, err := fs.HrwTier(lom.md.uname, lom.Bprops().Tier.PreferredTier())
+	if err != nil {
+		return false
+	}
+	redirected := cos.Stat(lom.redirFQN(home)) == nil
+	return redirected == (home.Path != lom.mi.Path)
+}
+
+// MigrateTier moves the object to its HRW mountpath on a given tier and updates its
+// redirection marker; empty tier - no tiering: move to fs.Hrw mountpath and remove markers.
+// Returns true if the object has been moved.
+// NOTE: must be called under w-lock
+func (lom *LOM) MigrateTier(tier string, buf []byte) (moved bool, err error) {
+	var (
+		dst, home *fs.Mountpath
+		uname     = lom.md.uname
+	)
+	debug.AssertFunc(func() bool { _, exclusive := lom.IsLocked(); return exclusive })
+	if tier == "" {
+		dst, _, err = fs.Hrw(uname)
+	} else if home, _, err = fs.HrwTier(uname, lom.Bprops().Tier.PreferredTier()); err == nil {
+		dst, _, err = fs.HrwTier(uname, tier)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// 1. redirect prior to moving (so that lookups that follow could find the object)
+	if home != nil && home.Path != dst.Path {
+		if err = lom.addRedir(home); err != nil {
+			return false, err
+		}
+	}
+	// 2. move
+	if lom.mi.Path != dst.Path {
+		lom.Uncache() // (carries over cached atime)
+		dstFQN := dst.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		dlom, err := lom.Copy2FQN(dstFQN, buf)
+		if err != nil {
+			return false, err
+		}
+		FreeLOM(dlom)
+		if err := cos.RemoveFile(lom.FQN); err != nil {
+			nlog.Errorln("failed to remove", lom.Cname(), "after moving it to", dst.String(), "err:", err)
+		}
+		moved = true
+	}
+	// 3. remove redirection
+	switch {
+	case home == nil:
+		for _, mi := range fs.GetAvail() {
+			if err := cos.RemoveFile(lom.redirFQN(mi)); err != nil {
+				nlog.Errorln(err)
+			}
+		}
+	case home.Path == dst.Path:
+		if err := cos.RemoveFile(lom.redirFQN(home)); err != nil {
+			nlog.Errorln(err)
+		}
+	}
+	return moved, nil
+}
+
+func (lom *LOM) addRedir(home *fs.Mountpath) error {
+	fqn := lom.redirFQN(home)
+	if cos.Stat(fqn) == nil {
+		return nil
+	}
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	return fh.Close()
+}
+
+// (when removing an object that resides on the non-preferred tier)
+func (lom *LOM) delRedir() {
+	if !lom.tiered() {
+		return
+	}
+	pref := lom.Bprops().Tier.PreferredTier()
+	if lom.mi.Class == pref {
+		return
+	}
+	home, _, err := fs.HrwTier(lom.md.uname, pref)
+	if err != nil || home.Path == lom.mi.Path {
+		return
+	}
+	if err := cos.RemoveFile(lom.redirFQN(home)); err != nil {
+		nlog.Errorln(err)
+	}
+}
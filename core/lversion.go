@@ -0,0 +1,245 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Retained (previous) versions of ais:// objects - see cmn.VersionConf.Retain
+// - when overwritten or deleted, the current object is moved (renamed) into fs.ObjVersionType
+//   content, with all its metadata (xattr) intact
+// - deleting an object without specifying version adds a "delete marker" - an empty
+//   version that has cmn.DeleteMarkerObjMD set
+// - the oldest retained versions get removed as per configured `Retain` count
+// All methods in this source require the (main) object to be locked.
+
+// retained version (as in: ListObjectVersions)
+type ObjVersion struct {
+	Cksum        *cos.Cksum
+	Version      string
+	Atime        int64
+	Size         int64
+	DeleteMarker bool
+}
+
+func (lom *LOM) VersionFQN(ver string) string { return fs.CSM.Gen(lom, fs.ObjVersionType, ver) }
+
+// number of previous versions to retain (zero when not applicable)
+func (lom *LOM) Retain() int {
+	if !lom.Bck().IsAIS() {
+		return 0
+	}
+	if vconf := lom.VersionConf(); vconf.Enabled {
+		return vconf.Retain
+	}
+	return 0
+}
+
+func (lom *LOM) IsDeleteMarker() bool {
+	_, ok := lom.GetCustomKey(cmn.DeleteMarkerObjMD)
+	return ok
+}
+
+// Allocates and loads retained version's LOM; the caller must free it and must not cache it
+// (the returned LOM shares uname, and therefore cache slot, with the object itself).
+func (lom *LOM) LoadVersion(ver string) (*LOM, error) {
+	vlom := lom.newVersion(ver)
+	if err := vlom.FromFS(); err != nil {
+		FreeLOM(vlom)
+		return nil, err
+	}
+	return vlom, nil
+}
+
+func (lom *LOM) newVersion(ver string) (vlom *LOM) {
+	vlom = AllocLOM(lom.ObjName)
+	vlom.mi, vlom.bck, vlom.digest = lom.mi, lom.bck, lom.digest
+	vlom.md.uname = lom.md.uname
+	vlom.HrwFQN = lom.HrwFQN
+	vlom.FQN = lom.VersionFQN(ver)
+	return vlom
+}
+
+// Move the current object (must be loaded) to retained versions.
+func (lom *LOM) RetainVersion(retain int) error {
+	debug.Assert(retain > 0)
+	ver := lom.Version()
+	if ver == "" {
+		return nil // (put prior to enabling versioning)
+	}
+	if err := cos.Rename(lom.FQN, lom.VersionFQN(ver)); err != nil {
+		return cmn.NewErrFailedTo(T, "retain version "+ver+" of", lom, err)
+	}
+	lom.Uncache()
+	lom.pruneVersions(retain)
+	return nil
+}
+
+// Delete (versioned) object: retain the current version and add a delete marker.
+// Upon return, lom's version is the one of the delete marker.
+func (lom *LOM) RemoveRetain(retain int) error {
+	if err := lom.DelAllCopies(); err != nil {
+		return err
+	}
+	if err := lom.RetainVersion(retain); err != nil {
+		return err
+	}
+	ver, err := lom.AddDeleteMarker(retain)
+	if err == nil {
+		lom.SetVersion(ver)
+	}
+	return err
+}
+
+// Add a delete marker that becomes the latest version; returns the marker's version.
+func (lom *LOM) AddDeleteMarker(retain int) (string, error) {
+	debug.Assert(retain > 0)
+	var (
+		ver  = lomInitialVersion
+		nums = lom.versionNums()
+	)
+	if len(nums) > 0 {
+		ver = strconv.FormatUint(nums[0]+1, 10)
+	}
+	vlom := lom.newVersion(ver)
+	defer FreeLOM(vlom)
+	vlom.md.Ver = ver
+	vlom.md.bckID = lom.Bprops().BID
+	vlom.md.Atime = time.Now().UnixNano()
+	vlom.md.Cksum = cos.NoneCksum
+	vlom.SetCustomKey(cmn.DeleteMarkerObjMD, "true")
+
+	fh, err := cos.CreateFile(vlom.FQN)
+	if err != nil {
+		return "", err
+	}
+	cos.Close(fh)
+	buf := vlom.marshal()
+	err = fs.SetXattr(vlom.FQN, XattrLOM, buf)
+	g.smm.Free(buf)
+	if err != nil {
+		cos.RemoveFile(vlom.FQN)
+		return "", err
+	}
+	lom.pruneVersions(retain)
+	return ver, nil
+}
+
+// Returns the latest retained version number or empty string if none.
+func (lom *LOM) LatestRetained() string {
+	if nums := lom.versionNums(); len(nums) > 0 {
+		return strconv.FormatUint(nums[0], 10)
+	}
+	return ""
+}
+
+// Retained versions, newest first.
+func (lom *LOM) Versions() ([]*ObjVersion, error) {
+	nums := lom.versionNums()
+	out := make([]*ObjVersion, 0, len(nums))
+	for _, n := range nums {
+		vlom, err := lom.LoadVersion(strconv.FormatUint(n, 10))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return out, err
+		}
+		out = append(out, vlom.ToObjVersion())
+		FreeLOM(vlom)
+	}
+	return out, nil
+}
+
+func (lom *LOM) ToObjVersion() *ObjVersion {
+	return &ObjVersion{
+		Cksum:        lom.Checksum(),
+		Version:      lom.Version(),
+		Atime:        lom.AtimeUnix(),
+		Size:         lom.SizeBytes(),
+		DeleteMarker: lom.IsDeleteMarker(),
+	}
+}
+
+// Permanently remove a given retained version.
+func (lom *LOM) DelVersion(ver string) error {
+	fqn := lom.VersionFQN(ver)
+	if err := os.Remove(fqn); err != nil {
+		if os.IsNotExist(err) {
+			return cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+		}
+		return err
+	}
+	return nil
+}
+
+// Restore the latest retained version (unless it's a delete marker) to become the current object.
+func (lom *LOM) RestoreLatest() error {
+	ver := lom.LatestRetained()
+	if ver == "" {
+		return nil
+	}
+	vlom, err := lom.LoadVersion(ver)
+	if err != nil {
+		return err
+	}
+	marker := vlom.IsDeleteMarker()
+	FreeLOM(vlom)
+	if marker {
+		return nil
+	}
+	lom.Uncache()
+	return cos.Rename(lom.VersionFQN(ver), lom.FQN)
+}
+
+// numeric versions, descending
+func (lom *LOM) versionNums() (nums []uint64) {
+	var (
+		vfqn       = lom.VersionFQN("0")
+		dir, vbase = filepath.Split(vfqn)
+		base       = vbase[:len(vbase)-2] // (minus ".0")
+	)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(lom.String(), err)
+		}
+		return nil
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		objBase, ver, ok := fs.ParseObjVersion(e.Name())
+		if !ok || objBase != base {
+			continue
+		}
+		n, _ := strconv.ParseUint(ver, 10, 64)
+		nums = append(nums, n)
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] > nums[j] })
+	return nums
+}
+
+func (lom *LOM) pruneVersions(retain int) {
+	nums := lom.versionNums()
+	for i := retain; i < len(nums); i++ {
+		fqn := lom.VersionFQN(strconv.FormatUint(nums[i], 10))
+		if err := cos.RemoveFile(fqn); err != nil {
+			nlog.Errorln(lom.String(), "failed to remove retained version:", err)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Write-back journal (see cmn.WriteBackConf):
+// - PUT to a remote bucket with write-back enabled is acknowledged once the object is stored locally
+//   and its journal record is fsync-ed; x-write-back uploads the object to the backend asynchronously;
+// - one pending entry per object: a subsequent PUT of the same object supersedes the previous one
+//   (new sequence number), and there's at most one upload of a given object at any point in time;
+// - failed uploads are retried with exponential backoff - the entry remains pending until uploaded,
+//   canceled by (the object's) DELETE, or until the object disappears locally;
+// - the journal is a per-target append-only file; "done" records are not fsync-ed (lost ones
+//   result in idempotent re-uploads); the file gets replayed upon startup and periodically compacted.
+
+// core stats: write-back
+const (
+	WbUploadCount    = "wb.upload.n"
+	WbUploadSize     = "wb.upload.size"
+	ErrWbUploadCount = "err.wb.upload.n"
+	WbBacklogCount   = "wb.backlog.n"    // gauge
+	WbBacklogSize    = "wb.backlog.size" // gauge
+	WbLag            = "wb.lag.ns"       // gauge: age of the oldest pending entry
+)
+
+const (
+	wbRetryMin = time.Second
+	wbRetryMax = 5 * time.Minute
+
+	wbCompactMin = 4096 // compact when the number of records exceeds max(wbCompactMin, 4 * pending)
+)
+
+type (
+	// journal record
+	wbRec struct {
+		Uname string `json:"u"`
+		Seq   int64  `json:"s"`
+		Added int64  `json:"t,omitempty"` // when first added (unix nano)
+		Size  int64  `json:"z,omitempty"`
+		Done  bool   `json:"d,omitempty"` // uploaded or canceled
+	}
+	// pending entry as seen by x-write-back
+	WbEntry struct {
+		Uname string
+		Seq   int64
+		Size  int64
+	}
+	wbEntry struct {
+		rec     wbRec
+		next    int64 // mono time: not earlier than (retry backoff)
+		retries int
+		busy    bool // being uploaded
+	}
+	wbJournal struct {
+		fh      *os.File
+		pending map[string]*wbEntry // by uname
+		queue   []*wbEntry          // in order of (first) arrival
+		fpath   string
+		seq     int64
+		size    int64 // total pending
+		nrec    int   // records in the file
+		mu      sync.Mutex
+	}
+)
+
+var wbj *wbJournal // nil when not initialized
+
+// load and compact the journal, if exists; returns the number of pending entries
+func WbInit(fpath string) (int, error) {
+	j := &wbJournal{fpath: fpath, pending: make(map[string]*wbEntry, 64)}
+	if err := j.replay(); err != nil {
+		return 0, err
+	}
+	if err := j.compact(); err != nil {
+		return 0, err
+	}
+	wbj = j
+	return len(j.pending), nil
+}
+
+func WbTerm() {
+	if j := wbj; j != nil {
+		j.mu.Lock()
+		cos.Close(j.fh)
+		j.mu.Unlock()
+		wbj = nil
+	}
+}
+
+// add (or supersede) pending entry; the caller must be holding the object's wlock
+func WbAdd(uname string, size int64) error {
+	j := wbj
+	if j == nil {
+		return errors.New("write-back journal not initialized")
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.seq++
+	e, ok := j.pending[uname]
+	rec := wbRec{Uname: uname, Seq: j.seq, Added: time.Now().UnixNano(), Size: size}
+	if ok {
+		rec.Added = e.rec.Added // (lag is measured from the first PUT that's still pending)
+	}
+	if err := j.write(&rec, true /*sync*/); err != nil {
+		j.seq--
+		return err
+	}
+	if ok {
+		j.size += size - e.rec.Size
+		e.rec = rec
+		e.next, e.retries = 0, 0
+	} else {
+		e = &wbEntry{rec: rec}
+		j.pending[uname] = e
+		j.queue = append(j.queue, e)
+		j.size += size
+	}
+	return nil
+}
+
+// up to `n` entries that are ready to be uploaded, oldest first; the caller (x-write-back)
+// must follow up with WbDone or WbFailed for each returned entry
+func WbNext(n int) (out []WbEntry) {
+	j := wbj
+	if j == nil {
+		return nil
+	}
+	now := mono.NanoTime()
+	j.mu.Lock()
+	// first, drop the head entries that are no longer pending
+	var i int
+	for i < len(j.queue) && j.pending[j.queue[i].rec.Uname] != j.queue[i] {
+		i++
+	}
+	if i > 0 {
+		j.queue = append(j.queue[:0], j.queue[i:]...)
+	}
+	for _, e := range j.queue {
+		if len(out) >= n {
+			break
+		}
+		if e.busy || e.next > now || j.pending[e.rec.Uname] != e {
+			continue
+		}
+		e.busy = true
+		out = append(out, WbEntry{Uname: e.rec.Uname, Seq: e.rec.Seq, Size: e.rec.Size})
+	}
+	j.mu.Unlock()
+	return out
+}
+
+// uploaded (or else, nothing to upload): remove the entry unless it was superseded
+// by a newer PUT in the meantime; returns true if removed
+func WbDone(en *WbEntry, uploaded bool) (removed bool) {
+	j := wbj
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	if e, ok := j.pending[en.Uname]; ok {
+		e.busy = false
+		if e.rec.Seq == en.Seq {
+			j.remove(e)
+			removed = true
+		}
+	}
+	j.mu.Unlock()
+	if !uploaded {
+		return removed
+	}
+	g.tstats.AddMany(
+		cos.NamedVal64{Name: WbUploadCount, Value: 1},
+		cos.NamedVal64{Name: WbUploadSize, Value: en.Size},
+	)
+	return removed
+}
+
+// failed to upload: retry later
+func WbFailed(en *WbEntry) {
+	j := wbj
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	if e, ok := j.pending[en.Uname]; ok {
+		e.busy = false
+		if e.rec.Seq == en.Seq {
+			backoff := min(wbRetryMin<<min(e.retries, 16), wbRetryMax)
+			e.next = mono.NanoTime() + int64(backoff)
+			e.retries++
+		}
+	}
+	j.mu.Unlock()
+	g.tstats.Inc(ErrWbUploadCount)
+}
+
+// cancel pending upload (e.g., object deleted or no longer exists locally); the caller must be
+// holding the object's wlock; returns true if was pending
+func WbCancel(uname string) (canceled bool) {
+	j := wbj
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	if e, ok := j.pending[uname]; ok {
+		j.remove(e)
+		canceled = true
+	}
+	j.mu.Unlock()
+	return canceled
+}
+
+func WbPending(uname string) (ok bool) {
+	j := wbj
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	_, ok = j.pending[uname]
+	j.mu.Unlock()
+	return ok
+}
+
+// number of pending entries in a given bucket
+func WbPendingBck(bck *cmn.Bck) (cnt int) {
+	j := wbj
+	if j == nil {
+		return 0
+	}
+	prefix := bck.MakeUname("")
+	j.mu.Lock()
+	for uname := range j.pending {
+		if strings.HasPrefix(uname, prefix) {
+			cnt++
+		}
+	}
+	j.mu.Unlock()
+	return cnt
+}
+
+// backlog: number of pending entries, their total size, and the age of the oldest one
+func WbBacklog() (cnt, size int64, lag time.Duration) {
+	j := wbj
+	if j == nil {
+		return 0, 0, 0
+	}
+	j.mu.Lock()
+	cnt, size = int64(len(j.pending)), j.size
+	for _, e := range j.queue {
+		if j.pending[e.rec.Uname] == e {
+			lag = time.Since(time.Unix(0, e.rec.Added))
+			break
+		}
+	}
+	j.mu.Unlock()
+	return cnt, size, max(lag, 0)
+}
+
+///////////////
+// wbJournal //
+///////////////
+
+// (under lock)
+func (j *wbJournal) remove(e *wbEntry) {
+	delete(j.pending, e.rec.Uname)
+	j.size -= e.rec.Size
+	rec := wbRec{Uname: e.rec.Uname, Seq: e.rec.Seq, Done: true}
+	if err := j.write(&rec, false /*sync*/); err != nil {
+		nlog.Errorln("write-back journal:", err) // (will be re-uploaded upon restart)
+	}
+	if j.nrec > max(wbCompactMin, 4*len(j.pending)) {
+		if err := j.compact(); err != nil {
+			nlog.Errorln("write-back journal:", err)
+		}
+	}
+}
+
+func (j *wbJournal) write(rec *wbRec, sync bool) error {
+	b, err := jsoniter.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if _, err := j.fh.Write(b); err != nil {
+		return fmt.Errorf("failed to write %s: %w", j.fpath, err)
+	}
+	j.nrec++
+	if sync {
+		if err := j.fh.Sync(); err != nil {
+			return fmt.Errorf("failed to sync %s: %w", j.fpath, err)
+		}
+	}
+	return nil
+}
+
+func (j *wbJournal) replay() error {
+	fh, err := os.Open(j.fpath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer cos.Close(fh)
+
+	scanner := bufio.NewScanner(fh)
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+	for scanner.Scan() {
+		var rec wbRec
+		if err := jsoniter.Unmarshal(scanner.Bytes(), &rec); err != nil || rec.Uname == "" {
+			// (e.g., torn write upon power loss)
+			nlog.Warningln("write-back journal", j.fpath, "- skipping invalid record:", err)
+			continue
+		}
+		j.seq = max(j.seq, rec.Seq)
+		e, ok := j.pending[rec.Uname]
+		switch {
+		case rec.Done:
+			if ok && e.rec.Seq == rec.Seq {
+				delete(j.pending, rec.Uname)
+				j.size -= e.rec.Size
+			}
+		case ok:
+			j.size += rec.Size - e.rec.Size
+			rec.Added = e.rec.Added
+			e.rec = rec
+		default:
+			e = &wbEntry{rec: rec}
+			j.pending[rec.Uname] = e
+			j.queue = append(j.queue, e)
+			j.size += rec.Size
+		}
+	}
+	return scanner.Err()
+}
+
+// rewrite the journal to contain only pending entries (under lock)
+func (j *wbJournal) compact() error {
+	tmp := j.fpath + ".tmp"
+	fh, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	var (
+		bw    = bufio.NewWriter(fh)
+		queue = make([]*wbEntry, 0, len(j.pending))
+	)
+	for _, e := range j.queue {
+		if j.pending[e.rec.Uname] != e {
+			continue
+		}
+		b, err := jsoniter.Marshal(&e.rec)
+		if err != nil {
+			cos.Close(fh)
+			return err
+		}
+		bw.Write(b)
+		bw.WriteByte('\n')
+		queue = append(queue, e)
+	}
+	if err = bw.Flush(); err == nil {
+		err = fh.Sync()
+	}
+	cos.Close(fh)
+	if err == nil {
+		err = os.Rename(tmp, j.fpath)
+	}
+	if err != nil {
+		cos.RemoveFile(tmp)
+		return err
+	}
+	if j.fh != nil {
+		cos.Close(j.fh)
+	}
+	if j.fh, err = os.OpenFile(j.fpath, os.O_APPEND|os.O_WRONLY, cos.PermRWR); err != nil {
+		return err
+	}
+	j.queue, j.nrec = queue, len(queue)
+	return nil
+}
@@ -185,6 +185,46 @@ func (smap *Smap) HrwTargetList(uname string, count int) (sis Nodes, err error)
 	return sis, nil
 }
 
+// HrwTargetDomains is HrwTargetList that also spreads the selected targets across
+// failure domains - the values of the `domain` label (e.g., "rack" or "zone"):
+//   - a target is selected only if its domain has not been selected yet - until all
+//     domains are used up, at which point the remaining targets get selected in HRW order;
+//   - unlabeled targets are considered separate (single-target) domains;
+//   - empty `domain` - same as HrwTargetList;
+//   - in all cases, the first selected target is the HRW target (the object's "owner").
+func (smap *Smap) HrwTargetDomains(uname string, count int, domain string) (Nodes, error) {
+	if domain == "" {
+		return smap.HrwTargetList(uname, count)
+	}
+	all, err := smap.HrwTargetList(uname, smap.CountTargets())
+	if err != nil {
+		return nil, err
+	}
+	if len(all) < count {
+		return nil, fmt.Errorf("%v: required %d, available %d, %s", cmn.ErrNotEnoughTargets, count, len(all), smap)
+	}
+	var (
+		sis  = make(Nodes, 0, count)
+		rest = make(Nodes, 0, len(all))
+		used = make(map[string]struct{}, count)
+	)
+	for _, tsi := range all {
+		val := tsi.Label(domain)
+		if val == "" {
+			sis = append(sis, tsi)
+		} else if _, ok := used[val]; !ok {
+			used[val] = struct{}{}
+			sis = append(sis, tsi)
+		} else {
+			rest = append(rest, tsi)
+		}
+		if len(sis) == count {
+			return sis, nil
+		}
+	}
+	return append(sis, rest[:count-len(sis)]...), nil
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
@@ -0,0 +1,77 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HRW", func() {
+	const numRacks, perRack = 3, 4
+
+	newSmap := func(labeled bool) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, numRacks*perRack), Pmap: make(meta.NodeMap)}
+		for i := 0; i < numRacks*perRack; i++ {
+			tsi := &meta.Snode{}
+			tsi.Init(fmt.Sprintf("t%d", i), apc.Target)
+			if labeled {
+				tsi.Labels = cos.StrKVs{"rack": fmt.Sprintf("r%d", i%numRacks)}
+			}
+			smap.Tmap[tsi.ID()] = tsi
+		}
+		return smap
+	}
+
+	Describe("HrwTargetDomains", func() {
+		It("should select the HRW target first and spread across domains", func() {
+			smap := newSmap(true)
+			for i := 0; i < 100; i++ {
+				uname := fmt.Sprintf("bck/obj-%d", i)
+				owner, err := smap.HrwName2T(uname)
+				Expect(err).NotTo(HaveOccurred())
+
+				sis, err := smap.HrwTargetDomains(uname, numRacks, "rack")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sis).To(HaveLen(numRacks))
+				Expect(sis[0].ID()).To(Equal(owner.ID()))
+				racks := make(map[string]struct{}, numRacks)
+				for _, tsi := range sis {
+					racks[tsi.Label("rack")] = struct{}{}
+				}
+				Expect(racks).To(HaveLen(numRacks))
+
+				// more than the number of domains
+				sis, err = smap.HrwTargetDomains(uname, numRacks+2, "rack")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sis).To(HaveLen(numRacks + 2))
+				Expect(sis[0].ID()).To(Equal(owner.ID()))
+			}
+		})
+
+		It("should be the same as HrwTargetList when not labeled", func() {
+			smap := newSmap(false)
+			for i := 0; i < 100; i++ {
+				uname := fmt.Sprintf("bck/obj-%d", i)
+				sis, err := smap.HrwTargetDomains(uname, 3, "rack")
+				Expect(err).NotTo(HaveOccurred())
+				list, err := smap.HrwTargetList(uname, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sis).To(Equal(list))
+			}
+		})
+
+		It("should fail when there are not enough targets", func() {
+			smap := newSmap(true)
+			_, err := smap.HrwTargetDomains("bck/obj", numRacks*perRack+1, "rack")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
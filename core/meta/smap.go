@@ -71,7 +71,8 @@ type (
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
 		name       string
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`            // enum { SnodeNonElectable, SnodeIC, ... }
+		Labels     cos.StrKVs   `json:"labels,omitempty"` // e.g. {"rack": "r7"} (see cmn.LocalConfig.Labels)
 		idDigest   uint64
 	}
 
@@ -110,6 +111,12 @@ func (d *Snode) setDigest() {
 	}
 }
 
+// built-in node label: physical host (see ais/htrun nodeLabels)
+const LabelHost = "host"
+
+// returns the value of the named label (failure domain, e.g. "rack" or "zone"), or empty string
+func (d *Snode) Label(key string) string { return d.Labels[key] }
+
 func (d *Snode) ID() string   { return d.DaeID }
 func (d *Snode) Type() string { return d.DaeType } // enum { apc.Proxy, apc.Target }
 
@@ -0,0 +1,195 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// ec-reencode: migrate existing erasure coded (or replicated) objects to the bucket's
+// current EC layout(s) and placement - see cmn.ECConf.Tiers and cmn.ECConf.Domain
+// - runs upon changing EC configuration of an erasure coded bucket, or on demand (`api.StartXaction`);
+// - re-encodes in place: the main replica stays put, new slices (or replicas) overwrite the old ones
+//   and the targets that are no longer part of the object's layout get cleaned up;
+// - objects that are not erasure coded yet get encoded as well (compare with ec-encode);
+// - throttles itself depending on disk utilization; progress: number of (re-encoded) objects and bytes
+
+type (
+	reencFactory struct {
+		xreg.RenewBase
+		xctn *XactBckReencode
+	}
+	XactBckReencode struct {
+		xact.Base
+		bck  *meta.Bck
+		wg   *sync.WaitGroup // to wait for EC finishes all objects
+		smap *meta.Smap
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactBckReencode)(nil)
+	_ xreg.Renewable = (*reencFactory)(nil)
+)
+
+//////////////////
+// reencFactory //
+//////////////////
+
+func (*reencFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &reencFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *reencFactory) Start() error {
+	p.xctn = newXactBckReencode(p.Bck, p.UUID())
+	return nil
+}
+
+func (*reencFactory) Kind() string     { return apc.ActECReencode }
+func (p *reencFactory) Get() core.Xact { return p.xctn }
+
+func (*reencFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+/////////////////////
+// XactBckReencode //
+/////////////////////
+
+func newXactBckReencode(bck *meta.Bck, uuid string) (r *XactBckReencode) {
+	r = &XactBckReencode{bck: bck, wg: &sync.WaitGroup{}, smap: core.T.Sowner().Get()}
+	r.InitBase(uuid, apc.ActECReencode, bck)
+	return
+}
+
+func (r *XactBckReencode) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	bck := r.bck
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+	if !bck.Props.EC.Enabled {
+		r.AddErr(fmt.Errorf("%s does not have EC enabled", r.bck.Cname("")))
+		r.Finish()
+		return
+	}
+	nlog.Infoln(r.Name(), "EC:", bck.Props.EC.String())
+
+	opts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	opts.Bck.Copy(r.bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+
+	select {
+	case <-r.ChanAbort():
+		jg.Stop()
+	case <-jg.ListenFinished():
+		err := jg.Stop()
+		if err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.wg.Wait() // wait for all async actions to finish
+
+	r.Finish()
+}
+
+func (r *XactBckReencode) afterECObj(lom *core.LOM, err error) {
+	if err == nil {
+		r.LomAdd(lom)
+	} else if err != errSkipped {
+		nlog.Errorf("%s: failed to re-encode %s: %v", r.Name(), lom.Cname(), err)
+	}
+	r.wg.Done()
+}
+
+// re-encode the object (this target being its HRW owner) unless its layout is up to date
+func (r *XactBckReencode) visit(lom *core.LOM, _ []byte) error {
+	_, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		nlog.Errorf("%s: %s", lom, err)
+		return nil
+	}
+	if !local {
+		return nil
+	}
+	mdFQN, _, err := core.HrwFQN(lom.Bck().Bucket(), fs.ECMetaType, lom.ObjName)
+	if err != nil {
+		nlog.Warningf("metadata FQN generation failed %q: %v", lom, err)
+		return nil
+	}
+	md, err := LoadMetadata(mdFQN)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Warningf("failed to load %q: %v", mdFQN, err)
+			return nil
+		}
+		md = nil // not erasure coded yet
+	}
+	if md != nil && sameLayout(md, &lom.Bprops().EC, lom.SizeBytes()) && r.samePlacement(lom, md) {
+		return nil
+	}
+
+	r.wg.Add(1)
+	if err = ECM.ReencodeObject(lom, md, r.afterECObj); err != nil {
+		r.afterECObj(lom, err)
+		if err != errSkipped {
+			return err
+		}
+	}
+	return nil
+}
+
+func sameLayout(md *Metadata, ecConf *cmn.ECConf, size int64) bool {
+	data, parity, isCopy := ecConf.Layout(size)
+	if md.IsCopy != isCopy || md.Parity != parity {
+		return false
+	}
+	return isCopy || md.Data == data
+}
+
+// when spreading across failure domains, slices (or replicas) must reside on the
+// targets selected by HrwTargets (e.g., after changing ec.domain)
+func (r *XactBckReencode) samePlacement(lom *core.LOM, md *Metadata) bool {
+	if lom.Bprops().EC.Domain == "" {
+		return true
+	}
+	targets, err := HrwTargets(r.smap, lom.Bck(), lom.ObjName, len(md.Daemons))
+	if err != nil {
+		return true // (not enough targets - nothing to do)
+	}
+	for _, tsi := range targets {
+		if _, ok := md.Daemons[tsi.ID()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *XactBckReencode) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -0,0 +1,370 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// ec-scrub: verify and repair erasure coded objects
+// - walks the bucket and, for each object this target is the (HRW) owner of, validates
+//   the main replica and asks all the other targets listed in the object's metadata to validate
+//   their respective slices (or replicas) - see VerifyCT;
+// - checks metadata consistency: generation, layout, and slice IDs;
+// - repairs degraded objects: missing and/or corrupted slices are rebuilt via the regular
+//   restore path (see getjogger), while replicated objects and objects with too few valid slices
+//   are re-encoded from the (valid) main replica;
+// - reports per-bucket health: the numbers of degraded, repaired, and unrecoverable objects (ExtECScrubStats).
+
+type (
+	scrubFactory struct {
+		xreg.RenewBase
+		xctn *XactBckScrub
+	}
+	XactBckScrub struct {
+		xact.Base
+		bck    *meta.Bck
+		wg     *sync.WaitGroup // to wait for async re-encoding
+		smap   *meta.Smap
+		client *http.Client
+		stats  struct {
+			degraded      atomic.Int64
+			repaired      atomic.Int64
+			unrecoverable atomic.Int64
+		}
+	}
+	// extended x-ec-scrub statistics
+	ExtECScrubStats struct {
+		Degraded      int64 `json:"ec.scrub.degraded.n,string"`
+		Repaired      int64 `json:"ec.scrub.repaired.n,string"`
+		Unrecoverable int64 `json:"ec.scrub.unrecoverable.n,string"`
+	}
+
+	// result of checking a given object
+	scrubRes struct {
+		bad          int  // (remote) slices and replicas: missing, corrupted, or obsolete
+		good         int  // --/--: valid
+		mainBad      bool // main replica is corrupted
+		inconclusive bool // failed to reach one or more targets
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactBckScrub)(nil)
+	_ xreg.Renewable = (*scrubFactory)(nil)
+)
+
+//////////////////
+// scrubFactory //
+//////////////////
+
+func (*scrubFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &scrubFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *scrubFactory) Start() error {
+	p.xctn = newXactBckScrub(p.Bck, p.UUID())
+	return nil
+}
+
+func (*scrubFactory) Kind() string     { return apc.ActECScrub }
+func (p *scrubFactory) Get() core.Xact { return p.xctn }
+
+func (*scrubFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+//////////////////
+// XactBckScrub //
+//////////////////
+
+func newXactBckScrub(bck *meta.Bck, uuid string) (r *XactBckScrub) {
+	var (
+		config = cmn.GCO.Get()
+		cargs  = cmn.TransportArgs{Timeout: config.Client.Timeout.D()}
+	)
+	r = &XactBckScrub{bck: bck, wg: &sync.WaitGroup{}, smap: core.T.Sowner().Get()}
+	if config.Net.HTTP.UseHTTPS {
+		r.client = cmn.NewIntraClientTLS(cargs, config)
+	} else {
+		r.client = cmn.NewClient(cargs)
+	}
+	r.InitBase(uuid, apc.ActECScrub, bck)
+	return
+}
+
+func (r *XactBckScrub) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	bck := r.bck
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+	if !bck.Props.EC.Enabled {
+		r.AddErr(fmt.Errorf("%s does not have EC enabled", r.bck.Cname("")))
+		r.Finish()
+		return
+	}
+
+	opts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	opts.Bck.Copy(r.bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+	jg.Run()
+
+	select {
+	case <-r.ChanAbort():
+		jg.Stop()
+	case <-jg.ListenFinished():
+		err := jg.Stop()
+		if err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.wg.Wait() // wait for all async actions to finish
+
+	snap := r.extSnap()
+	nlog.Infoln(r.Name(), "checked:", r.Objs(), "degraded:", snap.Degraded, "repaired:", snap.Repaired,
+		"unrecoverable:", snap.Unrecoverable)
+	r.Finish()
+}
+
+func (r *XactBckScrub) visit(lom *core.LOM, _ []byte) error {
+	_, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		nlog.Errorf("%s: %s", lom, err)
+		return nil
+	}
+	if !local {
+		return nil
+	}
+	md, err := ObjectMetadata(lom.Bck(), lom.ObjName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // not erasure coded yet
+		}
+		// damaged metafile: re-encode from the main replica (if valid)
+		nlog.Warningln(r.Name(), err)
+		res := &scrubRes{}
+		if res.mainBad, err = validateMain(lom); err != nil {
+			nlog.Warningln(r.Name(), lom.Cname(), err)
+			return nil
+		}
+		r.stats.degraded.Inc()
+		r.repair(lom, nil, res)
+		return nil
+	}
+	r.ObjsAdd(1, lom.SizeBytes())
+
+	res, err := r.check(lom, md)
+	if err != nil {
+		nlog.Warningln(r.Name(), lom.Cname(), err)
+		return nil
+	}
+	if !res.mainBad && res.bad == 0 {
+		return nil // healthy
+	}
+	r.stats.degraded.Inc()
+	if res.inconclusive {
+		return nil // will try again next time
+	}
+	r.repair(lom, md, res)
+	return nil
+}
+
+// validate the main replica and ask the targets that must have the object's
+// slices (or replicas) to do the same
+func (r *XactBckScrub) check(lom *core.LOM, md *Metadata) (res *scrubRes, err error) {
+	res = &scrubRes{}
+	if res.mainBad, err = validateMain(lom); err != nil {
+		return nil, err
+	}
+
+	expected := md.Parity // the number of remote CTs
+	if !md.IsCopy {
+		expected += md.Data
+	}
+	for _, tsi := range md.RemoteTargets() {
+		rmd, err := requestVerifyCT(lom.Bucket(), lom.ObjName, tsi, r.client)
+		switch {
+		case err == nil:
+			if rmd.Generation == md.Generation && rmd.SliceID == int(md.Daemons[tsi.ID()]) &&
+				rmd.Data == md.Data && rmd.Parity == md.Parity && rmd.IsCopy == md.IsCopy {
+				res.good++
+			} else if cmn.Rom.FastV(4, cos.SmoduleEC) {
+				nlog.Infof("%s: obsolete %s metadata at %s (generation %d vs %d)", r.Name(), lom.Cname(), tsi,
+					rmd.Generation, md.Generation)
+			}
+		case err == ErrorCorrupted:
+			nlog.Warningf("%s: %s at %s is corrupted", r.Name(), lom.Cname(), tsi)
+		case cos.IsNotExist(err, 0):
+			if cmn.Rom.FastV(4, cos.SmoduleEC) {
+				nlog.Infof("%s: %s not found at %s", r.Name(), lom.Cname(), tsi)
+			}
+		default:
+			nlog.Warningf("%s: failed to verify %s at %s: %v", r.Name(), lom.Cname(), tsi, err)
+			res.inconclusive = true
+		}
+	}
+	res.bad = max(expected-res.good, 0)
+	return res, nil
+}
+
+// - restore (and rebuild missing slices) via the regular EC restore path when there are enough valid slices;
+// - otherwise, re-encode from the main replica
+func (r *XactBckScrub) repair(lom *core.LOM, md *Metadata, res *scrubRes) {
+	var restore bool
+	switch {
+	case md == nil:
+	case md.IsCopy:
+		restore = res.mainBad && res.good > 0
+	default:
+		restore = res.good >= md.Data
+	}
+	if !restore && res.mainBad {
+		nlog.Errorf("%s: %s is unrecoverable (valid slices or replicas: %d)", r.Name(), lom.Cname(), res.good)
+		r.stats.unrecoverable.Inc()
+		return
+	}
+	if !restore {
+		r.wg.Add(1)
+		if err := ECM.ReencodeObject(lom, md, r.afterReencode); err != nil {
+			r.afterReencode(lom, err)
+		}
+		return
+	}
+
+	if res.mainBad {
+		cos.RemoveFile(lom.FQN)
+	}
+	if err := ECM.RestoreObject(lom); err != nil {
+		nlog.Errorf("%s: failed to restore %s: %v", r.Name(), lom.Cname(), err)
+		if res.mainBad {
+			r.stats.unrecoverable.Inc()
+		}
+		return
+	}
+	if res.mainBad {
+		lom.Uncache()
+		err := lom.Load(false /*cache it*/, false /*locked*/)
+		if err == nil {
+			err = lom.ValidateContentChecksum()
+		}
+		if err != nil {
+			nlog.Errorf("%s: failed to restore %s: %v", r.Name(), lom.Cname(), err)
+			r.stats.unrecoverable.Inc()
+			return
+		}
+	}
+	r.stats.repaired.Inc()
+}
+
+func validateMain(lom *core.LOM) (bad bool, err error) {
+	lom.Lock(false)
+	err = lom.ValidateContentChecksum()
+	lom.Unlock(false)
+	if err != nil && cos.IsErrBadCksum(err) {
+		nlog.Warningln(err)
+		return true, nil
+	}
+	return false, err
+}
+
+func (r *XactBckScrub) afterReencode(lom *core.LOM, err error) {
+	if err == nil {
+		r.stats.repaired.Inc()
+	} else if err != errSkipped {
+		nlog.Errorf("%s: failed to re-encode %s: %v", r.Name(), lom.Cname(), err)
+	}
+	r.wg.Done()
+}
+
+func (r *XactBckScrub) extSnap() *ExtECScrubStats {
+	return &ExtECScrubStats{
+		Degraded:      r.stats.degraded.Load(),
+		Repaired:      r.stats.repaired.Load(),
+		Unrecoverable: r.stats.unrecoverable.Load(),
+	}
+}
+
+func (r *XactBckScrub) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = r.extSnap()
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+//
+// verify local CT (target side of requestVerifyCT)
+//
+
+// VerifyCT loads the object's local metadata and validates the corresponding
+// slice or replica; returns ErrorCorrupted on checksum mismatch
+func VerifyCT(bck *meta.Bck, objName string) (*Metadata, error) {
+	md, err := ObjectMetadata(bck, objName)
+	if err != nil {
+		return nil, err
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return nil, err
+	}
+
+	// full replica
+	if md.SliceID == 0 {
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return nil, err
+		}
+		if err := lom.ValidateContentChecksum(); err != nil {
+			if cos.IsErrBadCksum(err) {
+				nlog.Warningln(err)
+				return nil, ErrorCorrupted
+			}
+			return nil, err
+		}
+		return md, nil
+	}
+
+	// slice
+	fqn := lom.Mountpath().MakePathFQN(bck.Bucket(), fs.ECSliceType, objName)
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	err = cksumSlice(fh, cos.NewCksum(md.CksumType, md.CksumValue), objName)
+	cos.Close(fh)
+	if err != nil {
+		if cos.IsErrBadCksum(err) {
+			nlog.Warningln(err)
+			return nil, ErrorCorrupted
+		}
+		return nil, err
+	}
+	return md, nil
+}
@@ -49,7 +49,7 @@ import (
 // data slice and #ParitySlices replicas
 //
 // NOTE: All slices and replicas must be on the different targets. The target
-// list is calculated by HrwTargetList. The first target in the list is the
+// list is calculated by HrwTargets. The first target in the list is the
 // "main" target that keeps the full object, the others keep only slices/replicas
 //
 // NOTE: All slices must be of the same size. So, the last slice can be padded
@@ -101,7 +101,7 @@ import (
 //			to local storage and sends recalculated data and parity slices to the
 //			targets which must have a slice but are 'empty' at this moment.
 // NOTE: the slices are stored on targets in random order, except the first
-//	     PUT when the main target stores the slices in the order of HrwTargetList
+//	     PUT when the main target stores the slices in the order of HrwTargets
 //		 algorithm returns.
 
 const (
@@ -115,8 +115,9 @@ const (
 	ActClearRequests  = "clear-requests"
 	ActEnableRequests = "enable-requests"
 
-	URLCT   = "ct"   // for using in URL path - requests for slices/replicas
-	URLMeta = "meta" /// .. - metadata requests
+	URLCT     = "ct"     // for using in URL path - requests for slices/replicas
+	URLMeta   = "meta"   /// .. - metadata requests
+	URLVerify = "verify" // .. - verify local slice (or replica) and return its metadata
 
 	// EC switches to disk from SGL when memory pressure is high and the amount of
 	// memory required to encode an object exceeds the limit
@@ -133,6 +134,7 @@ type (
 
 		putTime time.Time // time when the object is put into main queue
 		tm      time.Time // to measure different steps
+		prev    *Metadata // re-encoding: previous layout (to remove slices and replicas that are no longer needed)
 		IsCopy  bool      // replicate or use erasure coding
 		rebuild bool      // true - internal request to reencode, e.g., from ec-encode xaction
 	}
@@ -192,6 +194,7 @@ var (
 	ErrorECDisabled = errors.New("EC is disabled for bucket")
 	ErrorNoMetafile = errors.New("no metafile")
 	ErrorNotFound   = errors.New("not found")
+	ErrorCorrupted  = errors.New("corrupted slice or replica")
 )
 
 func Init() {
@@ -205,6 +208,8 @@ func Init() {
 	xreg.RegBckXact(&putFactory{})
 	xreg.RegBckXact(&rspFactory{})
 	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&reencFactory{})
+	xreg.RegBckXact(&scrubFactory{})
 
 	if err := initManager(); err != nil {
 		cos.ExitLogf("Failed to init manager: %v", err)
@@ -314,8 +319,16 @@ func unique(prefix string, bck *meta.Bck, objName string) string {
 	return prefix + string(filepath.Separator) + bck.MakeUname(objName)
 }
 
+// HrwTargets returns the targets to store the object and its slices (or replicas):
+// the "main" (HRW) target first, followed by the rest spread across the bucket's
+// failure domains, if configured (see cmn.ECConf.Domain)
+func HrwTargets(smap *meta.Smap, bck *meta.Bck, objName string, count int) (meta.Nodes, error) {
+	return smap.HrwTargetDomains(bck.MakeUname(objName), count, bck.Props.EC.Domain)
+}
+
 func IsECCopy(size int64, ecConf *cmn.ECConf) bool {
-	return size < ecConf.ObjSizeLimit
+	_, _, isCopy := ecConf.Layout(size)
+	return isCopy
 }
 
 // returns whether EC must use disk instead of keeping everything in memory.
@@ -370,7 +383,17 @@ func freeSlices(slices []*slice) {
 
 // RequestECMeta returns an EC metadata found on a remote target.
 func RequestECMeta(bck *cmn.Bck, objName string, si *meta.Snode, client *http.Client) (*Metadata, error) {
-	path := apc.URLPathEC.Join(URLMeta, bck.Name, objName)
+	return requestMeta(URLMeta, bck, objName, si, client)
+}
+
+// requestVerifyCT asks a remote target to validate its slice (or replica) against
+// the respective metadata; returns ErrorCorrupted when the checksums don't match.
+func requestVerifyCT(bck *cmn.Bck, objName string, si *meta.Snode, client *http.Client) (*Metadata, error) {
+	return requestMeta(URLVerify, bck, objName, si, client)
+}
+
+func requestMeta(what string, bck *cmn.Bck, objName string, si *meta.Snode, client *http.Client) (*Metadata, error) {
+	path := apc.URLPathEC.Join(what, bck.Name, objName)
 	query := url.Values{}
 	query = bck.AddToQuery(query)
 	url := si.URL(cmn.NetIntraData) + path
@@ -385,10 +408,13 @@ func RequestECMeta(bck *cmn.Bck, objName string, si *meta.Snode, client *http.Cl
 	}
 
 	defer cos.Close(resp.Body)
-	if resp.StatusCode == http.StatusNotFound {
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
 		return nil, cos.NewErrNotFound(core.T, bck.Cname(objName))
-	}
-	if resp.StatusCode != http.StatusOK {
+	case http.StatusUnprocessableEntity:
+		return nil, ErrorCorrupted
+	default:
 		return nil, cmn.NewErrFailedTo(core.T, "request ec md", bck.Cname(objName), err)
 	}
 	return MetaFromReader(resp.Body)
@@ -149,7 +149,7 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 		return err
 	}
 	smap := core.T.Sowner().Get()
-	targets, err := smap.HrwTargetList(ctx.lom.Uname(), ctx.meta.Parity+1)
+	targets, err := HrwTargets(smap, ctx.lom.Bck(), ctx.lom.ObjName, ctx.meta.Parity+1)
 	if err != nil {
 		return err
 	}
@@ -628,7 +628,7 @@ func (*getJogger) emptyTargets(ctx *restoreCtx) ([]string, error) {
 	}
 	// Generate the list of targets that should have a slice.
 	smap := core.T.Sowner().Get()
-	targets, err := smap.HrwTargetList(ctx.lom.Uname(), sliceCnt+1)
+	targets, err := HrwTargets(smap, ctx.lom.Bck(), ctx.lom.ObjName, sliceCnt+1)
 	if err != nil {
 		nlog.Warningln(err)
 		return nil, err
@@ -246,6 +246,34 @@ func (mgr *Manager) EncodeObject(lom *core.LOM, cb core.OnFinishObj) error {
 	return nil
 }
 
+// ReencodeObject re-encodes (or replicates) the object in accordance with the bucket's
+// current EC layout - see ec-reencode xaction:
+//   - prev - the object's current metadata (nil if not erasure coded yet)
+//   - cb - callback that is called after the object is re-encoded
+func (mgr *Manager) ReencodeObject(lom *core.LOM, prev *Metadata, cb core.OnFinishObj) error {
+	if !lom.Bprops().EC.Enabled {
+		return ErrorECDisabled
+	}
+	cs := fs.Cap()
+	if err := cs.Err(); err != nil {
+		return err
+	}
+	spec, _ := fs.CSM.FileSpec(lom.FQN)
+	if spec != nil && !spec.PermToProcess() {
+		return errSkipped
+	}
+
+	req := allocateReq(ActSplit, lom.LIF())
+	req.IsCopy = IsECCopy(lom.SizeBytes(), &lom.Bprops().EC)
+	req.prev = prev
+	req.rebuild = true
+	req.Callback = cb
+
+	mgr.RestoreBckPutXact(lom.Bck()).encode(req, lom)
+
+	return nil
+}
+
 func (mgr *Manager) CleanupObject(lom *core.LOM) {
 	if !lom.Bprops().EC.Enabled {
 		return
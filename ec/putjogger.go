@@ -82,14 +82,16 @@ func (ctx *encodeCtx) freeReplica() {
 func (*putJogger) newCtx(lom *core.LOM, meta *Metadata) (ctx *encodeCtx, err error) {
 	ctx = allocCtx()
 	ctx.lom = lom
-	ctx.dataSlices = lom.Bprops().EC.DataSlices
-	ctx.paritySlices = lom.Bprops().EC.ParitySlices
+	ctx.dataSlices = meta.Data
+	ctx.paritySlices = meta.Parity
 	ctx.meta = meta
 
-	totalCnt := ctx.paritySlices + ctx.dataSlices
-	ctx.sliceSize = SliceSize(ctx.lom.SizeBytes(), ctx.dataSlices)
-	ctx.slices = make([]*slice, totalCnt)
-	ctx.padSize = ctx.sliceSize*int64(ctx.dataSlices) - ctx.lom.SizeBytes()
+	if !meta.IsCopy {
+		totalCnt := ctx.paritySlices + ctx.dataSlices
+		ctx.sliceSize = SliceSize(ctx.lom.SizeBytes(), ctx.dataSlices)
+		ctx.slices = make([]*slice, totalCnt)
+		ctx.padSize = ctx.sliceSize*int64(ctx.dataSlices) - ctx.lom.SizeBytes()
+	}
 
 	ctx.fh, err = cos.NewFileHandle(lom.FQN)
 	return ctx, err
@@ -125,8 +127,8 @@ func (c *putJogger) processRequest(req *request) {
 		if err = lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 			return
 		}
-		ecConf := lom.Bprops().EC
-		memRequired := lom.SizeBytes() * int64(ecConf.DataSlices+ecConf.ParitySlices) / int64(ecConf.ParitySlices)
+		data, parity, _ := lom.Bprops().EC.Layout(lom.SizeBytes())
+		memRequired := lom.SizeBytes() * int64(data+parity) / int64(parity)
 		c.toDisk = useDisk(memRequired, c.parent.config)
 	}
 
@@ -214,17 +216,17 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		nlog.Infof("Encoding %q...", lom)
 	}
 	var (
-		ecConf     = lom.Bprops().EC
-		reqTargets = ecConf.ParitySlices + 1
-		smap       = core.T.Sowner().Get()
+		data, parity, _ = lom.Bprops().EC.Layout(lom.SizeBytes())
+		reqTargets      = parity + 1
+		smap            = core.T.Sowner().Get()
 	)
 	if !req.IsCopy {
-		reqTargets += ecConf.DataSlices
+		reqTargets += data
 	}
 	targetCnt := smap.CountActiveTs()
 	if targetCnt < reqTargets {
-		return fmt.Errorf("%v: given EC config (d=%d, p=%d), %d targets required to encode %s (have %d, %s)",
-			cmn.ErrNotEnoughTargets, ecConf.DataSlices, ecConf.ParitySlices, reqTargets, lom, targetCnt, smap.StringEx())
+		return fmt.Errorf("%v: given EC layout (d=%d, p=%d), %d targets required to encode %s (have %d, %s)",
+			cmn.ErrNotEnoughTargets, data, parity, reqTargets, lom, targetCnt, smap.StringEx())
 	}
 
 	var (
@@ -236,8 +238,8 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		MDVersion:   MDVersionLast,
 		Generation:  generation,
 		Size:        lom.SizeBytes(),
-		Data:        ecConf.DataSlices,
-		Parity:      ecConf.ParitySlices,
+		Data:        data,
+		Parity:      parity,
 		IsCopy:      req.IsCopy,
 		ObjCksum:    cksumValue,
 		CksumType:   cksumType,
@@ -252,7 +254,7 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 	if err != nil {
 		return err
 	}
-	targets, err := smap.HrwTargetList(ctx.lom.Uname(), reqTargets)
+	targets, err := HrwTargets(smap, ctx.lom.Bck(), ctx.lom.ObjName, reqTargets)
 	if err != nil {
 		return err
 	}
@@ -284,6 +286,9 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		}
 		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
 	}
+	if req.prev != nil {
+		return c.cleanupStale(lom, req.prev, meta)
+	}
 	return nil
 }
 
@@ -321,6 +326,27 @@ func (c *putJogger) cleanup(lom *core.LOM) error {
 	return c.parent.mgr.req().Send(o, nil, nodes...)
 }
 
+// Upon re-encoding: remove slices and replicas from the targets that are not part of the new layout
+// (the targets that are, have already received the new ones along with the new metafile)
+func (c *putJogger) cleanupStale(lom *core.LOM, prev, md *Metadata) error {
+	nodes := make([]*meta.Snode, 0, len(prev.Daemons))
+	for _, tsi := range prev.RemoteTargets() {
+		if _, ok := md.Daemons[tsi.ID()]; !ok {
+			nodes = append(nodes, tsi)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+	request := newIntraReq(reqDel, nil, lom.Bck()).NewPack(g.smm)
+	o := transport.AllocSend()
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel}
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Callback = c.ctSendCallback
+	c.parent.IncPending()
+	return c.parent.mgr.req().Send(o, nil, nodes...)
+}
+
 // Sends object replicas to targets that must have replicas after the client
 // uploads the main replica
 func (c *putJogger) createCopies(ctx *encodeCtx) error {
@@ -19,9 +19,10 @@ const (
 	MD5          = "md5"          // compare md5(name)
 	Shuffle      = "shuffle"      // random shuffle (use with the same seed to reproduce)
 	Content      = "content"      // extract (int, string, float) from a given file, and compare
+	Column       = "column"       // ditto, from a given column (Parquet and Arrow shards only)
 )
 
-var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, None}
+var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, Column, None}
 
 type Algorithm struct {
 	// one of the `algorithms` above
@@ -38,9 +39,13 @@ type Algorithm struct {
 	// NOTE: not to confuse with shards "input_extension"
 	Ext string `json:"extension"`
 
-	// ditto: Content only
+	// ditto: Content and Column
 	// `shard.contentKeyTypes` enum values: {"int", "string", "float" }
 	ContentKeyType string `json:"content_key_type"`
+
+	// usage: exclusively for Column sorting
+	// name of the column providing sorting key for each record (row or row group)
+	Column string `json:"column,omitempty"`
 }
 
 // RequestSpec defines the user specification for requests to the endpoint /v1/sort.
@@ -59,6 +64,12 @@ type RequestSpec struct {
 	OutputExtension string `json:"output_extension" yaml:"output_extension"`
 	// Default: ""
 	Description string `json:"description" yaml:"description"`
+	// Columnar (Parquet and Arrow) shards only: number of rows per output row group (Arrow: record batch)
+	// Default: shard.DefaultRowGroupSize
+	OutputRowGroupSize int64 `json:"output_row_group_size,omitempty" yaml:"output_row_group_size,omitempty"`
+	// Columnar shards only: extract each row group (Arrow: record batch) as a single record
+	// Default: false (each row is a record)
+	RecordPerRowGroup bool `json:"record_per_row_group,omitempty" yaml:"record_per_row_group,omitempty"`
 	// Default: same as `bck` field
 	OutputBck cmn.Bck `json:"output_bck" yaml:"output_bck"`
 	// Default: alphanumeric, increasing
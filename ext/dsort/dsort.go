@@ -13,7 +13,6 @@ import (
 	"math"
 	"net/http"
 	"net/url"
-	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -370,7 +369,7 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 			goto exit
 		}
 
-		file, err := cos.NewFileHandle(lom.FQN)
+		file, err := lom.NewHandle()
 		if err != nil {
 			return err
 		}
@@ -581,10 +580,10 @@ func (m *Manager) generateShardsWithTemplate(maxSize int64) ([]*shard.Shard, err
 			// no more shard names are available
 			return nil, errors.Errorf("number of shards to be created exceeds expected number of shards (%d)", shardCount)
 		}
+		ext, err := shard.Mime("", name)
 		shard := &shard.Shard{
 			Name: name,
 		}
-		ext, err := archive.Mime("", name)
 		if err == nil {
 			debug.Assert(m.Pars.OutputExtension == ext)
 		} else {
@@ -886,7 +885,7 @@ func (es *extractShard) do() (err error) {
 	m := es.m
 	shardName := es.name
 	if es.isRange && m.Pars.InputExtension != "" {
-		ext, errV := archive.Mime("", es.name) // from filename
+		ext, errV := shard.Mime("", es.name) // from filename
 		if errV == nil {
 			if !archive.EqExt(ext, m.Pars.InputExtension) {
 				if cmn.Rom.FastV(4, cos.SmoduleDsort) {
@@ -932,7 +931,7 @@ func (es *extractShard) _do(lom *core.LOM) error {
 	shardRW := m.shardRW
 	if shardRW == nil {
 		debug.Assert(!m.Pars.DryRun)
-		ext, err := archive.Mime("", lom.FQN)
+		ext, err := shard.Mime("", lom.FQN)
 		if err != nil {
 			return nil // skip
 		}
@@ -955,7 +954,7 @@ func (es *extractShard) _do(lom *core.LOM) error {
 	}
 
 	lom.Lock(false)
-	fh, err := os.Open(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err != nil {
 		phaseInfo.adjuster.releaseSema(lom.Mountpath())
 		lom.Unlock(false)
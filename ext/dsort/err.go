@@ -22,6 +22,7 @@ const (
 
 var (
 	errAlgExt            = errors.New("algorithm: invalid extension")
+	errAlgColumn         = errors.New("algorithm: missing column name")
 	errNegConcLimit      = errors.New("negative concurrency limit")
 	errMissingOutputSize = errors.New("output shard size must be set (cannot be 0 and cannot be omitted)")
 	errMissingSrcBucket  = errors.New("missing source bucket")
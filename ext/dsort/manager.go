@@ -450,6 +450,8 @@ func (m *Manager) setRW() (err error) {
 	switch m.Pars.Algorithm.Kind {
 	case Content:
 		ke, err = shard.NewContentKeyExtractor(m.Pars.Algorithm.ContentKeyType, m.Pars.Algorithm.Ext)
+	case Column:
+		ke, err = shard.NewColumnKeyExtractor(m.Pars.Algorithm.ContentKeyType, m.Pars.Algorithm.Column)
 	case MD5:
 		ke, err = shard.NewMD5KeyExtractor()
 	default:
@@ -459,7 +461,11 @@ func (m *Manager) setRW() (err error) {
 		return errors.WithStack(err)
 	}
 
-	m.shardRW = shard.RWs[m.Pars.InputExtension]
+	if shard.IsColumnar(m.Pars.InputExtension) {
+		m.shardRW = shard.NewColumnarRW(m.Pars.InputExtension, m.Pars.OutputRowGroupSize, m.Pars.RecordPerRowGroup)
+	} else {
+		m.shardRW = shard.RWs[m.Pars.InputExtension]
+	}
 	if m.shardRW == nil {
 		debug.Assert(!m.Pars.DryRun, "dry-run in combination with _any_ shard extension is not supported")
 		debug.Assert(m.Pars.InputExtension == "", m.Pars.InputExtension)
@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
 	"github.com/NVIDIA/aistore/fs"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -290,6 +291,43 @@ var _ = Describe("RequestSpec", func() {
 			_, err = rs.parse()
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("should parse spec with .parquet extension and column sorting", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix.parquet"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: Column, Column: " id ", ContentKeyType: shard.ContentKeyInt},
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(pars.InputExtension).To(Equal(shard.ExtParquet))
+			Expect(pars.OutputExtension).To(Equal(shard.ExtParquet))
+			Expect(pars.Algorithm.Column).To(Equal("id"))
+			Expect(pars.OutputRowGroupSize).To(BeEquivalentTo(shard.DefaultRowGroupSize))
+			Expect(pars.RecordPerRowGroup).To(BeFalse())
+		})
+
+		It("should parse spec with .arrow extension and row group options", func() {
+			rs := RequestSpec{
+				InputBck:           cmn.Bck{Name: "test"},
+				InputExtension:     shard.ExtArrow,
+				InputFormat:        newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:       "prefix-{0010..0111}-suffix",
+				OutputShardSize:    "10KB",
+				OutputRowGroupSize: 1000,
+				RecordPerRowGroup:  true,
+				Algorithm:          Algorithm{Kind: Shuffle},
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(pars.InputExtension).To(Equal(shard.ExtArrow))
+			Expect(pars.OutputRowGroupSize).To(BeEquivalentTo(1000))
+			Expect(pars.RecordPerRowGroup).To(BeTrue())
+		})
 	})
 
 	Context("request specs which shall NOT pass", func() {
@@ -478,6 +516,64 @@ var _ = Describe("RequestSpec", func() {
 			Expect(err).Should(HaveOccurred())
 		})
 
+		It("should fail when column sorting is used with non-columnar shards", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: Column, Column: "id", ContentKeyType: shard.ContentKeyInt},
+			}
+			_, err := rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should fail when column name is missing", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  shard.ExtParquet,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: Column, ContentKeyType: shard.ContentKeyInt},
+			}
+			_, err := rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should fail when columnar shards are resharded into a different format", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  shard.ExtParquet,
+				OutputExtension: archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: None},
+			}
+			_, err := rs.parse()
+			Expect(err).Should(HaveOccurred())
+
+			rs.InputExtension, rs.OutputExtension = archive.ExtTar, shard.ExtArrow
+			_, err = rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should fail due to negative output row group size", func() {
+			rs := RequestSpec{
+				InputBck:           cmn.Bck{Name: "test"},
+				InputExtension:     shard.ExtParquet,
+				InputFormat:        newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:       "prefix-{0010..0111}-suffix",
+				OutputShardSize:    "10KB",
+				OutputRowGroupSize: -1,
+				Algorithm:          Algorithm{Kind: None},
+			}
+			_, err := rs.parse()
+			Expect(err).Should(HaveOccurred())
+		})
+
 		It("should fail when output shard size is empty and output format is %06d", func() {
 			rs := RequestSpec{
 				InputBck:       cmn.Bck{Name: "test"},
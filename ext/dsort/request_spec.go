@@ -13,7 +13,6 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
-	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dsort/shard"
 )
@@ -42,6 +41,8 @@ type parsedReqSpec struct {
 	InputExtension      string                `json:"input_extension"`
 	OutputExtension     string                `json:"output_extension"`
 	OutputShardSize     int64                 `json:"output_shard_size,string"`
+	OutputRowGroupSize  int64                 `json:"output_row_group_size,string"`
+	RecordPerRowGroup   bool                  `json:"record_per_row_group"`
 	Pit                 *parsedInputTemplate  `json:"pit"`
 	Pot                 *parsedOutputTemplate `json:"pot"`
 	Algorithm           *Algorithm            `json:"algorithm"`
@@ -121,7 +122,7 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	if rs.InputFormat.Template != "" {
 		// template is not a filename but all we do here is
 		// checking the template's suffix for specific supported extensions
-		if ext, err := archive.Mime("", rs.InputFormat.Template); err == nil {
+		if ext, err := shard.Mime("", rs.InputFormat.Template); err == nil {
 			if rs.InputExtension != "" && rs.InputExtension != ext {
 				return nil, fmt.Errorf("input_extension: %q vs %q", rs.InputExtension, ext)
 			}
@@ -129,7 +130,7 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 		}
 	}
 	if rs.InputExtension != "" {
-		pars.InputExtension, err = archive.Mime(rs.InputExtension, "")
+		pars.InputExtension, err = shard.Mime(rs.InputExtension, "")
 		if err != nil {
 			return nil, specErr("input_extension", err)
 		}
@@ -164,7 +165,7 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 		}
 		if rs.OutputFormat != "" {
 			// (ditto)
-			if ext, err := archive.Mime("", rs.OutputFormat); err == nil {
+			if ext, err := shard.Mime("", rs.OutputFormat); err == nil {
 				if rs.OutputExtension != "" && rs.OutputExtension != ext {
 					return nil, fmt.Errorf("output_extension: %q vs %q", rs.OutputExtension, ext)
 				}
@@ -185,11 +186,14 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	if rs.OutputExtension == "" {
 		pars.OutputExtension = pars.InputExtension // default
 	} else {
-		pars.OutputExtension, err = archive.Mime(rs.OutputExtension, "")
+		pars.OutputExtension, err = shard.Mime(rs.OutputExtension, "")
 		if err != nil {
 			return nil, specErr("output_extension", err)
 		}
 	}
+	if err := pars.validateColumnar(rs); err != nil {
+		return nil, err
+	}
 
 	// mem & conc
 	if rs.MaxMemUsage == "" {
@@ -251,7 +255,8 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 			return nil, fmt.Errorf(fmtErrSeed, alg.Seed)
 		}
 	}
-	if alg.Kind == Content {
+	switch alg.Kind {
+	case Content:
 		alg.Ext = strings.TrimSpace(alg.Ext)
 		if alg.Ext == "" || alg.Ext[0] != '.' {
 			return nil, fmt.Errorf("%w %q", errAlgExt, alg.Ext)
@@ -259,13 +264,53 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 		if err := shard.ValidateContentKeyTy(alg.ContentKeyType); err != nil {
 			return nil, err
 		}
-	} else {
+	case Column:
+		alg.Column = strings.TrimSpace(alg.Column)
+		if alg.Column == "" {
+			return nil, errAlgColumn
+		}
+		if err := shard.ValidateContentKeyTy(alg.ContentKeyType); err != nil {
+			return nil, err
+		}
+	default:
 		alg.ContentKeyType = shard.ContentKeyString
 	}
 
 	return &alg, nil
 }
 
+// columnar (Parquet and Arrow) shards can only be resharded into the same format
+// (see ext/dsort/shard/columnar.go)
+func (pars *parsedReqSpec) validateColumnar(rs *RequestSpec) error {
+	if !shard.IsColumnar(pars.InputExtension) {
+		if pars.Algorithm.Kind == Column {
+			return fmt.Errorf("algorithm %q requires columnar input shards (one of: %v), got %q",
+				Column, shard.ColumnarExtensions, pars.InputExtension)
+		}
+		if shard.IsColumnar(pars.OutputExtension) {
+			return fmt.Errorf("output_extension: %q requires input shards of the same format (got %q)",
+				pars.OutputExtension, pars.InputExtension)
+		}
+		return nil
+	}
+	if pars.OutputExtension != pars.InputExtension {
+		return fmt.Errorf("output_extension: columnar shards (%q) cannot be resharded into %q",
+			pars.InputExtension, pars.OutputExtension)
+	}
+	if pars.Algorithm.Kind == Content {
+		return fmt.Errorf("algorithm %q is not supported with columnar shards (use %q)", Content, Column)
+	}
+	if rs.OutputRowGroupSize < 0 {
+		return fmt.Errorf("output_row_group_size must be >= 0 (got %d)", rs.OutputRowGroupSize)
+	}
+	pars.OutputRowGroupSize = rs.OutputRowGroupSize
+	if pars.OutputRowGroupSize == 0 {
+		pars.OutputRowGroupSize = shard.DefaultRowGroupSize
+	}
+	pars.RecordPerRowGroup = rs.RecordPerRowGroup
+	return nil
+}
+
 func validateOrderFileURL(orderURL string) (empty bool, err error) {
 	if orderURL == "" {
 		return true, nil
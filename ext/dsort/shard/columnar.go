@@ -0,0 +1,50 @@
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard
+
+import (
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Columnar shards: Parquet and Arrow IPC (file format)
+// - each row is a record or, optionally, each row group (Parquet) or record batch (Arrow);
+// - record's content is an Arrow IPC stream: schema followed by the record's row(s);
+// - output shards are written in the input format with (up to) `rowGroupSize` rows
+//   per row group (Parquet) or record batch (Arrow);
+// - all records of a given output shard must have the same schema.
+//
+// Reading and writing columnar shards requires `columnar` build tag (see columnar_arrow.go);
+// without it, aisnode does not link Apache Arrow and its Parquet dependencies.
+
+const (
+	ExtParquet = ".parquet"
+	ExtArrow   = ".arrow"
+
+	// content of each extracted record (Arrow IPC stream format)
+	ColumnarRecordExt = ".arrows"
+
+	DefaultRowGroupSize = 64 * 1024 // rows
+)
+
+var ColumnarExtensions = []string{ExtParquet, ExtArrow}
+
+func IsColumnar(ext string) bool { return cos.StringInSlice(ext, ColumnarExtensions) }
+
+// Mime is archive.Mime that also recognizes columnar formats
+func Mime(mime, filename string) (string, error) {
+	for _, ext := range ColumnarExtensions {
+		if mime != "" && strings.Contains(mime, ext[1:]) {
+			return ext, nil
+		}
+		if mime == "" && strings.HasSuffix(filename, ext) {
+			return ext, nil
+		}
+	}
+	return archive.Mime(mime, filename)
+}
@@ -0,0 +1,497 @@
+//go:build columnar
+
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/compress"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+)
+
+type (
+	colRW struct {
+		ext          string
+		rowGroupSize int64
+		perRowGroup  bool // record = row group (Parquet) or record batch (Arrow)
+	}
+
+	// `Extract` context
+	colCtx struct {
+		parent         *colRW
+		extractor      RecordExtractor
+		sgl            *memsys.SGL // current record (serialized)
+		shardName      string
+		buf            []byte
+		idx            int
+		extractedSize  int64
+		extractedCount int
+		toDisk         bool
+	}
+
+	// `Create` context
+	colWriter struct {
+		parent  *colRW
+		w       *posWriter
+		schema  *arrow.Schema
+		pqw     *pqarrow.FileWriter
+		ipcw    *ipc.FileWriter
+		pending []arrow.Record
+		rows    int64
+	}
+
+	// Arrow IPC file writer requires io.WriteSeeker (to tell the current position)
+	posWriter struct {
+		w   io.Writer
+		pos int64
+	}
+)
+
+// interface guard
+var (
+	_ RW             = (*colRW)(nil)
+	_ io.WriteSeeker = (*posWriter)(nil)
+)
+
+///////////
+// colRW //
+///////////
+
+// NewColumnarRW returns Parquet or Arrow RW that writes up to `rowGroupSize` rows
+// per row group (or record batch) and, if `perRowGroup` is set, extracts entire row groups
+// (record batches) as records.
+func NewColumnarRW(ext string, rowGroupSize int64, perRowGroup bool) RW {
+	debug.Assert(IsColumnar(ext), ext)
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+	return &colRW{ext: ext, rowGroupSize: rowGroupSize, perRowGroup: perRowGroup}
+}
+
+func (*colRW) IsCompressed() bool   { return true } // (extracted size != shard size)
+func (*colRW) SupportsOffset() bool { return false }
+func (*colRW) MetadataSize() int64  { return 0 } // schema is part of each record's content
+
+func (crw *colRW) Extract(lom *core.LOM, r cos.ReadReaderAt, extractor RecordExtractor, toDisk bool) (int64, int, error) {
+	var (
+		err error
+		sr  = io.NewSectionReader(r, 0, lom.SizeBytes())
+		c   = &colCtx{parent: crw, extractor: extractor, shardName: lom.ObjName, toDisk: toDisk}
+	)
+	buf, slab := core.T.PageMM().AllocSize(lom.SizeBytes())
+	c.buf = buf
+	c.sgl = core.T.PageMM().NewSGL(0)
+
+	switch crw.ext {
+	case ExtParquet:
+		err = c.parquet(sr)
+	case ExtArrow:
+		err = c.arrow(sr)
+	default:
+		debug.Assert(false, crw.ext)
+	}
+
+	c.sgl.Free()
+	slab.Free(buf)
+	return c.extractedSize, c.extractedCount, err
+}
+
+func (crw *colRW) Create(s *Shard, w io.Writer, loader ContentLoader) (int64, error) {
+	var (
+		cw  = &colWriter{parent: crw, w: &posWriter{w: w}}
+		sgl = core.T.PageMM().NewSGL(0)
+	)
+	defer sgl.Free()
+	for _, rec := range s.Records.All() {
+		for _, obj := range rec.Objects {
+			sgl.Reset()
+			if _, err := loader.Load(sgl, rec, obj); err != nil {
+				cw.abort()
+				return cw.w.pos, err
+			}
+			if err := cw.load(sgl); err != nil {
+				cw.abort()
+				return cw.w.pos, fmt.Errorf("%s: record %q: %w", s.Name, rec.Name, err)
+			}
+		}
+	}
+	err := cw.fini()
+	return cw.w.pos, err
+}
+
+////////////
+// colCtx //
+////////////
+
+func (c *colCtx) parquet(sr *io.SectionReader) error {
+	pf, err := file.NewParquetReader(sr)
+	if err != nil {
+		return err
+	}
+	defer pf.Close()
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return err
+	}
+	cols := make([]int, pf.MetaData().Schema.NumColumns()) // all leaf columns (nested fields included)
+	for i := range cols {
+		cols[i] = i
+	}
+	for i := 0; i < pf.NumRowGroups(); i++ {
+		tbl, err := fr.RowGroup(i).ReadTable(context.Background(), cols)
+		if err != nil {
+			return err
+		}
+		err = c.table(tbl)
+		tbl.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *colCtx) table(tbl arrow.Table) error {
+	tr := array.NewTableReader(tbl, tbl.NumRows())
+	defer tr.Release()
+	if !c.parent.perRowGroup {
+		for tr.Next() {
+			if err := c.rows(tr.Record()); err != nil {
+				return err
+			}
+		}
+		return tr.Err()
+	}
+	// (a row group may still come in more than one chunk)
+	var recs []arrow.Record
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+	for tr.Next() {
+		rec := tr.Record()
+		rec.Retain()
+		recs = append(recs, rec)
+	}
+	if err := tr.Err(); err != nil {
+		return err
+	}
+	if len(recs) == 0 {
+		return nil
+	}
+	return c.record(recs...)
+}
+
+func (c *colCtx) arrow(sr *io.SectionReader) error {
+	fr, err := ipc.NewFileReader(sr, ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return err
+	}
+	defer fr.Close()
+	for i := 0; i < fr.NumRecords(); i++ {
+		rec, err := fr.Record(i) // (valid until the next call)
+		if err != nil {
+			return err
+		}
+		if c.parent.perRowGroup {
+			err = c.record(rec)
+		} else {
+			err = c.rows(rec)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// one record per row
+func (c *colCtx) rows(rec arrow.Record) error {
+	for j := int64(0); j < rec.NumRows(); j++ {
+		row := rec.NewSlice(j, j+1)
+		err := c.record(row)
+		row.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *colCtx) record(recs ...arrow.Record) error {
+	c.sgl.Reset()
+	w := ipc.NewWriter(struct{ io.Writer }{c.sgl}, ipc.WithSchema(recs[0].Schema()))
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	args := extractRecordArgs{
+		shardName:  c.shardName,
+		recordName: fmt.Sprintf("%010d", c.idx) + ColumnarRecordExt, // (preserving the order within shard)
+		r:          c.sgl,
+		buf:        c.buf,
+		fileType:   fs.ObjectType,
+	}
+	args.extractMethod = ExtractToMem
+	if c.toDisk {
+		args.extractMethod = ExtractToDisk
+	}
+	size, err := c.extractor.RecordWithBuffer(&args)
+	if err != nil {
+		return err
+	}
+	c.idx++
+	c.extractedSize += size
+	c.extractedCount++
+	return nil
+}
+
+///////////////
+// colWriter //
+///////////////
+
+// read record's content (Arrow IPC stream) and write its rows, (re)batching as needed
+func (cw *colWriter) load(r io.Reader) error {
+	rd, err := ipc.NewReader(r, ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return err
+	}
+	defer rd.Release()
+	for rd.Next() {
+		rec := rd.Record()
+		if cw.schema == nil {
+			if err := cw.init(rec.Schema()); err != nil {
+				return err
+			}
+		} else if !cw.schema.Equal(rec.Schema()) {
+			return fmt.Errorf("schema mismatch:\n%s\nvs\n%s", rec.Schema(), cw.schema)
+		}
+		rec.Retain()
+		cw.pending = append(cw.pending, rec)
+		cw.rows += rec.NumRows()
+		if cw.rows >= cw.parent.rowGroupSize {
+			if err := cw.flush(false); err != nil {
+				return err
+			}
+		}
+	}
+	return rd.Err()
+}
+
+func (cw *colWriter) init(schema *arrow.Schema) (err error) {
+	cw.schema = schema
+	switch cw.parent.ext {
+	case ExtParquet:
+		props := parquet.NewWriterProperties(
+			parquet.WithMaxRowGroupLength(cw.parent.rowGroupSize),
+			parquet.WithCompression(compress.Codecs.Snappy),
+		)
+		cw.pqw, err = pqarrow.NewFileWriter(schema, cw.w, props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	case ExtArrow:
+		cw.ipcw, err = ipc.NewFileWriter(cw.w, ipc.WithSchema(schema), ipc.WithAllocator(memory.DefaultAllocator))
+	default:
+		debug.Assert(false, cw.parent.ext)
+	}
+	return err
+}
+
+// write full row groups; when `final` write the remaining rows as well
+func (cw *colWriter) flush(final bool) error {
+	if len(cw.pending) == 0 {
+		return nil
+	}
+	rec, err := concat(cw.schema, cw.pending)
+	cw.release()
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	var (
+		off  int64
+		n    = rec.NumRows()
+		size = cw.parent.rowGroupSize
+	)
+	for ; n-off >= size; off += size {
+		if err := cw.write(rec, off, off+size); err != nil {
+			return err
+		}
+	}
+	switch {
+	case off == n:
+	case final:
+		return cw.write(rec, off, n)
+	default:
+		cw.pending = append(cw.pending, rec.NewSlice(off, n))
+		cw.rows = n - off
+	}
+	return nil
+}
+
+func (cw *colWriter) write(rec arrow.Record, i, j int64) (err error) {
+	slice := rec.NewSlice(i, j)
+	if cw.pqw != nil {
+		err = cw.pqw.Write(slice)
+	} else {
+		err = cw.ipcw.Write(slice)
+	}
+	slice.Release()
+	return err
+}
+
+func (cw *colWriter) fini() error {
+	if cw.schema == nil {
+		return nil // (no records)
+	}
+	if err := cw.flush(true); err != nil {
+		cw.abort()
+		return err
+	}
+	if cw.pqw != nil {
+		return cw.pqw.Close()
+	}
+	return cw.ipcw.Close()
+}
+
+func (cw *colWriter) abort() { cw.release() }
+
+func (cw *colWriter) release() {
+	for _, rec := range cw.pending {
+		rec.Release()
+	}
+	cw.pending = cw.pending[:0]
+	cw.rows = 0
+}
+
+func concat(schema *arrow.Schema, recs []arrow.Record) (arrow.Record, error) {
+	if len(recs) == 1 {
+		recs[0].Retain()
+		return recs[0], nil
+	}
+	var (
+		rows int64
+		cols = make([]arrow.Array, schema.NumFields())
+		arrs = make([]arrow.Array, len(recs))
+	)
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+	for _, rec := range recs {
+		rows += rec.NumRows()
+	}
+	for i := range cols {
+		for j, rec := range recs {
+			arrs[j] = rec.Column(i)
+		}
+		col, err := array.Concatenate(arrs, memory.DefaultAllocator)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = col
+	}
+	return array.NewRecord(schema, cols, rows), nil
+}
+
+// sorting key: the value of the named column in the record's first row
+func columnKey(r io.Reader, column, ty string) (any, error) {
+	rd, err := ipc.NewReader(r, ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return nil, err
+	}
+	defer rd.Release()
+	if !rd.Next() {
+		if err := rd.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("empty record")
+	}
+	rec := rd.Record()
+	indices := rec.Schema().FieldIndices(column)
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("sorting key column %q not found", column)
+	}
+	arr := rec.Column(indices[0])
+	if arr.Len() == 0 || arr.IsNull(0) {
+		return nil, fmt.Errorf("sorting key column %q: null value", column)
+	}
+	switch ty {
+	case ContentKeyInt:
+		switch a := arr.(type) {
+		case *array.Int64:
+			return a.Value(0), nil
+		case *array.Int32:
+			return int64(a.Value(0)), nil
+		case *array.Int16:
+			return int64(a.Value(0)), nil
+		case *array.Int8:
+			return int64(a.Value(0)), nil
+		case *array.Uint32:
+			return int64(a.Value(0)), nil
+		case *array.Uint16:
+			return int64(a.Value(0)), nil
+		case *array.Uint8:
+			return int64(a.Value(0)), nil
+		}
+		return strconv.ParseInt(arr.ValueStr(0), 10, 64)
+	case ContentKeyFloat:
+		switch a := arr.(type) {
+		case *array.Float64:
+			return a.Value(0), nil
+		case *array.Float32:
+			return float64(a.Value(0)), nil
+		}
+		return strconv.ParseFloat(arr.ValueStr(0), 64)
+	case ContentKeyString:
+		return arr.ValueStr(0), nil
+	default:
+		return nil, &ErrSortingKeyType{ty}
+	}
+}
+
+///////////////
+// posWriter //
+///////////////
+
+func (pw *posWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.pos += int64(n)
+	return n, err
+}
+
+func (pw *posWriter) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekCurrent {
+		return 0, errors.New("posWriter: only supports telling the current position")
+	}
+	return pw.pos, nil
+}
@@ -0,0 +1,43 @@
+//go:build !columnar
+
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard
+
+import (
+	"errors"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+)
+
+var errColumnar = errors.New("columnar (Parquet, Arrow) shards are not supported - build aisnode with 'columnar' build tag")
+
+type colRW struct {
+	ext string
+}
+
+// interface guard
+var _ RW = (*colRW)(nil)
+
+func NewColumnarRW(ext string, _ int64, _ bool) RW {
+	debug.Assert(IsColumnar(ext), ext)
+	return &colRW{ext: ext}
+}
+
+func (*colRW) IsCompressed() bool   { return true }
+func (*colRW) SupportsOffset() bool { return false }
+func (*colRW) MetadataSize() int64  { return 0 }
+
+func (*colRW) Extract(*core.LOM, cos.ReadReaderAt, RecordExtractor, bool) (int64, int, error) {
+	return 0, 0, errColumnar
+}
+
+func (*colRW) Create(*Shard, io.Writer, ContentLoader) (int64, error) { return 0, errColumnar }
+
+func columnKey(io.Reader, string, string) (any, error) { return nil, errColumnar }
@@ -0,0 +1,356 @@
+//go:build columnar
+
+// Package shard provides Extract(shard), Create(shard), and associated methods
+// across all suppported archival formats (see cmn/archive/mime.go)
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package shard_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type (
+	memLoader struct {
+		recm *shard.RecordManager
+	}
+	byKey struct {
+		records *shard.Records
+		keyType string
+	}
+)
+
+func (l *memLoader) Load(w io.Writer, _ *shard.Record, obj *shard.RecordObj) (int64, error) {
+	v, ok := l.recm.RecordContents().Load(l.recm.FullContentPath(obj))
+	Expect(ok).To(BeTrue())
+	return io.Copy(w, memsys.NewReader(v.(*memsys.SGL)))
+}
+
+func (s *byKey) Len() int      { return s.records.Len() }
+func (s *byKey) Swap(i, j int) { s.records.Swap(i, j) }
+func (s *byKey) Less(i, j int) bool {
+	less, err := s.records.Less(i, j, s.keyType)
+	Expect(err).NotTo(HaveOccurred())
+	return less
+}
+
+var _ = Describe("Columnar", func() {
+	const (
+		numRows      = 100
+		inRowGroup   = 30
+		outRowGroup  = 16
+		keyColumn    = "id"
+		valueColumn  = "value"
+		shardObjName = "shard-0"
+	)
+
+	var (
+		tmpDir string
+		schema = arrow.NewSchema([]arrow.Field{
+			{Name: keyColumn, Type: arrow.PrimitiveTypes.Int64},
+			{Name: valueColumn, Type: arrow.BinaryTypes.String},
+		}, nil)
+	)
+
+	BeforeEach(func() {
+		_ = mock.NewTarget(nil)
+		var err error
+		tmpDir, err = os.MkdirTemp("", "columnar")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	// ids in a (deterministically) shuffled order
+	newRecord := func() arrow.Record {
+		b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+		defer b.Release()
+		for i := 0; i < numRows; i++ {
+			id := int64(i*37) % numRows
+			b.Field(0).(*array.Int64Builder).Append(id)
+			b.Field(1).(*array.StringBuilder).Append("value-" + strconv.FormatInt(id, 10))
+		}
+		return b.NewRecord()
+	}
+
+	writeShard := func(ext string, rec arrow.Record) string {
+		fqn := filepath.Join(tmpDir, shardObjName+ext)
+		fh, err := os.Create(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		defer fh.Close()
+		switch ext {
+		case shard.ExtParquet:
+			props := parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(inRowGroup))
+			w, err := pqarrow.NewFileWriter(rec.Schema(), fh, props, pqarrow.DefaultWriterProps())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.Write(rec)).NotTo(HaveOccurred())
+			Expect(w.Close()).NotTo(HaveOccurred())
+		case shard.ExtArrow:
+			w, err := ipc.NewFileWriter(fh, ipc.WithSchema(rec.Schema()))
+			Expect(err).NotTo(HaveOccurred())
+			for off := int64(0); off < rec.NumRows(); off += inRowGroup {
+				slice := rec.NewSlice(off, min(off+inRowGroup, rec.NumRows()))
+				Expect(w.Write(slice)).NotTo(HaveOccurred())
+				slice.Release()
+			}
+			Expect(w.Close()).NotTo(HaveOccurred())
+		}
+		return fqn
+	}
+
+	// returns ids and the number of row groups (record batches)
+	readShard := func(ext, fqn string) (ids []int64, groups int) {
+		fh, err := os.Open(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		defer fh.Close()
+		appendIDs := func(rec arrow.Record) {
+			// (parquet adds field metadata)
+			Expect(rec.Schema().NumFields()).To(Equal(schema.NumFields()))
+			for i, f := range schema.Fields() {
+				Expect(rec.Schema().Field(i).Name).To(Equal(f.Name))
+				Expect(arrow.TypeEqual(rec.Schema().Field(i).Type, f.Type)).To(BeTrue())
+			}
+			for i := 0; i < int(rec.NumRows()); i++ {
+				id := rec.Column(0).(*array.Int64).Value(i)
+				Expect(rec.Column(1).(*array.String).Value(i)).To(Equal("value-" + strconv.FormatInt(id, 10)))
+				ids = append(ids, id)
+			}
+		}
+		switch ext {
+		case shard.ExtParquet:
+			pf, err := file.NewParquetReader(fh)
+			Expect(err).NotTo(HaveOccurred())
+			defer pf.Close()
+			fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+			Expect(err).NotTo(HaveOccurred())
+			groups = pf.NumRowGroups()
+			tbl, err := fr.ReadTable(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			defer tbl.Release()
+			tr := array.NewTableReader(tbl, tbl.NumRows())
+			defer tr.Release()
+			for tr.Next() {
+				appendIDs(tr.Record())
+			}
+		case shard.ExtArrow:
+			fr, err := ipc.NewFileReader(fh)
+			Expect(err).NotTo(HaveOccurred())
+			defer fr.Close()
+			groups = fr.NumRecords()
+			for i := 0; i < groups; i++ {
+				rec, err := fr.Record(i)
+				Expect(err).NotTo(HaveOccurred())
+				appendIDs(rec)
+			}
+		}
+		return ids, groups
+	}
+
+	extract := func(rw shard.RW, ke shard.KeyExtractor, fqn string) (*shard.RecordManager, int) {
+		var (
+			bck  = cmn.Bck{Name: "columnar", Provider: apc.AIS}
+			recm = shard.NewRecordManager(bck, rw, ke, func(string) error { return nil })
+		)
+		fh, err := os.Open(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		defer fh.Close()
+		finfo, err := fh.Stat()
+		Expect(err).NotTo(HaveOccurred())
+
+		lom := core.AllocLOM(filepath.Base(fqn))
+		defer core.FreeLOM(lom)
+		lom.SetSize(finfo.Size())
+
+		_, count, err := rw.Extract(lom, fh, recm, false /*to disk*/)
+		Expect(err).NotTo(HaveOccurred())
+		return recm, count
+	}
+
+	for _, ext := range shard.ColumnarExtensions {
+		ext := ext
+
+		It("should sort "+ext+" rows by column and write configured row groups", func() {
+			rec := newRecord()
+			defer rec.Release()
+			fqn := writeShard(ext, rec)
+
+			rw := shard.NewColumnarRW(ext, outRowGroup, false /*per row group*/)
+			ke, err := shard.NewColumnKeyExtractor(shard.ContentKeyInt, keyColumn)
+			Expect(err).NotTo(HaveOccurred())
+			recm, count := extract(rw, ke, fqn)
+			defer recm.Cleanup()
+			Expect(count).To(Equal(numRows))
+			Expect(recm.Records.Len()).To(Equal(numRows))
+
+			sort.Sort(&byKey{records: recm.Records, keyType: shard.ContentKeyInt})
+
+			out := filepath.Join(tmpDir, "out"+ext)
+			fh, err := os.Create(out)
+			Expect(err).NotTo(HaveOccurred())
+			s := &shard.Shard{Name: filepath.Base(out), Records: recm.Records}
+			written, err := rw.Create(s, fh, &memLoader{recm})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fh.Close()).NotTo(HaveOccurred())
+			finfo, err := os.Stat(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(written).To(Equal(finfo.Size()))
+
+			ids, groups := readShard(ext, out)
+			Expect(groups).To(Equal((numRows + outRowGroup - 1) / outRowGroup))
+			Expect(ids).To(HaveLen(numRows))
+			for i, id := range ids {
+				Expect(id).To(BeEquivalentTo(i))
+			}
+		})
+
+		It("should extract "+ext+" row groups as records", func() {
+			rec := newRecord()
+			defer rec.Release()
+			fqn := writeShard(ext, rec)
+
+			rw := shard.NewColumnarRW(ext, numRows, true /*per row group*/)
+			ke, err := shard.NewNameKeyExtractor()
+			Expect(err).NotTo(HaveOccurred())
+			recm, count := extract(rw, ke, fqn)
+			defer recm.Cleanup()
+			Expect(count).To(Equal((numRows + inRowGroup - 1) / inRowGroup))
+
+			out := filepath.Join(tmpDir, "out"+ext)
+			fh, err := os.Create(out)
+			Expect(err).NotTo(HaveOccurred())
+			s := &shard.Shard{Name: filepath.Base(out), Records: recm.Records}
+			_, err = rw.Create(s, fh, &memLoader{recm})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fh.Close()).NotTo(HaveOccurred())
+
+			// same rows, same order, all in a single row group
+			ids, groups := readShard(ext, out)
+			Expect(groups).To(Equal(1))
+			Expect(ids).To(HaveLen(numRows))
+			for i, id := range ids {
+				Expect(id).To(BeEquivalentTo(int64(i*37) % numRows))
+			}
+		})
+
+		It("should sort "+ext+" rows with nested columns", func() {
+			nested := arrow.NewSchema([]arrow.Field{
+				{Name: keyColumn, Type: arrow.PrimitiveTypes.Int64},
+				{Name: "point", Type: arrow.StructOf(
+					arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Int32},
+					arrow.Field{Name: "y", Type: arrow.BinaryTypes.String},
+				)},
+				{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64)},
+				{Name: valueColumn, Type: arrow.BinaryTypes.String},
+			}, nil)
+			b := array.NewRecordBuilder(memory.DefaultAllocator, nested)
+			for i := 0; i < numRows; i++ {
+				id := int64(i*37) % numRows
+				b.Field(0).(*array.Int64Builder).Append(id)
+				sb := b.Field(1).(*array.StructBuilder)
+				sb.Append(true)
+				sb.FieldBuilder(0).(*array.Int32Builder).Append(int32(id))
+				sb.FieldBuilder(1).(*array.StringBuilder).Append("y-" + strconv.FormatInt(id, 10))
+				lb := b.Field(2).(*array.ListBuilder)
+				lb.Append(true)
+				for j := int64(0); j < id%3; j++ {
+					lb.ValueBuilder().(*array.Int64Builder).Append(id + j)
+				}
+				b.Field(3).(*array.StringBuilder).Append("value-" + strconv.FormatInt(id, 10))
+			}
+			rec := b.NewRecord()
+			b.Release()
+			defer rec.Release()
+			fqn := writeShard(ext, rec)
+
+			rw := shard.NewColumnarRW(ext, outRowGroup, false /*per row group*/)
+			ke, err := shard.NewColumnKeyExtractor(shard.ContentKeyInt, keyColumn)
+			Expect(err).NotTo(HaveOccurred())
+			recm, count := extract(rw, ke, fqn)
+			defer recm.Cleanup()
+			Expect(count).To(Equal(numRows))
+			sort.Sort(&byKey{records: recm.Records, keyType: shard.ContentKeyInt})
+
+			out := filepath.Join(tmpDir, "out"+ext)
+			fh, err := os.Create(out)
+			Expect(err).NotTo(HaveOccurred())
+			s := &shard.Shard{Name: filepath.Base(out), Records: recm.Records}
+			_, err = rw.Create(s, fh, &memLoader{recm})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fh.Close()).NotTo(HaveOccurred())
+
+			// read back (all columns, all rows)
+			var recs []arrow.Record
+			fh, err = os.Open(out)
+			Expect(err).NotTo(HaveOccurred())
+			defer fh.Close()
+			switch ext {
+			case shard.ExtParquet:
+				pf, err := file.NewParquetReader(fh)
+				Expect(err).NotTo(HaveOccurred())
+				defer pf.Close()
+				fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+				Expect(err).NotTo(HaveOccurred())
+				tbl, err := fr.ReadTable(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				defer tbl.Release()
+				tr := array.NewTableReader(tbl, tbl.NumRows())
+				defer tr.Release()
+				for tr.Next() {
+					tr.Record().Retain()
+					recs = append(recs, tr.Record())
+				}
+			case shard.ExtArrow:
+				fr, err := ipc.NewFileReader(fh)
+				Expect(err).NotTo(HaveOccurred())
+				defer fr.Close()
+				for i := 0; i < fr.NumRecords(); i++ {
+					r, err := fr.Record(i)
+					Expect(err).NotTo(HaveOccurred())
+					r.Retain()
+					recs = append(recs, r)
+				}
+			}
+			var n int64
+			for _, r := range recs {
+				Expect(r.Schema().NumFields()).To(Equal(nested.NumFields()))
+				for i := 0; i < int(r.NumRows()); i++ {
+					id := r.Column(0).(*array.Int64).Value(i)
+					Expect(id).To(Equal(n))
+					point := r.Column(1).(*array.Struct)
+					Expect(point.Field(0).(*array.Int32).Value(i)).To(BeEquivalentTo(id))
+					Expect(point.Field(1).(*array.String).Value(i)).To(Equal("y-" + strconv.FormatInt(id, 10)))
+					beg, end := r.Column(2).(*array.List).ValueOffsets(i)
+					Expect(end - beg).To(Equal(id % 3))
+					Expect(r.Column(3).(*array.String).Value(i)).To(Equal("value-" + strconv.FormatInt(id, 10)))
+					n++
+				}
+				r.Release()
+			}
+			Expect(n).To(BeEquivalentTo(numRows))
+		})
+	}
+})
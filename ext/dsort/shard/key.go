@@ -45,6 +45,10 @@ type (
 		ty  string // one of contentKeyTypes: {"int", "string", ... } - see above
 		ext string // file with this extension provides sorting key (of the type `ty`)
 	}
+	columnKeyExtractor struct {
+		ty     string // ditto
+		column string // (columnar shards only) column that provides sorting key
+	}
 
 	ErrSortingKeyType struct {
 		ty string
@@ -127,6 +131,32 @@ func (ke *contentKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error)
 	}
 }
 
+////////////////////////
+// columnKeyExtractor //
+////////////////////////
+
+func NewColumnKeyExtractor(ty, column string) (KeyExtractor, error) {
+	if err := ValidateContentKeyTy(ty); err != nil {
+		return nil, err
+	}
+	return &columnKeyExtractor{ty: ty, column: column}, nil
+}
+
+func (*columnKeyExtractor) PrepareExtractor(name string, r cos.ReadSizer, _ string) (cos.ReadSizer, *SingleKeyExtractor, bool) {
+	buf := &bytes.Buffer{}
+	tee := cos.NewSizedReader(io.TeeReader(r, buf), r.Size())
+	return tee, &SingleKeyExtractor{name: name, buf: buf}, true
+}
+
+func (ke *columnKeyExtractor) ExtractKey(ske *SingleKeyExtractor) (any, error) {
+	if ske == nil {
+		return nil, nil
+	}
+	key, err := columnKey(ske.buf, ke.column, ke.ty)
+	ske.buf = nil
+	return key, err
+}
+
 func ValidateContentKeyTy(ty string) error {
 	switch ty {
 	case ContentKeyInt, ContentKeyFloat, ContentKeyString:
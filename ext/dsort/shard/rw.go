@@ -28,7 +28,10 @@ var (
 		archive.ExtTgz:    &tgzRW{archive.ExtTgz},
 		archive.ExtTarGz:  &tgzRW{archive.ExtTarGz},
 		archive.ExtTarLz4: &tlz4RW{archive.ExtTarLz4},
+		archive.ExtTarZst: &tzstRW{archive.ExtTarZst},
 		archive.ExtZip:    &zipRW{archive.ExtZip},
+		ExtParquet:        NewColumnarRW(ExtParquet, DefaultRowGroupSize, false),
+		ExtArrow:          NewColumnarRW(ExtArrow, DefaultRowGroupSize, false),
 	}
 )
 
@@ -71,6 +71,9 @@ type (
 		CommTypeX string       `json:"communication"` // enum commTypes
 		ArgTypeX  string       `json:"argument"`      // enum argTypes
 		Timeout   cos.Duration `json:"timeout"`
+		// per-target capacity (bytes) of the cache of inline transformation results (see cache.go);
+		// 0 (zero) - no caching
+		CacheSize int64 `json:"cache_size,omitempty"`
 	}
 	InitSpecMsg struct {
 		InitMsgBase
@@ -215,6 +218,10 @@ func (m *InitMsgBase) validate(detail string) error {
 		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
 	}
 
+	if m.CacheSize < 0 {
+		return cmn.NewErrETL(errCtx, "invalid cache size %d [%s]", m.CacheSize, detail)
+	}
+
 	// NOTE: default comm-type
 	if m.CommType() == "" {
 		cos.Infof("Warning: empty comm-type, defaulting to %q", Hpush)
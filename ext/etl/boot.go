@@ -39,6 +39,9 @@ type etlBootstrapper struct {
 	uri             string
 	originalPodName string
 	originalCommand []string
+	proc            *lproc // local (non-Kubernetes) ETL
+	specHash        string
+	cache           *rcache // inline transformation results (see cache.go)
 }
 
 func (b *etlBootstrapper) createPodSpec() (err error) {
@@ -226,6 +229,20 @@ func (b *etlBootstrapper) setupXaction(xid string) {
 	debug.Assertf(b.xctn.ID() == xid, "%s vs %s", b.xctn.ID(), xid)
 }
 
+// finally, add Communicator to the runtime registry
+func (b *etlBootstrapper) register(xid string) error {
+	b.setupXaction(xid)
+	if b.msg.CacheSize > 0 {
+		b.cache = newRcache(b.msg.IDX, b.specHash, b.msg.CacheSize)
+	}
+	comm := newCommunicator(newAborter(b.msg.IDX), b)
+	if err := reg.add(b.msg.IDX, comm); err != nil {
+		return err
+	}
+	core.T.Sowner().Listeners().Reg(comm)
+	return nil
+}
+
 func (b *etlBootstrapper) _updPodCommand() {
 	if b.msg.CommTypeX != HpushStdin {
 		return
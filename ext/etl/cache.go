@@ -0,0 +1,319 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"container/list"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Inline transformation results cache (InitMsgBase.CacheSize > 0):
+// - each target caches the results locally (fs.ETLCacheType), on the mountpath of the source object;
+// - cached result is keyed by the source object's (version, checksum) and the hash of the ETL spec,
+//   and gets recomputed (and overwritten) when either changes;
+// - results of ETL pipelines are cached by the pipeline's last stage, keyed by the combined hashes
+//   of all the stages' specs;
+// - least recently used results are evicted once the total size exceeds the configured capacity;
+// - the cache is in-memory indexed; it is purged when the ETL stops, while space cleanup removes
+//   results left behind by the target's previous runs.
+
+type (
+	rcache struct {
+		etlName  string
+		specHash string
+		capacity int64
+		size     int64
+		lru      *list.List               // front: most recently used
+		m        map[string]*list.Element // by (transformation, source object's uname)
+		mu       sync.Mutex
+		hits     int64
+		misses   int64
+	}
+	rcEntry struct {
+		id   string // (transformation, source object's uname)
+		fqn  string
+		key  string // (version, checksum, spec hash)
+		size int64
+	}
+
+	// tees the transformed response into cache file; unlike io.MultiWriter, failing to cache
+	// does not fail the request
+	cacheWriter struct {
+		http.ResponseWriter
+		wfh    *os.File
+		err    error
+		size   int64
+		status int
+	}
+
+	// implemented by all communicators (via baseComm)
+	cachingComm interface {
+		cache() *rcache
+		spec() string
+	}
+)
+
+// interface guard
+var (
+	_ cachingComm         = (*baseComm)(nil)
+	_ http.ResponseWriter = (*cacheWriter)(nil)
+)
+
+////////////
+// rcache //
+////////////
+
+func newRcache(etlName, specHash string, capacity int64) *rcache {
+	return &rcache{
+		etlName:  etlName,
+		specHash: specHash,
+		capacity: capacity,
+		lru:      list.New(),
+		m:        make(map[string]*list.Element, 64),
+	}
+}
+
+// hash of the ETL spec (or code) and its environment, if any
+func specHash(msg InitMsg, env map[string]string) string {
+	h := xxhash.New64()
+	h.Write(cos.MustMarshal(msg))
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.WriteString(k)
+		h.WriteString(env[k])
+	}
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+// InlineTransform serves the transformed object from the ETL's results cache, if enabled and
+// present; otherwise, transforms the object and caches the result (compare with pipe.InlineTransform)
+func InlineTransform(comm Communicator, w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) error {
+	cc, ok := comm.(cachingComm)
+	if !ok || cc.cache() == nil {
+		return comm.InlineTransform(w, r, bck, objName)
+	}
+	var (
+		rc  = cc.cache()
+		lom = core.AllocLOM(objName)
+	)
+	err := rc.inline(w, bck, lom, rc.etlName, rc.specHash, func(w http.ResponseWriter) error {
+		return comm.InlineTransform(w, r, bck, objName)
+	})
+	core.FreeLOM(lom)
+	return err
+}
+
+// serve cached result, if any; otherwise, call `transform` and cache what it writes
+// (`tag` identifies the transformation: ETL or pipeline)
+func (rc *rcache) inline(w http.ResponseWriter, bck *meta.Bck, lom *core.LOM, tag, spec string, transform func(http.ResponseWriter) error) error {
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	id := tag + "/" + lom.Uname()
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	lom.Unlock(false)
+	if err != nil {
+		// not present (e.g., remote object that is yet to be cold-GET) or deleted: bypass
+		rc.del(id)
+		return transform(w)
+	}
+	key := rc.key(lom, spec)
+	if key == "" || lom.IsEncrypted() { // (never store plaintext of encrypted objects)
+		return transform(w)
+	}
+	if served, err := rc.serve(w, id, key); served || err != nil {
+		return err
+	}
+
+	// miss: transform, and write the result to both the client and the cache
+	rc.mu.Lock()
+	rc.misses++
+	rc.mu.Unlock()
+	workFQN := fs.CSM.Gen(lom, fs.WorkfileType, "etl-cache")
+	wfh, err := cos.CreateFile(workFQN)
+	if err != nil {
+		nlog.Warningln(rc.etlName, "cache:", err)
+		return transform(w)
+	}
+	cw := &cacheWriter{ResponseWriter: w, wfh: wfh}
+	err = transform(cw)
+	errClose := wfh.Close()
+
+	// cache only transformed content (not, e.g., hpull:// redirects and error responses)
+	if err == nil && cw.err == nil && errClose == nil && cw.status == http.StatusOK && rc.unchanged(lom, spec, key) {
+		rc.add(id, fs.CSM.Gen(lom, fs.ETLCacheType, tag), key, workFQN, cw.size)
+		return nil
+	}
+	if cw.err != nil {
+		nlog.Warningln(rc.etlName, "cache:", cw.err)
+	}
+	cos.RemoveFile(workFQN)
+	return err
+}
+
+// the source object may have changed while being transformed
+// (in which case the result must not be cached under the previously loaded key)
+func (rc *rcache) unchanged(lom *core.LOM, spec, key string) bool {
+	src := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(src)
+	if err := src.InitBck(lom.Bucket()); err != nil {
+		return false
+	}
+	src.Lock(false)
+	err := src.Load(false /*cache it*/, true /*locked*/)
+	src.Unlock(false)
+	return err == nil && rc.key(src, spec) == key
+}
+
+func (*rcache) key(lom *core.LOM, spec string) string {
+	var (
+		ver   = lom.Version()
+		cksum = lom.Checksum()
+	)
+	if ver == "" && cksum.IsEmpty() {
+		return "" // cannot tell whether the object has changed
+	}
+	var ck string
+	if !cksum.IsEmpty() {
+		ck = cksum.String()
+	}
+	return ver + "|" + ck + "|" + spec
+}
+
+// serve cached result, if any
+func (rc *rcache) serve(w http.ResponseWriter, id, key string) (bool, error) {
+	rc.mu.Lock()
+	el, ok := rc.m[id]
+	if !ok {
+		rc.mu.Unlock()
+		return false, nil
+	}
+	e := el.Value.(*rcEntry)
+	if e.key != key {
+		rc._del(el) // stale
+		rc.mu.Unlock()
+		return false, nil
+	}
+	fh, err := os.Open(e.fqn) // (open file remains readable if evicted in the meantime)
+	if err != nil {
+		rc._del(el)
+		rc.mu.Unlock()
+		return false, nil
+	}
+	rc.lru.MoveToFront(el)
+	rc.hits++
+	size := e.size
+	rc.mu.Unlock()
+
+	w.Header().Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	buf, slab := core.T.PageMM().AllocSize(size)
+	_, err = io.CopyBuffer(w, fh, buf)
+	slab.Free(buf)
+	cos.Close(fh)
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(rc.etlName, "cache hit:", id, err)
+	}
+	return true, err
+}
+
+func (rc *rcache) add(id, fqn, key, workFQN string, size int64) {
+	if size > rc.capacity {
+		cos.RemoveFile(workFQN)
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if err := cos.Rename(workFQN, fqn); err != nil {
+		nlog.Warningln(rc.etlName, "cache:", err)
+		cos.RemoveFile(workFQN)
+		return
+	}
+	if el, ok := rc.m[id]; ok {
+		// (concurrent miss, or stale) - same fqn, already overwritten
+		e := el.Value.(*rcEntry)
+		rc.size -= e.size
+		rc.lru.Remove(el)
+		delete(rc.m, id)
+	}
+	rc.m[id] = rc.lru.PushFront(&rcEntry{id: id, fqn: fqn, key: key, size: size})
+	rc.size += size
+	for rc.size > rc.capacity {
+		rc._del(rc.lru.Back())
+	}
+}
+
+func (rc *rcache) del(id string) {
+	rc.mu.Lock()
+	if el, ok := rc.m[id]; ok {
+		rc._del(el)
+	}
+	rc.mu.Unlock()
+}
+
+// (under lock)
+func (rc *rcache) _del(el *list.Element) {
+	e := el.Value.(*rcEntry)
+	if err := cos.RemoveFile(e.fqn); err != nil {
+		nlog.Warningln(rc.etlName, "cache:", err)
+	}
+	rc.size -= e.size
+	rc.lru.Remove(el)
+	delete(rc.m, e.id)
+}
+
+// remove all cached results (when the ETL stops)
+func (rc *rcache) purge() {
+	rc.mu.Lock()
+	for el := rc.lru.Front(); el != nil; el = rc.lru.Front() {
+		rc._del(el)
+	}
+	hits, misses := rc.hits, rc.misses
+	rc.mu.Unlock()
+	nlog.Infoln(rc.etlName, "cache purged; hits:", hits, "misses:", misses)
+}
+
+/////////////////
+// cacheWriter //
+/////////////////
+
+func (cw *cacheWriter) WriteHeader(status int) {
+	if cw.status == 0 {
+		cw.status = status
+	}
+	cw.ResponseWriter.WriteHeader(status)
+}
+
+func (cw *cacheWriter) Write(b []byte) (int, error) {
+	if cw.status == 0 {
+		cw.status = http.StatusOK
+	}
+	n, err := cw.ResponseWriter.Write(b)
+	if cw.err == nil && cw.status == http.StatusOK {
+		_, cw.err = cw.wfh.Write(b[:n])
+		cw.size += int64(n)
+	}
+	return n, err
+}
+
+// (e.g., reverse proxy flushing via http.ResponseController)
+func (cw *cacheWriter) Unwrap() http.ResponseWriter { return cw.ResponseWriter }
@@ -5,21 +5,28 @@
 package etl
 
 import (
+	"archive/tar"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/ext/etl/runtime"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/tools/cryptorand"
 	. "github.com/onsi/ginkgo"
@@ -38,6 +45,7 @@ func TestETLTransform(t *testing.T) {
 var _ = Describe("CommunicatorTest", func() {
 	var (
 		tmpDir            string
+		objFQN            string
 		comm              Communicator
 		transformerServer *httptest.Server
 		targetServer      *httptest.Server
@@ -79,6 +87,7 @@ var _ = Describe("CommunicatorTest", func() {
 		lom := &core.LOM{ObjName: objName}
 		err = lom.InitBck(clusterBck.Bucket())
 		Expect(err).NotTo(HaveOccurred())
+		objFQN = lom.FQN
 		err = createRandomFile(lom.FQN, dataSize)
 		Expect(err).NotTo(HaveOccurred())
 		lom.SetAtimeUnix(time.Now().UnixNano())
@@ -141,6 +150,307 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(b).To(Equal(transformData))
 		})
 	}
+
+	Describe("pipeline", func() {
+		var reverseServer *httptest.Server
+
+		BeforeEach(func() {
+			reverseServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+					b[i], b[j] = b[j], b[i]
+				}
+				w.Write(b)
+			}))
+		})
+		AfterEach(func() {
+			reverseServer.Close()
+		})
+
+		newComm := func(name, commType, uri string) Communicator {
+			pod := &corev1.Pod{}
+			pod.SetName(name)
+			boot := &etlBootstrapper{
+				msg:             InitSpecMsg{InitMsgBase: InitMsgBase{IDX: name, CommTypeX: commType}},
+				pod:             pod,
+				uri:             uri,
+				xctn:            mock.NewXact(apc.ActETLInline),
+				originalPodName: name,
+			}
+			return newCommunicator(nil, boot)
+		}
+
+		It("should pipe the output of one ETL into the next", func() {
+			pipe := Pipeline{
+				newComm("first", Hpush, transformerServer.URL),
+				newComm("second", Hpush, reverseServer.URL),
+			}
+			Expect(pipe.String()).To(Equal("first,second"))
+			r, err := pipe.Transform(clusterBck, objName, 0)
+			Expect(err).NotTo(HaveOccurred())
+			b, err := io.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Close()).NotTo(HaveOccurred())
+
+			expected := make([]byte, len(transformData))
+			for i := range transformData {
+				expected[len(expected)-1-i] = transformData[i]
+			}
+			Expect(b).To(Equal(expected))
+
+			// per-stage stats
+			for _, comm := range pipe {
+				Expect(comm.InBytes()).To(BeEquivalentTo(len(transformData)))
+			}
+			Expect(pipe[0].OutBytes()).To(BeEquivalentTo(dataSize))
+			Expect(pipe[1].OutBytes()).To(BeEquivalentTo(len(transformData)))
+		})
+
+		It("should report the failing stage", func() {
+			failServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "failed to transform", http.StatusInternalServerError)
+			}))
+			defer failServer.Close()
+
+			pipe := Pipeline{
+				newComm("first", Hpush, reverseServer.URL),
+				newComm("second", Hpush, failServer.URL),
+			}
+			_, err := pipe.Transform(clusterBck, objName, 0)
+			Expect(err).To(HaveOccurred())
+			errETL, ok := err.(*cmn.ErrETL)
+			Expect(ok).To(BeTrue())
+			Expect(errETL.ETLName).To(Equal("second"))
+			Expect(err.Error()).To(ContainSubstring("stage #2"))
+			Expect(err.Error()).To(ContainSubstring("failed to transform"))
+		})
+
+		It("should transform archived files", func() {
+			var (
+				mu        sync.Mutex
+				archpaths []string
+				members   = []string{"a/1.txt", "a/2.txt", "b/3.txt"}
+				shardName = "shard.tar"
+			)
+			memberServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				archpaths = append(archpaths, r.URL.Query().Get(apc.QparamArchpath))
+				mu.Unlock()
+				b, _ := io.ReadAll(r.Body)
+				w.Write(append([]byte("transformed:"), b...))
+			}))
+			defer memberServer.Close()
+
+			// source shard, with a directory that must be skipped
+			lom := &core.LOM{ObjName: shardName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			fh, err := cos.CreateFile(lom.FQN)
+			Expect(err).NotTo(HaveOccurred())
+			tw := tar.NewWriter(fh)
+			Expect(tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "a/", Mode: 0o755})).NotTo(HaveOccurred())
+			for _, name := range members {
+				Expect(tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: int64(len(name)), Mode: 0o644})).NotTo(HaveOccurred())
+				_, err := tw.Write([]byte(name))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(tw.Close()).NotTo(HaveOccurred())
+			finfo, err := fh.Stat()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fh.Close()).NotTo(HaveOccurred())
+			lom.SetSize(finfo.Size())
+			lom.SetAtimeUnix(time.Now().UnixNano())
+			Expect(lom.Persist()).NotTo(HaveOccurred())
+
+			// tar => tgz
+			comm := newComm("members", Hpush, memberServer.URL)
+			dp := &OfflineDP{
+				comm:     comm,
+				pipe:     Pipeline{comm},
+				tcbmsg:   &apc.TCBMsg{Transform: apc.Transform{Name: "members", ArchMembers: true}},
+				archMime: archive.ExtTgz,
+			}
+			r, oah, err := dp.Reader(lom, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oah.SizeBytes()).To(BeEquivalentTo(cos.ContentLengthUnknown))
+
+			ar, err := archive.NewReader(archive.ExtTgz, r)
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			_, err = ar.Range("", func(name string, reader cos.ReadCloseSizer, _ any) (bool, error) {
+				b, err := io.ReadAll(reader)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(b)).To(Equal("transformed:" + name))
+				names = append(names, name)
+				return false, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Close()).NotTo(HaveOccurred())
+
+			Expect(names).To(Equal(members))
+			Expect(archpaths).To(Equal(members))
+			Expect(comm.InBytes()).To(BeEquivalentTo(len("transformed:a/1.txt") * len(members)))
+		})
+
+		It("should only pipe into hpush:// and io:// ETLs", func() {
+			Expect(pipeable(newComm("push", Hpush, transformerServer.URL))).NotTo(HaveOccurred())
+			Expect(pipeable(newComm("pull", Hpull, transformerServer.URL))).To(HaveOccurred())
+			Expect(pipeable(newComm("rev", Hrev, transformerServer.URL))).To(HaveOccurred())
+		})
+	})
+
+	Describe("cache", func() {
+		var (
+			numReqs     atomic.Int32
+			countServer *httptest.Server
+		)
+		BeforeEach(func() {
+			fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+			fs.CSM.Reg(fs.ETLCacheType, &fs.ETLCacheContentResolver{}, true)
+			numReqs.Store(0)
+			countServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				numReqs.Add(1)
+				io.Copy(io.Discard, r.Body)
+				w.Write(transformData)
+			}))
+		})
+		AfterEach(func() {
+			countServer.Close()
+		})
+
+		newCachingComm := func(capacity int64) Communicator {
+			boot := &etlBootstrapper{
+				msg:             InitSpecMsg{InitMsgBase: InitMsgBase{IDX: "cached", CommTypeX: Hpush}},
+				pod:             &corev1.Pod{},
+				uri:             countServer.URL,
+				xctn:            mock.NewXact(apc.ActETLInline),
+				originalPodName: "cached",
+				cache:           newRcache("cached", "spec-hash", capacity),
+			}
+			return newCommunicator(nil, boot)
+		}
+		setCksum := func(value string) {
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, value))
+			Expect(lom.Persist()).NotTo(HaveOccurred())
+		}
+		get := func(comm Communicator) {
+			w := httptest.NewRecorder()
+			Expect(InlineTransform(comm, w, nil, clusterBck, objName)).NotTo(HaveOccurred())
+			Expect(w.Body.Bytes()).To(Equal(transformData))
+		}
+
+		It("should serve cached results until the source object changes", func() {
+			setCksum("1234567890abcdef")
+			comm := newCachingComm(2 * dataSize)
+			get(comm)
+			get(comm)
+			get(comm)
+			Expect(numReqs.Load()).To(BeEquivalentTo(1))
+			rc := comm.(cachingComm).cache()
+			Expect(rc.hits).To(BeEquivalentTo(2))
+			Expect(rc.size).To(BeEquivalentTo(len(transformData)))
+
+			// new content
+			setCksum("fedcba0987654321")
+			get(comm)
+			get(comm)
+			Expect(numReqs.Load()).To(BeEquivalentTo(2))
+			Expect(rc.size).To(BeEquivalentTo(len(transformData)))
+
+			// stopping ETL purges the cache
+			el := rc.lru.Front()
+			Expect(el).NotTo(BeNil())
+			fqn := el.Value.(*rcEntry).fqn
+			Expect(cos.Stat(fqn)).NotTo(HaveOccurred())
+			rc.purge()
+			Expect(cos.Stat(fqn)).To(HaveOccurred())
+			Expect(rc.size).To(BeZero())
+		})
+
+		It("should cache results of ETL pipelines in the last stage's cache", func() {
+			setCksum("1234567890abcdef")
+			pipe := Pipeline{newCachingComm(2 * dataSize), newCachingComm(2 * dataSize)}
+			for i := 0; i < 3; i++ {
+				w := httptest.NewRecorder()
+				Expect(pipe.InlineTransform(w, clusterBck, objName)).NotTo(HaveOccurred())
+				Expect(w.Body.Bytes()).To(Equal(transformData))
+			}
+			Expect(numReqs.Load()).To(BeEquivalentTo(2)) // (one per stage)
+			Expect(pipe[1].(cachingComm).cache().hits).To(BeEquivalentTo(2))
+			Expect(pipe[0].(cachingComm).cache().size).To(BeZero())
+
+			// standalone, the last stage does not serve the pipeline's results
+			get(pipe[1])
+			Expect(numReqs.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("should not cache when there's no room or no way to tell whether the object has changed", func() {
+			comm := newCachingComm(2 * dataSize)
+			get(comm) // (no checksum, no version)
+			get(comm)
+			Expect(numReqs.Load()).To(BeEquivalentTo(2))
+
+			setCksum("1234567890abcdef")
+			comm = newCachingComm(dataSize / 2)
+			get(comm)
+			get(comm)
+			Expect(numReqs.Load()).To(BeEquivalentTo(4))
+			Expect(comm.(cachingComm).cache().size).To(BeZero())
+		})
+	})
+
+	localCode := map[string]string{
+		Hpush:      "def transform(data):\n    return data[::-1]\n",
+		HpushStdin: "import sys\nsys.stdout.buffer.write(sys.stdin.buffer.read()[::-1])\n",
+	}
+	for _, commType := range []string{Hpush, HpushStdin} {
+		It("should perform local transformation "+commType, func() {
+			r, _ := runtime.Get(runtime.Py311)
+			if _, err := exec.LookPath(r.Interpreter()); err != nil {
+				Skip(err.Error())
+			}
+			msg := &InitCodeMsg{
+				InitMsgBase: InitMsgBase{IDX: "local-etl", CommTypeX: commType, Timeout: cos.Duration(DefaultTimeout)},
+				Code:        []byte(localCode[commType]),
+				Runtime:     runtime.Py311,
+			}
+			msg.Funcs.Transform = "transform"
+			lp, err := newLproc(msg, &cmn.Config{LocalConfig: cmn.LocalConfig{ConfigDir: tmpDir}})
+			Expect(err).NotTo(HaveOccurred())
+			defer lp.stop()
+
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: msg.InitMsgBase},
+				proc: lp,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			if commType == Hpush {
+				port, err := lp.serve(msg.Timeout.D())
+				Expect(err).NotTo(HaveOccurred())
+				boot.uri = "http://127.0.0.1:" + strconv.Itoa(port)
+			}
+			comm = newCommunicator(nil, boot)
+			Expect(comm.PodName()).To(BeEmpty())
+
+			resp, err := http.Get(proxyServer.URL)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			b, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			orig, err := os.ReadFile(objFQN)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(b)).To(Equal(len(orig)))
+			for i, j := 0, len(orig)-1; i < j; i, j = i+1, j-1 {
+				orig[i], orig[j] = orig[j], orig[i]
+			}
+			Expect(b).To(Equal(orig))
+			Expect(lp.health()).To(Equal("Running"))
+		})
+	}
 })
 
 // Creates a file with random content.
@@ -24,6 +24,8 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 )
 
+const errBodyMax = 1024 // when ETL container responds with error status: max error message length
+
 type (
 	CommStats interface {
 		ObjCount() int64
@@ -53,6 +55,11 @@ type (
 		// with GET requests from users (such as training models and apps)
 		// to perform on-the-fly transformation.
 		OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error)
+
+		// PipeTransform transforms the output of the previous stage of ETL pipeline (see pipeline.go)
+		// or else an archived file (non-empty archpath; see members.go);
+		// takes ownership of the reader; supported by hpush:// and io:// communicators
+		PipeTransform(r cos.ReadCloseSizer, bck *meta.Bck, objName, archpath string, timeout time.Duration) (cos.ReadCloseSizer, error)
 		Stop()
 
 		CommStats
@@ -74,6 +81,8 @@ type (
 		rp *httputil.ReverseProxy
 	}
 
+	doFunc func(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, int, error)
+
 	// TODO: Generalize and move to `cos` package
 	cbWriter struct {
 		w       io.Writer
@@ -97,6 +106,11 @@ var (
 func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicator {
 	switch boot.msg.CommTypeX {
 	case Hpush, HpushStdin:
+		if boot.proc != nil && boot.msg.CommTypeX == HpushStdin { // local io://
+			sc := &stdioComm{}
+			sc.listener, sc.boot = listener, boot
+			return sc
+		}
 		pc := &pushComm{}
 		pc.listener, pc.boot = listener, boot
 		if boot.msg.CommTypeX == HpushStdin { // io://
@@ -133,9 +147,20 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 	return nil
 }
 
-func (c *baseComm) Name() string    { return c.boot.originalPodName }
-func (c *baseComm) PodName() string { return c.boot.pod.Name }
-func (c *baseComm) SvcName() string { return c.boot.pod.Name /*same as pod name*/ }
+func (c *baseComm) Name() string { return c.boot.originalPodName }
+
+// (empty when local)
+func (c *baseComm) PodName() string {
+	if c.boot.pod == nil {
+		return ""
+	}
+	return c.boot.pod.Name
+}
+func (c *baseComm) SvcName() string { return c.PodName() /*same as pod name*/ }
+
+func (c *baseComm) proc() *lproc   { return c.boot.proc }
+func (c *baseComm) cache() *rcache { return c.boot.cache }
+func (c *baseComm) spec() string   { return c.boot.specHash }
 
 func (c *baseComm) ListenSmapChanged() { c.listener.ListenSmapChanged() }
 
@@ -148,7 +173,15 @@ func (c *baseComm) ObjCount() int64 { return c.boot.xctn.Objs() }
 func (c *baseComm) InBytes() int64  { return c.boot.xctn.InBytes() }
 func (c *baseComm) OutBytes() int64 { return c.boot.xctn.OutBytes() }
 
-func (c *baseComm) Stop() { c.boot.xctn.Finish() }
+func (c *baseComm) Stop() {
+	if c.boot.proc != nil {
+		c.boot.proc.stop()
+	}
+	if c.boot.cache != nil {
+		c.boot.cache.purge()
+	}
+	c.boot.xctn.Finish()
+}
 
 func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	if err := c.boot.xctn.AbortErr(); err != nil {
@@ -195,14 +228,15 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 // pushComm: implements (Hpush | HpushStdin)
 //////////////
 
-func (pc *pushComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+// (common for hpush:// and local io://)
+func doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duration, do doFunc) (r cos.ReadCloseSizer, err error) {
 	var errCode int
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		return nil, err
 	}
 
 	lom.Lock(false)
-	r, errCode, err = pc.do(lom, timeout)
+	r, errCode, err = do(lom, timeout)
 	lom.Unlock(false)
 
 	if err != nil && cos.IsNotExist(err, errCode) && bck.IsRemote() {
@@ -211,7 +245,7 @@ func (pc *pushComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duratio
 			return nil, err
 		}
 		lom.Lock(false)
-		r, _, err = pc.do(lom, timeout)
+		r, _, err = do(lom, timeout)
 		lom.Unlock(false)
 	}
 	return
@@ -219,11 +253,8 @@ func (pc *pushComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duratio
 
 func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, errCode int, err error) {
 	var (
-		body   io.ReadCloser
-		cancel func()
-		req    *http.Request
-		resp   *http.Response
-		u      string
+		body io.ReadCloser
+		u    string
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
 		return nil, 0, err
@@ -241,18 +272,30 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		debug.Assertf(lom.Bck().Ns.IsGlobal(), lom.Bck().Cname("")+" - bucket with namespace")
 		u = pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName
 
-		fh, err := cos.NewFileHandle(lom.FQN)
+		fh, err := lom.NewHandle()
 		if err != nil {
 			return nil, 0, err
 		}
 		body = fh
 	case ArgTypeFQN:
+		if err := argFQN(lom); err != nil {
+			return nil, http.StatusNotImplemented, err
+		}
 		body = http.NoBody
 		u = cos.JoinPath(pc.boot.uri, url.PathEscape(lom.FQN)) // compare w/ rc.redirectURL()
 	default:
 		debug.Assert(false, "unexpected msg type:", pc.boot.msg.ArgTypeX) // is validated at construction time
 	}
+	return pc.put(u, body, size, timeout)
+}
 
+// (common for transforming local objects and ETL pipeline's stages)
+func (pc *pushComm) put(u string, body io.ReadCloser, size int64, timeout time.Duration) (_ cos.ReadCloseSizer, errCode int, err error) {
+	var (
+		cancel func()
+		req    *http.Request
+		resp   *http.Response
+	)
 	if timeout != 0 {
 		var ctx context.Context
 		ctx, cancel = context.WithTimeout(context.Background(), timeout)
@@ -278,6 +321,11 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	// Do it
 	//
 	resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	if err == nil && resp.StatusCode >= http.StatusBadRequest {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, errBodyMax))
+		resp.Body.Close()
+		err = fmt.Errorf("%s %s: %s (%q)", http.MethodPut, u, resp.Status, b)
+	}
 
 finish:
 	if err != nil {
@@ -298,7 +346,7 @@ finish:
 				cancel()
 			}
 			pc.boot.xctn.InObjsAdd(1, 0)
-			pc.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
+			pc.boot.xctn.OutObjsAdd(1, max(size, 0)) // see also: `coi.objsAdd`
 		},
 	}
 	return cos.NewReaderWithArgs(args), 0, nil
@@ -306,7 +354,7 @@ finish:
 
 func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck *meta.Bck, objName string) error {
 	lom := core.AllocLOM(objName)
-	r, err := pc.doRequest(bck, lom, 0 /*timeout*/)
+	r, err := doRequest(bck, lom, 0 /*timeout*/, pc.do)
 	core.FreeLOM(lom)
 	if err != nil {
 		return err
@@ -314,13 +362,17 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpush, lom.Cname(), err)
 	}
+	return copyTransformed(w, r)
+}
 
+// (common for hpush:// and local io://)
+func copyTransformed(w io.Writer, r cos.ReadCloseSizer) error {
 	size := r.Size()
 	if size < 0 {
 		size = memsys.DefaultBufSize // TODO: track an average
 	}
 	buf, slab := core.T.PageMM().AllocSize(size)
-	_, err = io.CopyBuffer(w, r, buf)
+	_, err := io.CopyBuffer(w, r, buf)
 
 	slab.Free(buf)
 	r.Close()
@@ -329,7 +381,7 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck
 
 func (pc *pushComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	lom := core.AllocLOM(objName)
-	r, err = pc.doRequest(bck, lom, timeout)
+	r, err = doRequest(bck, lom, timeout, pc.do)
 	if err == nil && cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpush, lom.Cname(), err)
 	}
@@ -348,6 +400,9 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 
 	lom := core.AllocLOM(objName)
 	size, err := lomLoad(lom, bck)
+	if err == nil && rc.boot.msg.ArgTypeX == ArgTypeFQN {
+		err = argFQN(lom)
+	}
 	if err != nil {
 		core.FreeLOM(lom)
 		return err
@@ -379,6 +434,9 @@ func (rc *redirectComm) redirectURL(lom *core.LOM) string {
 func (rc *redirectComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error) {
 	lom := core.AllocLOM(objName)
 	size, errV := lomLoad(lom, bck)
+	if errV == nil && rc.boot.msg.ArgTypeX == ArgTypeFQN {
+		errV = argFQN(lom)
+	}
 	if errV != nil {
 		core.FreeLOM(lom)
 		return nil, errV
@@ -471,6 +529,14 @@ func transformerPath(bck *meta.Bck, objName string) string {
 	return "/" + url.PathEscape(bck.MakeUname(objName))
 }
 
+// ArgTypeFQN: the container reads the file directly - not supported for encrypted objects
+func argFQN(lom *core.LOM) error {
+	if lom.IsEncrypted() {
+		return cmn.NewErrUnsupp("pass FQN of an encrypted object to ETL", lom.Cname())
+	}
+	return nil
+}
+
 func lomLoad(lom *core.LOM, bck *meta.Bck) (size int64, err error) {
 	if err = lom.InitBck(bck.Bucket()); err != nil {
 		return
@@ -9,6 +9,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -20,7 +21,9 @@ import (
 type (
 	OfflineDP struct {
 		comm           Communicator
+		pipe           Pipeline // when transforming via ETL pipeline (msg.Transform.Chain) or archived files
 		tcbmsg         *apc.TCBMsg
+		archMime       string // destination shard format (msg.Transform.ArchMime)
 		config         *cmn.Config
 		requestTimeout time.Duration
 	}
@@ -35,6 +38,22 @@ func NewOfflineDP(msg *apc.TCBMsg, config *cmn.Config) (*OfflineDP, error) {
 		return nil, err
 	}
 	pr := &OfflineDP{comm: comm, tcbmsg: msg, config: config}
+	if len(msg.Transform.Chain) > 0 || msg.Transform.ArchMembers {
+		if pr.pipe, err = GetPipeline(msg.Transform.Names()); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Transform.ArchMembers {
+		// archived files are transformed by all stages, the first one included (see members.go)
+		if err := pipeable(comm); err != nil {
+			return nil, err
+		}
+		if msg.Transform.ArchMime != "" {
+			if pr.archMime, err = archive.Mime(msg.Transform.ArchMime, ""); err != nil {
+				return nil, err
+			}
+		}
+	}
 	pr.requestTimeout = time.Duration(msg.Transform.Timeout)
 	return pr, nil
 }
@@ -48,10 +67,29 @@ func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCl
 		action = "read [" + dp.tcbmsg.Transform.Name + "]-transformed " + lom.Cname()
 	)
 	debug.Assert(!latestVer && !sync, "NIY") // TODO -- FIXME
+	if dp.tcbmsg.Transform.ArchMembers {
+		// no retries: failing mid-stream
+		action = "transform archived files of " + lom.Cname() + " via [" + dp.pipe.String() + "]"
+		r, err = dp.members(lom)
+		if cmn.Rom.FastV(5, cos.SmoduleETL) {
+			nlog.Infoln(action, err)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return dp.transformed(lom, r)
+	}
 	call := func() (int, error) {
 		r, err = dp.comm.OfflineTransform(lom.Bck(), lom.ObjName, dp.requestTimeout)
 		return 0, err
 	}
+	if dp.pipe != nil {
+		action = "read [" + dp.pipe.String() + "]-transformed " + lom.Cname()
+		call = func() (int, error) {
+			r, err = dp.pipe.Transform(lom.Bck(), lom.ObjName, dp.requestTimeout)
+			return 0, err
+		}
+	}
 	// TODO: Check if ETL pod is healthy and wait some more if not (yet).
 	err = cmn.NetworkCallWithRetry(&cmn.RetryArgs{
 		Call:      call,
@@ -68,6 +106,10 @@ func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCl
 	if err != nil {
 		return nil, nil, err
 	}
+	return dp.transformed(lom, r)
+}
+
+func (*OfflineDP) transformed(lom *core.LOM, r cos.ReadCloseSizer) (cos.ReadOpenCloser, cos.OAH, error) {
 	lom.SetAtimeUnix(time.Now().UnixNano())
 	oah := &cmn.ObjAttrs{
 		Size:  r.Size(),
@@ -0,0 +1,437 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/etl/runtime"
+	"github.com/NVIDIA/aistore/sys"
+)
+
+// Local ETL: when aistore is _not_ deployed in Kubernetes and feat.AllowLocalETL is set,
+// `InitCode` runs user's transforming function in target-local processes (instead of K8s pods):
+// - hpush://, hpull://, hrev:// - a single long-lived server (runtime.PyServer) that listens
+//   on a loopback port and speaks the same protocols as the corresponding ETL containers;
+// - io:// - the code gets executed once per object, with the object's content on its stdin
+//   and the transformed content read from its stdout (see stdioComm below);
+// - the runtime's interpreter (e.g. "python3.11" for python3.11v2) must be in the target's PATH;
+//   dependencies, if any, get pip-installed into the ETL's working directory;
+// - InitSpec (custom containers) still requires Kubernetes.
+
+const (
+	localDir    = "etl" // under config.ConfigDir
+	localCode   = "code.py"
+	localServer = "server.py"
+	localDeps   = "deps"
+	localReqs   = "requirements.txt"
+	localLog    = ".log"
+	localPort   = "port"
+	localStopTo = 5 * time.Second
+	localPoll   = 200 * time.Millisecond
+
+	localEnvArchpath = "AIS_ARCHPATH" // io:// transforming archived file (see members.go)
+)
+
+type (
+	lproc struct {
+		ctx     context.Context
+		cancel  context.CancelFunc
+		cmd     *exec.Cmd     // server process (nil for io://)
+		done    chan struct{} // closed when the server exits
+		exitErr error
+		logf    *os.File
+		name    string
+		dir     string
+		python  string
+		env     []string
+		// cpu utilization (see metrics)
+		cpu struct {
+			total uint64 // ms
+			ts    int64  // mono
+			mu    sync.Mutex
+		}
+	}
+
+	// local io://
+	stdioComm struct {
+		baseComm
+	}
+	procReader struct {
+		stdout io.ReadCloser
+		stdin  io.Closer
+		cmd    *exec.Cmd
+		cancel context.CancelFunc
+		name   string
+		err    error
+		waited bool
+	}
+
+	// implemented by all communicators (via baseComm)
+	localComm interface {
+		proc() *lproc
+	}
+)
+
+// interface guard
+var (
+	_ Communicator = (*stdioComm)(nil)
+	_ localComm    = (*baseComm)(nil)
+)
+
+// Supported returns nil if ETL can be used in this deployment.
+func Supported() error {
+	if k8s.IsK8s() || cmn.Rom.Features().IsSet(feat.AllowLocalETL) {
+		return nil
+	}
+	return fmt.Errorf("%w (or else, feature flag %q to run local ETLs)", k8s.ErrK8sRequired, feat.AllowLocalETL.String())
+}
+
+// (non-Kubernetes InitCode; compare with `start`)
+func initLocal(msg *InitCodeMsg, xid string) error {
+	var (
+		config = cmn.GCO.Get()
+		errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.IDX}
+		boot   = &etlBootstrapper{errCtx: errCtx, config: config, originalPodName: msg.IDX}
+	)
+	if _, ok := reg.get(msg.IDX); ok {
+		return cmn.NewErrETL(errCtx, "etl[%s] already exists", msg.IDX)
+	}
+	boot.msg.InitMsgBase = msg.InitMsgBase
+	boot.specHash = specHash(msg, nil)
+
+	lp, err := newLproc(msg, config)
+	if err != nil {
+		return cmn.NewErrETL(errCtx, err.Error())
+	}
+	boot.proc = lp
+	if msg.CommTypeX != HpushStdin {
+		var port int
+		if port, err = lp.serve(msg.Timeout.D()); err == nil {
+			boot.uri = "http://127.0.0.1:" + strconv.Itoa(port)
+		}
+	}
+	if err == nil {
+		err = boot.register(xid)
+	}
+	if err != nil {
+		nlog.Warningln(cmn.NewErrETL(errCtx, "failed to start local etl[%s], msg %s, err %v - cleaning up..", msg.IDX, msg, err))
+		lp.stop()
+		return cmn.NewErrETL(errCtx, err.Error())
+	}
+	nlog.Infoln("started local etl", boot.uri, msg.String())
+	return nil
+}
+
+///////////
+// lproc //
+///////////
+
+func newLproc(msg *InitCodeMsg, config *cmn.Config) (*lproc, error) {
+	r, ok := runtime.Get(msg.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("unsupported runtime %q (supported: %v)", msg.Runtime, runtime.GetNames())
+	}
+	python, err := exec.LookPath(r.Interpreter())
+	if err != nil {
+		return nil, fmt.Errorf("runtime %q requires %q: %v", msg.Runtime, r.Interpreter(), err)
+	}
+	lp := &lproc{name: msg.IDX, python: python, dir: filepath.Join(config.ConfigDir, localDir, msg.IDX)}
+	lp.ctx, lp.cancel = context.WithCancel(context.Background())
+
+	if err := os.RemoveAll(lp.dir); err != nil {
+		return nil, err
+	}
+	if err := cos.CreateDir(lp.dir); err != nil {
+		return nil, err
+	}
+	// (the log remains after the etl is stopped, or fails to start)
+	if lp.logf, err = os.Create(lp.dir + localLog); err != nil {
+		lp.stop()
+		return nil, err
+	}
+	if err := lp.prep(msg); err != nil {
+		lp.stop()
+		return nil, err
+	}
+	return lp, nil
+}
+
+func (lp *lproc) prep(msg *InitCodeMsg) error {
+	if err := os.WriteFile(filepath.Join(lp.dir, localCode), msg.Code, cos.PermRWR); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(lp.dir, localServer), []byte(runtime.PyServer), cos.PermRWR); err != nil {
+		return err
+	}
+
+	var chunk string
+	if msg.ChunkSize > 0 {
+		chunk = strconv.FormatInt(msg.ChunkSize, 10)
+	}
+	lp.env = append(os.Environ(),
+		"PYTHONPATH="+lp.dir+string(os.PathListSeparator)+filepath.Join(lp.dir, localDeps),
+		"MOD_NAME="+"code",
+		"FUNC_TRANSFORM="+msg.Funcs.Transform,
+		"COMM_TYPE="+msg.CommTypeX,
+		"ARG_TYPE="+msg.ArgTypeX,
+		"CHUNK_SIZE="+chunk,
+		"FLAGS="+strconv.FormatInt(msg.Flags, 10),
+		"AIS_TARGET_URL="+core.T.Snode().URL(cmn.NetPublic)+apc.URLPathETLObject.Join(reqSecret),
+		"ETL_HOST=127.0.0.1",
+		"ETL_PORT_FILE="+filepath.Join(lp.dir, localPort),
+	)
+
+	if len(bytes.TrimSpace(msg.Deps)) == 0 {
+		return nil
+	}
+	reqs := filepath.Join(lp.dir, localReqs)
+	if err := os.WriteFile(reqs, msg.Deps, cos.PermRWR); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(lp.ctx, msg.Timeout.D())
+	defer cancel()
+	cmd := exec.CommandContext(ctx, lp.python, "-m", "pip", "install", "--quiet", "--disable-pip-version-check",
+		"--target", filepath.Join(lp.dir, localDeps), "-r", reqs)
+	cmd.Dir, cmd.Env, cmd.Stdout, cmd.Stderr = lp.dir, lp.env, lp.logf, lp.logf
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to install dependencies: %v (see %s)", err, lp.logf.Name())
+	}
+	return nil
+}
+
+// start the server and wait for it to report its (dynamically assigned) port
+func (lp *lproc) serve(timeout time.Duration) (int, error) {
+	cmd := exec.Command(lp.python, filepath.Join(lp.dir, localServer))
+	cmd.Dir, cmd.Env, cmd.Stdout, cmd.Stderr = lp.dir, lp.env, lp.logf, lp.logf
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+	lp.cmd, lp.done = cmd, make(chan struct{})
+	go lp.wait()
+
+	var (
+		fpath    = filepath.Join(lp.dir, localPort)
+		deadline = time.Now().Add(timeout)
+	)
+	for {
+		if b, err := os.ReadFile(fpath); err == nil {
+			port, err := strconv.Atoi(string(bytes.TrimSpace(b)))
+			if err != nil {
+				return 0, fmt.Errorf("invalid port %q: %v", b, err)
+			}
+			return cmn.ValidatePort(port)
+		}
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("timed out after %v waiting for the server to start (see %s)", timeout, lp.logf.Name())
+		}
+		select {
+		case <-lp.done:
+			return 0, fmt.Errorf("server exited: %v (see %s)", lp.exitErr, lp.logf.Name())
+		case <-time.After(localPoll):
+		}
+	}
+}
+
+func (lp *lproc) wait() {
+	lp.exitErr = lp.cmd.Wait()
+	close(lp.done)
+	if lp.ctx.Err() == nil {
+		nlog.Errorln("local etl", lp.name, "server exited:", lp.exitErr)
+	}
+}
+
+// run the code once, with `stdin` on its standard input (and extra environment, if any)
+func (lp *lproc) exec(stdin io.ReadCloser, timeout time.Duration, env ...string) (*procReader, error) {
+	ctx, cancel := lp.ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(lp.ctx, timeout)
+	}
+	cmd := exec.CommandContext(ctx, lp.python, filepath.Join(lp.dir, localCode))
+	cmd.Dir, cmd.Env, cmd.Stdin, cmd.Stderr = lp.dir, lp.env, stdin, lp.logf
+	if len(env) > 0 {
+		cmd.Env = append(append(make([]string, 0, len(lp.env)+len(env)), lp.env...), env...)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &procReader{stdout: stdout, stdin: stdin, cmd: cmd, cancel: cancel, name: lp.name}, nil
+}
+
+func (lp *lproc) stop() {
+	lp.cancel()
+	if lp.cmd != nil {
+		lp.cmd.Process.Signal(syscall.SIGTERM)
+		select {
+		case <-lp.done:
+		case <-time.After(localStopTo):
+			lp.cmd.Process.Kill()
+			<-lp.done
+		}
+	}
+	if lp.logf != nil {
+		cos.Close(lp.logf)
+	}
+	if err := os.RemoveAll(lp.dir); err != nil {
+		nlog.Errorln("local etl", lp.name+":", err)
+	}
+}
+
+func (lp *lproc) logs() ([]byte, error) { return os.ReadFile(lp.logf.Name()) }
+
+func (lp *lproc) health() string {
+	select {
+	case <-lp.done: // (nil when io://)
+		return "Exited"
+	default:
+		return "Running"
+	}
+}
+
+// cores (used since the previous call) and resident memory
+func (lp *lproc) metrics() (*CPUMemUsed, error) {
+	if lp.cmd == nil {
+		return nil, cmn.NewErrUnsupp("get metrics of", "local io:// etl "+lp.name)
+	}
+	ps, err := sys.ProcessStats(lp.cmd.Process.Pid)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cpu float64
+		now = mono.NanoTime()
+	)
+	lp.cpu.mu.Lock()
+	if lp.cpu.ts != 0 && now > lp.cpu.ts && ps.CPU.Total >= lp.cpu.total {
+		cpu = float64(ps.CPU.Total-lp.cpu.total) / float64((now-lp.cpu.ts)/int64(time.Millisecond)+1)
+	}
+	lp.cpu.total, lp.cpu.ts = ps.CPU.Total, now
+	lp.cpu.mu.Unlock()
+	return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpu, Mem: int64(ps.Mem.Resident)}, nil
+}
+
+////////////////
+// procReader //
+////////////////
+
+func (r *procReader) Read(b []byte) (n int, err error) {
+	n, err = r.stdout.Read(b)
+	if err == io.EOF && !r.waited {
+		if errW := r.wait(); errW != nil {
+			err = fmt.Errorf("local etl %s: %v", r.name, errW)
+		}
+	}
+	return n, err
+}
+
+func (r *procReader) wait() error {
+	r.err, r.waited = r.cmd.Wait(), true
+	return r.err
+}
+
+func (r *procReader) Close() error {
+	if !r.waited {
+		r.cancel() // (e.g., the caller stopped reading)
+		r.wait()
+	}
+	r.cancel()
+	return r.stdin.Close()
+}
+
+///////////////
+// stdioComm: implements local HpushStdin
+///////////////
+
+func (sc *stdioComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, errCode int, err error) {
+	if err := sc.boot.xctn.AbortErr(); err != nil {
+		return nil, 0, err
+	}
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, 0, err
+	}
+	size := lom.SizeBytes()
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return nil, 0, err
+	}
+	pr, err := sc.boot.proc.exec(fh, timeout)
+	if err != nil {
+		cos.Close(fh)
+		return nil, 0, err
+	}
+	return sc.reader(pr, size), 0, nil
+}
+
+func (sc *stdioComm) reader(pr *procReader, size int64) cos.ReadCloseSizer {
+	args := cos.ReaderArgs{
+		R:      pr,
+		Size:   cos.ContentLengthUnknown,
+		ReadCb: func(n int, _ error) { sc.boot.xctn.InObjsAdd(0, int64(n)) },
+		DeferCb: func() {
+			sc.boot.xctn.InObjsAdd(1, 0)
+			sc.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
+		},
+	}
+	return cos.NewReaderWithArgs(args)
+}
+
+func (sc *stdioComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck *meta.Bck, objName string) error {
+	lom := core.AllocLOM(objName)
+	r, err := doRequest(bck, lom, 0 /*timeout*/, sc.do)
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(HpushStdin, lom.Cname(), err)
+	}
+	core.FreeLOM(lom)
+	if err != nil {
+		return err
+	}
+	return copyTransformed(w, r)
+}
+
+func (sc *stdioComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+	lom := core.AllocLOM(objName)
+	r, err = doRequest(bck, lom, timeout, sc.do)
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(HpushStdin, lom.Cname(), err)
+	}
+	core.FreeLOM(lom)
+	return
+}
+
+//
+// local ETL: logs, health, and metrics
+//
+
+func localProc(c Communicator) *lproc {
+	if lc, ok := c.(localComm); ok {
+		return lc.proc()
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Transforming archived files (apc.Transform.ArchMembers), whereby OfflineDP:
+// - opens the source shard with cmn/archive reader (any supported format);
+// - sends archived files (members) to the ETL (or ETL pipeline) one at a time, with archpath
+//   in the query (hpush://) or in the environment (io://; see localEnvArchpath);
+// - packs transformed members into the destination shard of the same or a different
+//   (apc.Transform.ArchMime) format, preserving the order;
+// - streams the latter (size unknown) - no staging of the entire shard.
+// Non-regular files (e.g., directories) are skipped.
+
+type (
+	packer struct {
+		dp    *OfflineDP
+		fh    core.LomHandle
+		ar    archive.Reader
+		aw    archive.Writer
+		bck   *meta.Bck
+		pw    *io.PipeWriter
+		cname string
+		oname string
+		mime  string // destination
+		atime int64
+	}
+	// transformed shard
+	packReader struct {
+		*io.PipeReader
+	}
+)
+
+// interface guard
+var _ cos.ReadCloseSizer = (*packReader)(nil)
+
+func (dp *OfflineDP) members(lom *core.LOM) (cos.ReadCloseSizer, error) {
+	fh, err := openShard(lom)
+	if err != nil {
+		return nil, err
+	}
+	mime, err := archive.MimeFile(fh, core.T.PageMM(), "", lom.ObjName)
+	if err != nil {
+		cos.Close(fh)
+		return nil, err
+	}
+	ar, err := archive.NewReader(mime, fh, lom.SizeBytes())
+	if err != nil {
+		cos.Close(fh)
+		return nil, cmn.NewErrFailedTo(core.T, "open", lom, err)
+	}
+	pr, pw := io.Pipe()
+	p := &packer{
+		dp:    dp,
+		fh:    fh,
+		ar:    ar,
+		bck:   lom.Bck(),
+		pw:    pw,
+		cname: lom.Cname(),
+		oname: lom.ObjName,
+		mime:  mime,
+		atime: lom.AtimeUnix(),
+	}
+	if dp.archMime != "" {
+		p.mime = dp.archMime
+	}
+	go p.run()
+	return &packReader{pr}, nil
+}
+
+// (compare with doRequest)
+func openShard(lom *core.LOM) (core.LomHandle, error) {
+	fh, err := _openShard(lom)
+	if err != nil && cos.IsNotExist(err, 0) && lom.Bck().IsRemote() {
+		if _, err = core.T.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+			return nil, err
+		}
+		fh, err = _openShard(lom)
+	}
+	return fh, err
+}
+
+func _openShard(lom *core.LOM) (core.LomHandle, error) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, err
+	}
+	return lom.NewHandle()
+}
+
+////////////
+// packer //
+////////////
+
+func (p *packer) run() {
+	p.aw = archive.NewWriter(p.mime, p.pw, nil /*checksum*/, nil /*opts*/)
+	_, err := p.ar.Range("", p.do)
+	p.aw.Fini()
+	cos.Close(p.fh)
+	if err != nil && cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Warningln("failed to transform archived files of", p.cname+":", err)
+	}
+	p.pw.CloseWithError(err) // nil => EOF
+}
+
+// transform a single archived file and write the result into the destination shard
+func (p *packer) do(archpath string, r cos.ReadCloseSizer, hdr any) (bool, error) {
+	if !regular(hdr) {
+		cos.Close(r)
+		return false, nil
+	}
+	// NOTE: the member gets (fully) read before its transformation - the latter may
+	// still be reading in the background when the source shard reader advances
+	sgl := core.T.PageMM().NewSGL(r.Size())
+	_, err := sgl.ReadFrom(r)
+	cos.Close(r)
+	if err != nil {
+		sgl.Free()
+		return true, err
+	}
+	var once sync.Once
+	in := cos.NewReaderWithArgs(cos.ReaderArgs{R: sgl, Size: sgl.Size(), DeferCb: func() { once.Do(sgl.Free) }})
+
+	out, err := p.dp.pipe.TransformMember(in, p.bck, p.oname, archpath, p.dp.requestTimeout)
+	if err != nil {
+		return true, err
+	}
+	var (
+		src  io.Reader = out
+		size           = out.Size()
+	)
+	if size < 0 {
+		// archive formats require the size upfront
+		buf := core.T.PageMM().NewSGL(0)
+		defer buf.Free()
+		if _, err := buf.ReadFrom(out); err != nil {
+			cos.Close(out)
+			return true, err
+		}
+		src, size = buf, buf.Size()
+	}
+	err = p.aw.Write(archpath, &cmn.ObjAttrs{Size: size, Atime: p.atime}, src)
+	cos.Close(out)
+	return err != nil, err
+}
+
+func regular(hdr any) bool {
+	if th, ok := hdr.(*tar.Header); ok {
+		return th.FileInfo().Mode().IsRegular()
+	}
+	return true // (zip reader skips directories)
+}
+
+////////////////
+// packReader //
+////////////////
+
+func (*packReader) Size() int64 { return cos.ContentLengthUnknown }
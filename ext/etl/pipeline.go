@@ -0,0 +1,230 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// ETL pipeline: ordered list of ETLs (see apc.Transform.Chain and apc.ETLPipelineSep), whereby:
+// - the first stage transforms the (locally stored) object - any communication type;
+// - each subsequent stage transforms the output of the previous one, streaming (not staging) it
+//   via its `PipeTransform` - hpush:// and io:// only;
+// - each stage accounts for its own objects and bytes (CommStats) in its own ETL xaction;
+// - errors are reported (and added to the respective xaction) on a per-stage basis.
+
+type (
+	Pipeline []Communicator
+
+	// adds stage context to errors returned by the (previous stage's) reader
+	stageReader struct {
+		r     cos.ReadCloseSizer
+		pipe  Pipeline
+		once  sync.Once
+		stage int
+	}
+)
+
+// interface guard
+var _ cos.ReadCloseSizer = (*stageReader)(nil)
+
+func GetPipeline(names []string) (Pipeline, error) {
+	debug.Assert(len(names) > 0)
+	pipe := make(Pipeline, 0, len(names))
+	for i, name := range names {
+		comm, err := GetCommunicator(name)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			if err := pipeable(comm); err != nil {
+				return nil, fmt.Errorf("etl pipeline %q: stage #%d: %v", strings.Join(names, apc.ETLPipelineSep), i+1, err)
+			}
+		}
+		pipe = append(pipe, comm)
+	}
+	return pipe, nil
+}
+
+func pipeable(comm Communicator) error {
+	switch c := comm.(type) {
+	case *pushComm:
+		if c.boot.msg.ArgTypeX == ArgTypeFQN {
+			return fmt.Errorf("%s: arg-type %q cannot be used to transform the output of another ETL", c, ArgTypeFQN)
+		}
+		return nil
+	case *stdioComm:
+		return nil
+	default:
+		return fmt.Errorf("%s: only (%s, %s) ETLs can transform the output of another ETL", c, Hpush, HpushStdin)
+	}
+}
+
+func (pipe Pipeline) String() string {
+	names := make([]string, len(pipe))
+	for i, comm := range pipe {
+		names[i] = comm.Name()
+	}
+	return strings.Join(names, apc.ETLPipelineSep)
+}
+
+// transform object through all stages; the caller must close the returned reader
+func (pipe Pipeline) Transform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	r, err := pipe[0].OfflineTransform(bck, objName, timeout)
+	if err != nil {
+		return nil, pipe.stageErr(0, err)
+	}
+	return pipe.pipeFrom(1, r, bck, objName, "", timeout)
+}
+
+// transform archived file (shard member) through all stages (see members.go)
+func (pipe Pipeline) TransformMember(r cos.ReadCloseSizer, bck *meta.Bck, objName, archpath string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	return pipe.pipeFrom(0, r, bck, objName, archpath, timeout)
+}
+
+// pipe `r` through the stages starting from a given one
+func (pipe Pipeline) pipeFrom(stage int, r cos.ReadCloseSizer, bck *meta.Bck, objName, archpath string, timeout time.Duration) (_ cos.ReadCloseSizer, err error) {
+	for i := stage; i < len(pipe); i++ {
+		in := r
+		if i > 0 {
+			in = &stageReader{r: r, pipe: pipe, stage: i - 1}
+		}
+		if r, err = pipe[i].PipeTransform(in, bck, objName, archpath, timeout); err != nil {
+			return nil, pipe.stageErr(i, err)
+		}
+	}
+	return &stageReader{r: r, pipe: pipe, stage: len(pipe) - 1}, nil
+}
+
+// serves the result from the last stage's cache, if enabled (see cache.go)
+func (pipe Pipeline) InlineTransform(w http.ResponseWriter, bck *meta.Bck, objName string) error {
+	cc, ok := pipe[len(pipe)-1].(cachingComm)
+	if !ok || cc.cache() == nil {
+		return pipe.inline(w, bck, objName)
+	}
+	lom := core.AllocLOM(objName)
+	err := cc.cache().inline(w, bck, lom, pipe.String(), pipe.specHash(), func(w http.ResponseWriter) error {
+		return pipe.inline(w, bck, objName)
+	})
+	core.FreeLOM(lom)
+	return err
+}
+
+// combined hash of all the stages' specs
+func (pipe Pipeline) specHash() string {
+	hashes := make([]string, len(pipe))
+	for i, comm := range pipe {
+		if cc, ok := comm.(cachingComm); ok {
+			hashes[i] = cc.spec()
+		}
+	}
+	return strings.Join(hashes, apc.ETLPipelineSep)
+}
+
+func (pipe Pipeline) inline(w io.Writer, bck *meta.Bck, objName string) error {
+	r, err := pipe.Transform(bck, objName, 0 /*timeout*/)
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln("etl pipeline", pipe.String(), bck.Cname(objName), err)
+	}
+	if err != nil {
+		return err
+	}
+	return copyTransformed(w, r)
+}
+
+// (idempotent: an error that originated in one of the previous stages is reported as such)
+func (pipe Pipeline) stageErr(stage int, err error) error {
+	var errETL *cmn.ErrETL
+	if errors.As(err, &errETL) {
+		return errETL
+	}
+	comm := pipe[stage]
+	errV := cmn.NewErrETL(&cmn.ETLErrCtx{TID: core.T.SID(), ETLName: comm.Name(), PodName: comm.PodName()},
+		"pipeline %q, stage #%d: %v", pipe.String(), stage+1, err)
+	comm.Xact().AddErr(errV)
+	return errV
+}
+
+/////////////////
+// stageReader //
+/////////////////
+
+func (sr *stageReader) Size() int64  { return sr.r.Size() }
+func (sr *stageReader) Close() error { return sr.r.Close() }
+
+func (sr *stageReader) Read(b []byte) (n int, err error) {
+	n, err = sr.r.Read(b)
+	if err != nil && err != io.EOF {
+		sr.once.Do(func() { err = sr.pipe.stageErr(sr.stage, err) })
+	}
+	return n, err
+}
+
+//
+// PipeTransform
+//
+
+func (c *baseComm) PipeTransform(r cos.ReadCloseSizer, _ *meta.Bck, _, _ string, _ time.Duration) (cos.ReadCloseSizer, error) {
+	cos.Close(r)
+	return nil, cmn.NewErrUnsupp("transform the output of another ETL with", c.String())
+}
+
+func (pc *pushComm) PipeTransform(r cos.ReadCloseSizer, bck *meta.Bck, objName, archpath string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	if err := pc.boot.xctn.AbortErr(); err != nil {
+		cos.Close(r)
+		return nil, err
+	}
+	debug.Assert(pc.boot.msg.ArgTypeX != ArgTypeFQN) // see pipeable()
+	u := pc.boot.uri + "/" + bck.Name + "/" + objName
+	if archpath != "" {
+		u += "?" + apc.QparamArchpath + "=" + url.QueryEscape(archpath)
+	}
+	out, _, err := pc.put(u, pc.countOut(r), r.Size(), timeout)
+	return out, err
+}
+
+func (sc *stdioComm) PipeTransform(r cos.ReadCloseSizer, _ *meta.Bck, _, archpath string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	if err := sc.boot.xctn.AbortErr(); err != nil {
+		cos.Close(r)
+		return nil, err
+	}
+	var env []string
+	if archpath != "" {
+		env = []string{localEnvArchpath + "=" + archpath}
+	}
+	pr, err := sc.boot.proc.exec(sc.countOut(r), timeout, env...)
+	if err != nil {
+		cos.Close(r)
+		return nil, err
+	}
+	return sc.reader(pr, max(r.Size(), 0)), nil
+}
+
+// when the size of the previous stage's output is unknown, count it as it's being read
+func (c *baseComm) countOut(r cos.ReadCloseSizer) io.ReadCloser {
+	if r.Size() >= 0 {
+		return r
+	}
+	return cos.NewReaderWithArgs(cos.ReaderArgs{
+		R:      r,
+		Size:   r.Size(),
+		ReadCb: func(n int, _ error) { c.boot.xctn.OutObjsAdd(0, int64(n)) },
+	})
+}
@@ -23,6 +23,7 @@ type (
 		PodSpec() string
 		CodeEnvName() string
 		DepsEnvName() string
+		Interpreter() string // (local ETL) executable to look up in the target's PATH
 	}
 	runbase struct{}
 	py38    struct{ runbase }
@@ -34,6 +35,10 @@ var (
 	//go:embed podspec.yaml
 	pyPodSpec string
 
+	// (local ETL) hpush/hpull/hrev server that runs the transforming function in a target-local process
+	//go:embed server.py
+	PyServer string
+
 	all map[string]runtime
 )
 
@@ -65,11 +70,14 @@ func (runbase) CodeEnvName() string { return "AISTORE_CODE" }
 func (runbase) DepsEnvName() string { return "AISTORE_DEPS" }
 
 // container images: "aistorage/runtime_python:<TAG>"
-func (py38) Name() string    { return Py38 }
-func (py38) PodSpec() string { return strings.ReplaceAll(pyPodSpec, "<TAG>", "3.8v2") }
+func (py38) Name() string        { return Py38 }
+func (py38) PodSpec() string     { return strings.ReplaceAll(pyPodSpec, "<TAG>", "3.8v2") }
+func (py38) Interpreter() string { return "python3.8" }
 
-func (py310) Name() string    { return Py310 }
-func (py310) PodSpec() string { return strings.ReplaceAll(pyPodSpec, "<TAG>", "3.10v2") }
+func (py310) Name() string        { return Py310 }
+func (py310) PodSpec() string     { return strings.ReplaceAll(pyPodSpec, "<TAG>", "3.10v2") }
+func (py310) Interpreter() string { return "python3.10" }
 
-func (py311) Name() string    { return Py311 }
-func (py311) PodSpec() string { return strings.ReplaceAll(pyPodSpec, "<TAG>", "3.11v2") }
+func (py311) Name() string        { return Py311 }
+func (py311) PodSpec() string     { return strings.ReplaceAll(pyPodSpec, "<TAG>", "3.11v2") }
+func (py311) Interpreter() string { return "python3.11" }
@@ -0,0 +1,124 @@
+#
+# Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+#
+# Local (non-Kubernetes) ETL server: runs user-provided transforming function in a target-local
+# process and serves hpush://, hpull://, and hrev:// requests from the target (see ext/etl/local.go).
+#
+# Environment:
+#   MOD_NAME, FUNC_TRANSFORM    - module and function to import (the module being user's code.py)
+#   COMM_TYPE, ARG_TYPE         - communication and argument types (see ext/etl/api.go)
+#   CHUNK_SIZE                  - when > 0: call transform(reader, writer) with chunks of that size
+#   AIS_TARGET_URL              - the target to GET objects from (hpull, hrev)
+#   ETL_HOST, ETL_PORT_FILE     - listen address; the (dynamically assigned) port gets written to the file
+#
+import importlib
+import io
+import os
+import sys
+import urllib.parse
+import urllib.request
+from http.server import BaseHTTPRequestHandler, ThreadingHTTPServer
+
+_transform = getattr(importlib.import_module(os.environ["MOD_NAME"]), os.environ["FUNC_TRANSFORM"])
+_arg_type = os.getenv("ARG_TYPE", "")
+_chunk_size = int(os.getenv("CHUNK_SIZE") or 0)
+_target_url = os.getenv("AIS_TARGET_URL", "")
+
+
+def _chunks(reader):
+    while True:
+        b = reader.read(_chunk_size)
+        if not b:
+            return
+        yield b
+
+
+def _run(reader):
+    if _chunk_size > 0:
+        writer = io.BytesIO()
+        _transform(_chunks(reader), writer)
+        return writer.getvalue()
+    return _transform(reader.read())
+
+
+class Handler(BaseHTTPRequestHandler):
+    protocol_version = "HTTP/1.1"
+
+    # hpush://
+    def do_PUT(self):
+        try:
+            if _arg_type == "fqn":
+                self._reply(self._fqn())
+                return
+            size = int(self.headers.get("Content-Length") or 0)
+            self._reply(_run(io.BufferedReader(_Limited(self.rfile, size))))
+        except Exception as e:  # pylint: disable=broad-except
+            self._error(e)
+
+    # hpull://, hrev://
+    def do_GET(self):
+        if self.path == "/health":
+            self._reply(b"Running")
+            return
+        try:
+            if _arg_type == "fqn":
+                self._reply(self._fqn())
+            elif _arg_type == "url":
+                self._reply(_transform(_target_url + self.path))
+            else:
+                with urllib.request.urlopen(_target_url + self.path) as resp:
+                    self._reply(_run(resp))
+        except Exception as e:  # pylint: disable=broad-except
+            self._error(e)
+
+    def _fqn(self):
+        with open(urllib.parse.unquote(self.path), "rb") as f:
+            return _run(f)
+
+    def _reply(self, data):
+        if isinstance(data, str):
+            data = data.encode()
+        self.send_response(200)
+        self.send_header("Content-Type", "application/octet-stream")
+        self.send_header("Content-Length", str(len(data)))
+        self.end_headers()
+        self.wfile.write(data)
+
+    def _error(self, e):
+        msg = repr(e).encode()
+        self.send_response(500)
+        self.send_header("Content-Length", str(len(msg)))
+        self.end_headers()
+        self.wfile.write(msg)
+
+    def log_message(self, *args):  # quiet
+        pass
+
+
+class _Limited(io.RawIOBase):
+    def __init__(self, r, size):
+        self.r, self.left = r, size
+
+    def readable(self):
+        return True
+
+    def readinto(self, b):
+        if self.left <= 0:
+            return 0
+        n = self.r.readinto(memoryview(b)[: min(len(b), self.left)])
+        self.left -= n
+        return n
+
+
+def main():
+    srv = ThreadingHTTPServer((os.getenv("ETL_HOST", "127.0.0.1"), 0), Handler)
+    port_file = os.environ["ETL_PORT_FILE"]
+    with open(port_file + ".tmp", "w", encoding="utf-8") as f:
+        f.write(str(srv.server_address[1]))
+    os.replace(port_file + ".tmp", port_file)
+    print("listening on", srv.server_address, file=sys.stderr, flush=True)
+    srv.serve_forever()
+
+
+if __name__ == "__main__":
+    main()
@@ -146,6 +146,10 @@ func (e *Aborter) ListenSmapChanged() {
 
 // (common for both `InitCode` and `InitSpec` flows)
 func InitSpec(msg *InitSpecMsg, etlName string, opts StartOpts) error {
+	if !k8s.IsK8s() {
+		return fmt.Errorf("%w: cannot run custom ETL container %q (only init-code ETLs can run as local processes)",
+			k8s.ErrK8sRequired, msg.IDX)
+	}
 	config := cmn.GCO.Get()
 	errCtx, podName, svcName, err := start(msg, etlName, opts, config)
 	if err == nil {
@@ -167,7 +171,11 @@ func InitSpec(msg *InitSpecMsg, etlName string, opts StartOpts) error {
 // - make the corresponding assorted substitutions in the etl/runtime/podspec.yaml spec, and
 // - execute `InitSpec` with the modified podspec
 // See also: etl/runtime/podspec.yaml
+// (when not running in Kubernetes, start target-local process instead - see local.go)
 func InitCode(msg *InitCodeMsg, xid string) error {
+	if !k8s.IsK8s() {
+		return initLocal(msg, xid)
+	}
 	var (
 		ftp      = fromToPairs(msg)
 		replacer = strings.NewReplacer(ftp...)
@@ -252,6 +260,7 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 	errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.IDX}
 	boot := &etlBootstrapper{errCtx: errCtx, config: config, env: opts.Env}
 	boot.msg = *msg
+	boot.specHash = specHash(msg, opts.Env)
 
 	// Parse spec template and fill Pod object with necessary fields.
 	if err = boot.createPodSpec(); err != nil {
@@ -284,14 +293,7 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 		return
 	}
 
-	boot.setupXaction(xid)
-
-	// finally, add Communicator to the runtime registry
-	comm := newCommunicator(newAborter(msg.IDX), boot)
-	if err = reg.add(msg.IDX, comm); err != nil {
-		return
-	}
-	core.T.Sowner().Listeners().Reg(comm)
+	err = boot.register(xid)
 	return
 }
 
@@ -328,9 +330,6 @@ func Stop(id string, errCause error) error {
 
 // StopAll terminates all running ETLs.
 func StopAll() {
-	if !k8s.IsK8s() {
-		return
-	}
 	for _, e := range List() {
 		if err := Stop(e.Name, nil); err != nil {
 			nlog.Errorln(err)
@@ -353,6 +352,10 @@ func PodLogs(transformID string) (logs Logs, err error) {
 	if err != nil {
 		return logs, err
 	}
+	if lp := localProc(c); lp != nil {
+		b, err := lp.logs()
+		return Logs{TargetID: core.T.SID(), Logs: b}, err
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return logs, err
@@ -372,6 +375,9 @@ func PodHealth(etlName string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if lp := localProc(c); lp != nil {
+		return lp.health(), nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return "", err
@@ -384,6 +390,9 @@ func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	if err != nil {
 		return nil, err
 	}
+	if lp := localProc(c); lp != nil {
+		return lp.metrics()
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return nil, err
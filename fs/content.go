@@ -38,6 +38,10 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+
+	ObjVersionType = "ov" // retained (previous) versions of ais:// objects
+	TierRedirType  = "tr" // redirection markers of objects stored on the (bucket's) non-preferred tier
+	ETLCacheType   = "et" // cached results of inline ETL transformations (see ext/etl/cache.go)
 )
 
 type (
@@ -173,10 +177,13 @@ func (f *contentSpecMgr) PermToProcess(fqn string) (ok bool) {
 // FIXME: This should be probably placed somewhere else \/
 
 type (
-	ObjectContentResolver   struct{}
-	WorkfileContentResolver struct{}
-	ECSliceContentResolver  struct{}
-	ECMetaContentResolver   struct{}
+	ObjectContentResolver     struct{}
+	WorkfileContentResolver   struct{}
+	ECSliceContentResolver    struct{}
+	ECMetaContentResolver     struct{}
+	ObjVersionContentResolver struct{}
+	TierRedirContentResolver  struct{}
+	ETLCacheContentResolver   struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -251,3 +258,65 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// NOTE: retained versions stay with the target (and mountpath) that stored them -
+// rebalance and resilver won't migrate them
+
+func (*ObjVersionContentResolver) PermToMove() bool    { return false }
+func (*ObjVersionContentResolver) PermToEvict() bool   { return false }
+func (*ObjVersionContentResolver) PermToProcess() bool { return false }
+
+// <object-name>.<numeric version>
+func (*ObjVersionContentResolver) GenUniqueFQN(base, ver string) string { return base + "." + ver }
+
+func (*ObjVersionContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	orig, _, ok = ParseObjVersion(base)
+	return orig, false, ok
+}
+
+func ParseObjVersion(base string) (objName, ver string, ok bool) {
+	i := strings.LastIndexByte(base, '.')
+	if i <= 0 || i == len(base)-1 {
+		return "", "", false
+	}
+	if _, err := strconv.ParseUint(base[i+1:], 10, 64); err != nil {
+		return "", "", false
+	}
+	return base[:i], base[i+1:], true
+}
+
+// NOTE: redirection markers are maintained by tier-migrate (see core/ltier.go)
+
+func (*TierRedirContentResolver) PermToMove() bool    { return false }
+func (*TierRedirContentResolver) PermToEvict() bool   { return false }
+func (*TierRedirContentResolver) PermToProcess() bool { return false }
+
+func (*TierRedirContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*TierRedirContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
+
+// NOTE: cached ETL results are target-local and transient: rebalance does not move them,
+// and space cleanup removes those left behind by previous runs (different pid)
+
+func (*ETLCacheContentResolver) PermToMove() bool    { return false }
+func (*ETLCacheContentResolver) PermToEvict() bool   { return true }
+func (*ETLCacheContentResolver) PermToProcess() bool { return false }
+
+// <etl-name>/<object-name>.<pid>
+func (*ETLCacheContentResolver) GenUniqueFQN(base, etlName string) string {
+	return etlName + "/" + base + "." + spid
+}
+
+func (*ETLCacheContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	i := strings.LastIndexByte(base, '.')
+	if i <= 0 {
+		return "", false, false
+	}
+	filePID, err := strconv.ParseInt(base[i+1:], 16, 64)
+	if err != nil {
+		return "", false, false
+	}
+	return base[:i], filePID != pid, true
+}
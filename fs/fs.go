@@ -55,6 +55,7 @@ type (
 		Disks      []string // owned disks (ios.FsDisks map => slice)
 		flags      uint64   // bit flags (set/get atomic)
 		PathDigest uint64   // (HRW logic)
+		Class      string   // storage class: apc.TierHot | apc.TierCold (see cmn.FSPConf)
 		capacity   Capacity
 	}
 	MPI map[string]*Mountpath
@@ -121,6 +122,7 @@ func NewMountpath(mpath string) (mi *Mountpath, err error) {
 		Path:       cleanMpath,
 		FS:         fsInfo,
 		PathDigest: xxhash.Checksum64S(cos.UnsafeB(cleanMpath), cos.MLCG32),
+		Class:      cmn.GCO.Get().FSP.Class(cleanMpath),
 	}
 	return
 }
@@ -0,0 +1,49 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/xoshiro256"
+	"github.com/OneOfOne/xxhash"
+)
+
+// storage tiers: mountpath classes (see cmn.FSPConf and cmn.TierConf)
+
+// returns true if there's at least one available cold mountpath
+// (in other words, if this target is tiered)
+func Tiered() bool {
+	for _, mi := range GetAvail() {
+		if mi.Class == apc.TierCold {
+			return true
+		}
+	}
+	return false
+}
+
+// HrwTier is Hrw restricted to the mountpaths of a given class;
+// when there are no such mountpaths, falls back to Hrw (all available mountpaths)
+func HrwTier(uname, tier string) (mi *Mountpath, digest uint64, err error) {
+	var (
+		max   uint64
+		avail = GetAvail()
+	)
+	digest = xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+	for _, mpathInfo := range avail {
+		if mpathInfo.Class != tier || mpathInfo.IsAnySet(FlagWaitingDD) {
+			continue
+		}
+		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
+		if cs >= max {
+			max = cs
+			mi = mpathInfo
+		}
+	}
+	if mi == nil {
+		return Hrw(uname)
+	}
+	return mi, digest, nil
+}
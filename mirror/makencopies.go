@@ -32,7 +32,8 @@ type (
 	// mncXact runs in a background, traverses all local mountpaths, and makes sure
 	// the bucket is N-way replicated (where N >= 1).
 	mncXact struct {
-		p *mncFactory
+		p    *mncFactory
+		smap *meta.Smap // (target placement only)
 		xact.BckJog
 	}
 )
@@ -90,11 +91,12 @@ func newMNC(p *mncFactory, slab *memsys.Slab) (r *mncXact) {
 func (r *mncXact) Run(wg *sync.WaitGroup) {
 	wg.Done()
 	tname := core.T.String()
-	if err := fs.ValidateNCopies(tname, r.p.args.Copies); err != nil {
+	if err := ValidateNCopies(tname, r.p.args.Copies, &r.p.Bck.Props.Mirror); err != nil {
 		r.AddErr(err)
 		r.Finish()
 		return
 	}
+	r.smap = core.T.Sowner().Get()
 	r.BckJog.Run()
 	nlog.Infoln(r.Name())
 	err := r.BckJog.Wait()
@@ -111,6 +113,8 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		copies = r.p.args.Copies
 	)
 	switch {
+	case lom.MirrorConf().OnTargets():
+		size, err = r.visitTargets(lom)
 	case n == copies:
 		return nil
 	case n > copies:
@@ -153,6 +157,41 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 	return
 }
 
+// cluster-level mirror (see targets.go):
+// - owner: remove local copies, if any, and make sure that remote copies exist;
+// - non-owner: remove the object if it's not supposed to be here and the owner has it
+func (r *mncXact) visitTargets(lom *core.LOM) (size int64, err error) {
+	tsis, err := r.smap.HrwTargetDomains(lom.Uname(), r.p.args.Copies, lom.MirrorConf().Domain)
+	if err != nil {
+		return 0, err
+	}
+	tid := core.T.SID()
+	if tsis[0].ID() == tid {
+		if lom.NumCopies() > 1 {
+			lom.Lock(true)
+			_, err = delCopies(lom, 1)
+			lom.Unlock(true)
+			if err != nil {
+				return 0, err
+			}
+		}
+		return AddRemoteCopies(lom, tsis[1:], true /*check*/)
+	}
+	for _, tsi := range tsis[1:] {
+		if tsi.ID() == tid {
+			return 0, nil
+		}
+	}
+	if !core.T.HeadObjT2T(lom, tsis[0]) {
+		return 0, nil
+	}
+	size = lom.SizeBytes()
+	lom.Lock(true)
+	err = lom.Remove()
+	lom.Unlock(true)
+	return size, err
+}
+
 func (r *mncXact) str(s string) string {
 	return fmt.Sprintf("%s tag=%s, copies=%d", s, r.p.args.Tag, r.p.args.Copies)
 }
@@ -18,7 +18,6 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
-	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/xact"
@@ -68,7 +67,7 @@ func (p *putFactory) Start() error {
 	if !mirror.Enabled {
 		return fmt.Errorf("%s: mirroring disabled, nothing to do", bck)
 	}
-	if err = fs.ValidateNCopies(core.T.String(), int(mirror.Copies)); err != nil {
+	if err = ValidateNCopies(core.T.String(), int(mirror.Copies), mirror); err != nil {
 		nlog.Errorln(err)
 		return err
 	}
@@ -112,11 +111,22 @@ func (p *putFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
 
 // (one worker per mountpath)
 func (r *XactPut) do(lom *core.LOM, buf []byte) {
-	copies := int(lom.Bprops().Mirror.Copies)
-
-	lom.Lock(true)
-	size, err := addCopies(lom, copies, buf)
-	lom.Unlock(true)
+	var (
+		size   int64
+		err    error
+		mconf  = lom.MirrorConf()
+		copies = int(mconf.Copies)
+	)
+	if mconf.OnTargets() {
+		var tsis meta.Nodes
+		if tsis, err = Placement(lom, core.T.Sowner().Get()); err == nil {
+			size, err = AddRemoteCopies(lom, tsis[1:], false /*check*/)
+		}
+	} else {
+		lom.Lock(true)
+		size, err = addCopies(lom, copies, buf)
+		lom.Unlock(true)
+	}
 
 	if err != nil {
 		r.AddErr(err, 5, cos.SmoduleMirror)
@@ -0,0 +1,112 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Cluster-level n-way mirror (cmn.MirrorConf.Placement = apc.MirrorPlaceTarget):
+// - the object's HRW target ("owner") stores the object itself and is the one that replicates it;
+// - the remaining (copies - 1) copies are stored, as regular objects, by the next HRW targets
+//   (and in distinct failure domains when cmn.MirrorConf.Domain is configured) - see Placement();
+// - remote copies are written with cmn.OwtRebalance semantics, i.e., never to remote backends;
+// - rebalance (reb) leaves properly placed copies alone and re-replicates upon cluster membership changes.
+
+// returns targets that must store the object: the owner followed by the targets for its copies
+func Placement(lom *core.LOM, smap *meta.Smap) (meta.Nodes, error) {
+	mconf := lom.MirrorConf()
+	return smap.HrwTargetDomains(lom.Uname(), int(mconf.Copies), mconf.Domain)
+}
+
+// validate the number of copies: mountpaths (fs.ValidateNCopies) or targets, depending on placement
+func ValidateNCopies(tname string, copies int, mconf *cmn.MirrorConf) error {
+	if !mconf.OnTargets() {
+		return fs.ValidateNCopies(tname, copies)
+	}
+	if copies < 1 {
+		return fmt.Errorf("%s: invalid num copies %d", tname, copies)
+	}
+	smap := core.T.Sowner().Get()
+	if num := smap.CountActiveTs(); num < copies {
+		return fmt.Errorf("%s: number of copies (%d) exceeds the number of targets (%d)", tname, copies, num)
+	}
+	return nil
+}
+
+// is called by the owner to add remote copies on the specified targets;
+// `check` to skip targets that already have the object (compare with the PUT path
+// that always (over)writes)
+func AddRemoteCopies(lom *core.LOM, tsis meta.Nodes, check bool) (size int64, err error) {
+	for _, tsi := range tsis {
+		if tsi.ID() == core.T.SID() {
+			continue
+		}
+		if check && core.T.HeadObjT2T(lom, tsi) {
+			continue
+		}
+		if err = putRemote(lom, tsi); err != nil {
+			if cos.IsNotExist(err, 0) {
+				err = nil // removed in the meantime
+			}
+			return size, err
+		}
+		size += lom.SizeBytes()
+	}
+	return size, nil
+}
+
+// PUT lom => tsi (compare with ais/tgtobj coi.put)
+func putRemote(lom *core.LOM, tsi *meta.Snode) error {
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	roc, err := lom.NewDeferROC() // unlocks when closed
+	if err != nil {
+		return err
+	}
+	var (
+		hdr   = make(http.Header, 8)
+		bck   = lom.Bck()
+		query = bck.NewQuery()
+	)
+	cmn.ToHeader(lom, hdr)
+	hdr.Set(apc.HdrT2TPutterID, core.T.SID())
+	query.Set(apc.QparamOWT, cmn.OwtRebalance.ToS())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(bck.Name, lom.ObjName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  roc,
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(cmn.GCO.Get().Timeout.SendFile.D())
+	if err != nil {
+		cos.Close(roc)
+		return err
+	}
+	defer cancel()
+	resp, err := core.T.DataClient().Do(req)
+	if err != nil {
+		return cmn.NewErrFailedTo(core.T, "replicate "+lom.Cname()+" =>", tsi, err)
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return cmn.NewErrFailedTo(core.T, "replicate "+lom.Cname()+" =>", tsi, fmt.Errorf("status %d", resp.StatusCode))
+	}
+	return nil
+}
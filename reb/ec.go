@@ -210,7 +210,7 @@ func (reb *Reb) findEmptyTarget(md *ec.Metadata, ct *core.CT, sender string) (*m
 	var (
 		sliceCnt     = md.Data + md.Parity + 2
 		smap         = reb.smap.Load()
-		hrwList, err = smap.HrwTargetList(ct.Bck().MakeUname(ct.ObjectName()), sliceCnt)
+		hrwList, err = ec.HrwTargets(smap, ct.Bck(), ct.ObjectName(), sliceCnt)
 	)
 	if err != nil {
 		return nil, err
@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
@@ -815,6 +816,11 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	if lom.Bck().Props.EC.Enabled {
 		return filepath.SkipDir
 	}
+	if mconf := lom.MirrorConf(); mconf.Enabled && mconf.OnTargets() {
+		if rj.placedCopy(lom) {
+			return cmn.ErrSkip
+		}
+	}
 	tsi, err := rj.smap.HrwHash2T(lom.Digest())
 	if err != nil {
 		return err
@@ -846,6 +852,31 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	return nil
 }
 
+// cluster-level n-way mirror (see mirror/targets.go) - returns true if there's nothing else to do:
+// - owner: make sure that copies exist on the (new) targets of the object's placement;
+// - target that's supposed to store a copy: send the object to the owner only if the latter doesn't have it;
+// - any other target: proceed to send the object to its owner (as usual)
+func (rj *rebJogger) placedCopy(lom *core.LOM) bool {
+	tsis, err := mirror.Placement(lom, rj.smap)
+	if err != nil {
+		nlog.Warningln(rj.xreb.Name(), lom.Cname(), err)
+		return false
+	}
+	tid := core.T.SID()
+	if tsis[0].ID() == tid {
+		if _, err := mirror.AddRemoteCopies(lom, tsis[1:], true /*check*/); err != nil {
+			nlog.Warningln(rj.xreb.Name(), "failed to replicate", lom.Cname(), "err:", err)
+		}
+		return true
+	}
+	for _, tsi := range tsis[1:] {
+		if tsi.ID() == tid {
+			return core.T.HeadObjT2T(lom, tsis[0])
+		}
+	}
+	return false
+}
+
 // takes rlock and keeps it _iff_ successful
 func _getReader(lom *core.LOM) (roc cos.ReadOpenCloser, err error) {
 	lom.Lock(false)
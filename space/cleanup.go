@@ -348,7 +348,7 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.ETLCacheType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
@@ -370,6 +370,13 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 		if ok && old {
 			j.oldWork = append(j.oldWork, fqn)
 		}
+	case fs.ETLCacheType:
+		// cached ETL results: remove those left behind by previous runs
+		_, base := filepath.Split(fqn)
+		contentResolver := fs.CSM.Resolver(fs.ETLCacheType)
+		if _, old, ok := contentResolver.ParseUniqueFQN(base); ok && old {
+			j.oldWork = append(j.oldWork, fqn)
+		}
 	case fs.ECSliceType:
 		// EC slices:
 		// - EC enabled: remove only slices with missing metafiles
@@ -470,6 +477,9 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	if lom.IsCopy() {
 		return
 	}
+	if core.WbPending(lom.Uname()) { // (e.g., rebalanced prior to write-back upload)
+		return
+	}
 	if lom.Bprops().EC.Enabled {
 		metaFQN := fs.CSM.Gen(lom, fs.ECMetaType, "")
 		if cos.Stat(metaFQN) != nil {
@@ -9,6 +9,7 @@ package space
 import (
 	"container/heap"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -32,7 +33,8 @@ import (
 // config.Space.HighWM (section "space" in the cluster config).
 //
 // When and if exceeded, AIS target will start gradually evicting objects from its
-// stable storage: oldest first access-time wise.
+// stable storage: oldest first access-time wise or, more generally, in the order
+// defined by the bucket's eviction policy (cmn.LRUConf.Policy and policy.go).
 //
 // LRU is implemented as eXtended Action (xaction, see xact/README.md) that gets
 // triggered when/if a used local capacity exceeds high watermark (config.Space.HighWM). LRU then
@@ -67,8 +69,13 @@ type (
 
 // private
 type (
-	// minHeap keeps fileInfo sorted by access time with oldest on top of the heap.
-	minHeap []*core.LOM
+	// eviction candidate ranked by the bucket's eviction policy
+	evictCand struct {
+		lom  *core.LOM
+		rank float64
+	}
+	// minHeap keeps eviction candidates sorted by rank with the lowest on top of the heap.
+	minHeap []evictCand
 
 	// parent (contains mpath joggers)
 	lruP struct {
@@ -83,8 +90,9 @@ type (
 		// runtime
 		curSize   int64
 		totalSize int64 // difference between lowWM size and used size
-		newest    int64
+		maxRank   float64
 		heap      *minHeap
+		policy    evictPolicy
 		bck       cmn.Bck
 		now       int64
 		// init-time
@@ -257,14 +265,18 @@ func (j *lruJ) jogBcks(bcks []cmn.Bck, force bool) (err error) {
 		j.sortBsize(bcks)
 	}
 	for _, bck := range bcks { // for each bucket under a given provider
-		var size int64
+		var (
+			size    int64
+			lruConf *cmn.LRUConf
+		)
 		j.bck = bck
-		if j.allowDelObj, err = j.allow(); err != nil {
+		if lruConf, j.allowDelObj, err = j.allow(); err != nil {
 			nlog.Errorf("%s: %v - skipping %s (Hint: run 'ais storage cleanup' to cleanup)", j, err, bck)
 			err = nil
 			continue
 		}
 		j.allowDelObj = j.allowDelObj || force
+		j.policy = newEvictPolicy(lruConf.EvictPolicy())
 		if size, err = j.jogBck(); err != nil {
 			return
 		}
@@ -287,6 +299,7 @@ func (j *lruJ) jogBck() (size int64, err error) {
 	h := (*j.heap)[:0]
 	j.heap = &h
 	heap.Init(j.heap)
+	j.curSize, j.maxRank = 0, math.Inf(-1)
 
 	// 2. collect
 	opts := &fs.WalkOpts{
@@ -328,15 +341,19 @@ func (j *lruJ) _visit(lom *core.LOM) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
+	if lom.CheckObjLock(false /*bypass governance*/) != nil {
+		return // (object lock: retained or on legal hold)
+	}
 	// do nothing if the heap's curSize >= totalSize and
-	// the file is more recent then the the heap's newest.
-	if j.curSize >= j.totalSize && lom.AtimeUnix() > j.newest {
+	// the object ranks higher than any object in the heap
+	rank := j.policy.rank(lom, j.now)
+	if j.curSize >= j.totalSize && rank > j.maxRank {
 		return
 	}
-	heap.Push(j.heap, lom)
+	heap.Push(j.heap, evictCand{lom: lom, rank: rank})
 	j.curSize += lom.SizeBytes()
-	if lom.AtimeUnix() > j.newest {
-		j.newest = lom.AtimeUnix()
+	if rank > j.maxRank {
+		j.maxRank = rank
 	}
 	return true
 }
@@ -369,7 +386,7 @@ func (j *lruJ) evict() (size int64, err error) {
 
 	// evict(sic!) and house-keep
 	for h.Len() > 0 && j.totalSize > 0 {
-		lom := heap.Pop(h).(*core.LOM)
+		lom := heap.Pop(h).(evictCand).lom
 		if !j.evictObj(lom) {
 			core.FreeLOM(lom)
 			continue
@@ -401,7 +418,7 @@ func (j *lruJ) postRemove(prev, size int64) (capCheck int64, err error) {
 	// init, recompute, and throttle - once per capCheckThresh
 	capCheck = 0
 	j.throttle = false
-	j.allowDelObj, _ = j.allow()
+	_, j.allowDelObj, _ = j.allow()
 	j.config = cmn.GCO.Get()
 	j.now = time.Now().UnixNano()
 	usedPct, ok := j.ini.GetFSUsedPercentage(j.mi.Path)
@@ -432,6 +449,10 @@ func (j *lruJ) _throttle(usedPct int64) (err error) {
 // remove local copies that "belong" to different LRU joggers (space accounting may be temporarily not precise)
 func (j *lruJ) evictObj(lom *core.LOM) bool {
 	lom.Lock(true)
+	if core.WbPending(lom.Uname()) { // not uploaded yet (write-back)
+		lom.Unlock(true)
+		return false
+	}
 	err := lom.Remove()
 	lom.Unlock(true)
 	if err != nil {
@@ -498,7 +519,7 @@ func (j *lruJ) sortBsize(bcks []cmn.Bck) {
 	}
 }
 
-func (j *lruJ) allow() (ok bool, err error) {
+func (j *lruJ) allow() (lruConf *cmn.LRUConf, ok bool, err error) {
 	var (
 		bowner = core.T.Bowner()
 		b      = meta.CloneBck(&j.bck)
@@ -506,7 +527,8 @@ func (j *lruJ) allow() (ok bool, err error) {
 	if err = b.Init(bowner); err != nil {
 		return
 	}
-	ok = b.Props.LRU.Enabled && b.Allow(apc.AceObjDELETE) == nil
+	lruConf = &b.Props.LRU
+	ok = lruConf.Enabled && b.Allow(apc.AceObjDELETE) == nil
 	return
 }
 
@@ -514,10 +536,17 @@ func (j *lruJ) allow() (ok bool, err error) {
 // min-heap //
 //////////////
 
-func (h minHeap) Len() int           { return len(h) }
-func (h minHeap) Less(i, j int) bool { return h[i].Atime().Before(h[j].Atime()) }
-func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
-func (h *minHeap) Push(x any)        { *h = append(*h, x.(*core.LOM)) }
+func (h minHeap) Len() int      { return len(h) }
+func (h minHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)   { *h = append(*h, x.(evictCand)) }
+
+func (h minHeap) Less(i, j int) bool {
+	if h[i].rank != h[j].rank {
+		return h[i].rank < h[j].rank
+	}
+	return h[i].lom.AtimeUnix() < h[j].lom.AtimeUnix()
+}
+
 func (h *minHeap) Pop() any {
 	old := *h
 	n := len(old)
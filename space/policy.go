@@ -0,0 +1,71 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Eviction policies (cmn.LRUConf.Policy) rank eviction candidates - the lower the rank
+// the sooner the object gets evicted; objects of the same rank are evicted oldest first.
+//
+// Frequency-based policies (LFU and ARC) rely on per-object access counters
+// that are maintained only when the policy is configured (see cmn.LRUConf.TrackFreq);
+// with no access counts (e.g., right after switching the policy) they degenerate to LRU.
+
+type (
+	evictPolicy interface {
+		rank(lom *core.LOM, now int64) float64
+	}
+	lruPolicy     struct{}
+	sizeLRUPolicy struct{}
+	lfuPolicy     struct{}
+	arcPolicy     struct{}
+)
+
+// interface guard
+var (
+	_ evictPolicy = (*lruPolicy)(nil)
+	_ evictPolicy = (*sizeLRUPolicy)(nil)
+	_ evictPolicy = (*lfuPolicy)(nil)
+	_ evictPolicy = (*arcPolicy)(nil)
+)
+
+func newEvictPolicy(policy string) evictPolicy {
+	switch policy {
+	case apc.LRUPolicySizeLRU:
+		return &sizeLRUPolicy{}
+	case apc.LRUPolicyLFU:
+		return &lfuPolicy{}
+	case apc.LRUPolicyARC:
+		return &arcPolicy{}
+	default:
+		return &lruPolicy{}
+	}
+}
+
+// least recently used first
+func (*lruPolicy) rank(lom *core.LOM, _ int64) float64 { return float64(lom.AtimeUnix()) }
+
+// (time since last access) x size: big rarely-read objects go before small hot ones
+func (*sizeLRUPolicy) rank(lom *core.LOM, now int64) float64 {
+	age := max(now-lom.AtimeUnix(), 0)
+	return -float64(age) * float64(lom.SizeBytes())
+}
+
+// least frequently used first
+func (*lfuPolicy) rank(lom *core.LOM, _ int64) float64 { return float64(lom.AccessCnt()) }
+
+// ARC-like: the "recency" list (objects accessed at most once) is evicted before
+// the "frequency" list (objects accessed repeatedly), LRU-wise within each list
+func (*arcPolicy) rank(lom *core.LOM, _ int64) float64 {
+	if lom.AccessCnt() > 1 {
+		return 1
+	}
+	return 0
+}
@@ -37,6 +37,9 @@ const (
 	basePath             = "/tmp/space-tests"
 	bucketName           = "space-bck"
 	bucketNameAnother    = bucketName + "-another"
+	bucketNameSizeLRU    = bucketName + "-size-lru"
+	bucketNameLFU        = bucketName + "-lfu"
+	bucketNameARC        = bucketName + "-arc"
 )
 
 type fileMetadata struct {
@@ -200,6 +203,80 @@ var _ = Describe("space evict/cleanup tests", func() {
 			})
 		})
 
+		Describe("eviction policies", func() {
+			var ini *space.IniLRU
+			BeforeEach(func() {
+				ini = newIniLRU()
+			})
+
+			bckPath := func(name string) string {
+				bck := cmn.Bck{Name: name, Provider: apc.AIS, Ns: cmn.NsGlobal}
+				fqn := fs.GetAvail()[basePath].MakePathCT(&bck, fs.ObjectType)
+				cos.CreateDir(fqn)
+				return fqn
+			}
+
+			It("should evict big objects first (size-weighted LRU)", func() {
+				var (
+					filesPath = bckPath(bucketNameSizeLRU)
+					atime     = time.Now().Add(-time.Hour).UnixNano()
+					small     = []string{getRandomFileName(0), getRandomFileName(1), getRandomFileName(2), getRandomFileName(3)}
+				)
+				ini.GetFSStats = func(string) (blocks, bavail uint64, bsize int64, err error) {
+					bsize = blockSize
+					btaken := uint64(40 * cos.MiB / blockSize)
+					blocks = uint64(float64(btaken) / initialDiskUsagePct)
+					bavail = blocks - btaken
+					return
+				}
+				// older small and (slightly) more recent big ones
+				for _, name := range small {
+					saveFile(path.Join(filesPath, name), 2*cos.MiB, atime-int64(time.Minute), 0)
+				}
+				saveFile(path.Join(filesPath, getRandomFileName(4)), 16*cos.MiB, atime, 0)
+				saveFile(path.Join(filesPath, getRandomFileName(5)), 16*cos.MiB, atime, 0)
+
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(len(small)))
+				for _, name := range files {
+					Expect(cos.StringInSlice(name.Name(), small)).To(BeTrue())
+				}
+			})
+
+			for _, bname := range []string{bucketNameLFU, bucketNameARC} {
+				bname := bname
+				It("should evict rarely accessed objects first ("+bname+")", func() {
+					const numberOfFiles = 6
+					var (
+						filesPath = bckPath(bname)
+						atime     = time.Now().Add(-time.Hour).UnixNano()
+						frequent  = []string{getRandomFileName(0), getRandomFileName(1), getRandomFileName(2)}
+					)
+					ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+
+					// older but frequently accessed vs more recent accessed once
+					for _, name := range frequent {
+						saveFile(path.Join(filesPath, name), fileSize, atime-int64(time.Hour), 10)
+					}
+					for i := 3; i < numberOfFiles; i++ {
+						saveFile(path.Join(filesPath, getRandomFileName(i)), fileSize, atime, 1)
+					}
+
+					space.RunLRU(ini)
+
+					files, err := os.ReadDir(filesPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(files)).To(Equal(len(frequent)))
+					for _, name := range files {
+						Expect(cos.StringInSlice(name.Name(), frequent)).To(BeTrue())
+					}
+				})
+			}
+		})
+
 		Describe("not evict files", func() {
 			var ini *space.IniLRU
 			BeforeEach(func() {
@@ -335,6 +412,33 @@ func newTargetLRUMock() *mock.TargetMock {
 					BID:    0xf4e3d2c1,
 				},
 			),
+			meta.NewBck(
+				bucketNameSizeLRU, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+					LRU:    cmn.LRUConf{Enabled: true, Policy: apc.LRUPolicySizeLRU},
+					Access: apc.AccessAll,
+					BID:    0xb1c2d3e4,
+				},
+			),
+			meta.NewBck(
+				bucketNameLFU, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+					LRU:    cmn.LRUConf{Enabled: true, Policy: apc.LRUPolicyLFU},
+					Access: apc.AccessAll,
+					BID:    0xc2d3e4f5,
+				},
+			),
+			meta.NewBck(
+				bucketNameARC, apc.AIS, cmn.NsGlobal,
+				&cmn.Bprops{
+					Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+					LRU:    cmn.LRUConf{Enabled: true, Policy: apc.LRUPolicyARC},
+					Access: apc.AccessAll,
+					BID:    0xd3e4f5a6,
+				},
+			),
 		)
 		tMock = mock.NewTarget(bmdMock)
 	)
@@ -387,6 +491,10 @@ func getRandomFileName(fileCounter int) string {
 }
 
 func saveRandomFile(filename string, size int64) {
+	saveFile(filename, size, time.Now().UnixNano(), 0)
+}
+
+func saveFile(filename string, size, atime int64, accessCnt uint64) {
 	buff := make([]byte, size)
 	_, err := cos.SaveReader(filename, rand.Reader, buff, cos.ChecksumNone, size)
 	Expect(err).NotTo(HaveOccurred())
@@ -395,7 +503,8 @@ func saveRandomFile(filename string, size int64) {
 	Expect(err).NotTo(HaveOccurred())
 	lom.SetSize(size)
 	lom.IncVersion()
-	lom.SetAtimeUnix(time.Now().UnixNano())
+	lom.SetAtimeUnix(atime)
+	lom.SetAccessCnt(accessCnt)
 	Expect(lom.Persist()).NotTo(HaveOccurred())
 }
 
@@ -102,6 +102,14 @@ const (
 	LcacheCollisionCount = core.LcacheCollisionCount
 	LcacheEvictedCount   = core.LcacheEvictedCount
 	LcacheFlushColdCount = core.LcacheFlushColdCount
+
+	// write-back (see core/lwback.go)
+	WbUploadCount    = core.WbUploadCount
+	WbUploadSize     = core.WbUploadSize
+	ErrWbUploadCount = core.ErrWbUploadCount
+	WbBacklogCount   = core.WbBacklogCount
+	WbBacklogSize    = core.WbBacklogSize
+	WbLag            = core.WbLag
 )
 
 type (
@@ -257,6 +265,13 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, LcacheEvictedCount, KindCounter)
 	r.reg(node, LcacheFlushColdCount, KindCounter)
 
+	r.reg(node, WbUploadCount, KindCounter)
+	r.reg(node, WbUploadSize, KindSize)
+	r.reg(node, ErrWbUploadCount, KindCounter)
+	r.reg(node, WbBacklogCount, KindGauge)
+	r.reg(node, WbBacklogSize, KindGauge)
+	r.reg(node, WbLag, KindGauge)
+
 	// Prometheus
 	r.core.initProm(node)
 }
@@ -298,6 +313,13 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 		v = s.Tracker[nameUtil(disk)]
 		v.Value = stats.Util
 	}
+	// write-back backlog
+	{
+		cnt, size, lag := core.WbBacklog()
+		s.Tracker[WbBacklogCount].Value = cnt
+		s.Tracker[WbBacklogSize].Value = size
+		s.Tracker[WbLag].Value = int64(lag)
+	}
 
 	// 2 copy stats, reset latencies, send via StatsD if configured
 	s.updateUptime(uptime)
@@ -151,6 +151,9 @@ func PrepareObjects(t *testing.T, desc ObjectsDesc) *ObjectsOut {
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.ECSliceType, &fs.ECSliceContentResolver{}, true)
 	fs.CSM.Reg(fs.ECMetaType, &fs.ECMetaContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
+	fs.CSM.Reg(fs.TierRedirType, &fs.TierRedirContentResolver{}, true)
+	fs.CSM.Reg(fs.ETLCacheType, &fs.ETLCacheContentResolver{}, true)
 
 	dir := t.TempDir()
 
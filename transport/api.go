@@ -105,17 +105,20 @@ type (
 // object stream //
 ///////////////////
 
-func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream) {
+func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (*Stream, error) {
 	if extra == nil {
 		extra = &Extra{Config: cmn.GCO.Get()}
 	} else if extra.Config == nil {
 		extra.Config = cmn.GCO.Get()
 	}
-	s = &Stream{streamBase: *newBase(client, dstURL, dstID, extra)}
+	s := &Stream{streamBase: *newBase(client, dstURL, dstID, extra)}
 	s.streamBase.streamer = s
 	s.callback = extra.Callback
 	if extra.Compressed() {
-		s.initCompression(extra)
+		if err := s.initCompression(extra); err != nil {
+			s.freeBufs()
+			return nil, err
+		}
 	}
 	debug.Assert(s.usePDU() == extra.UsePDU())
 
@@ -128,7 +131,7 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 	go s.cmplLoop()         // handle SCQ
 
 	gc.ctrlCh <- ctrl{&s.streamBase, true /* collect */}
-	return
+	return s, nil
 }
 
 // Asynchronously send an object (transport.Obj) defined by its header and its reader.
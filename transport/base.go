@@ -52,6 +52,7 @@ const (
 type (
 	streamer interface {
 		compressed() bool
+		compression() string // apc.LZ4Compression, etc.
 		dryrun()
 		terminate(error, string) (string, error)
 		doRequest() error
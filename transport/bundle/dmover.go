@@ -77,7 +77,7 @@ func NewDataMover(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Ex
 	switch extra.Compression {
 	case "":
 		dm.compression = apc.CompressNever
-	case apc.CompressAlways, apc.CompressNever:
+	case apc.CompressAlways, apc.CompressNever, apc.CompressZstd:
 		dm.compression = extra.Compression
 	default:
 		return nil, fmt.Errorf("invalid compression %q", extra.Compression)
@@ -10,6 +10,7 @@ import (
 	"sync"
 	ratomic "sync/atomic"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -99,9 +100,12 @@ func New(cl transport.Client, args Args) (sb *Streams) {
 	if sb.extra.Config == nil {
 		sb.extra.Config = cmn.GCO.Get()
 	}
-	if !sb.extra.Compressed() {
+	switch {
+	case !sb.extra.Compressed():
 		sb.lid = fmt.Sprintf("sb[%s-%s-%s]", core.T.SID(), sb.network, sb.trname)
-	} else {
+	case sb.extra.Compression == apc.CompressZstd:
+		sb.lid = fmt.Sprintf("sb[%s-%s-%s[%s]]", core.T.SID(), sb.network, sb.trname, apc.ZstdCompression)
+	default:
 		sb.lid = fmt.Sprintf("sb[%s-%s-%s[%s]]", core.T.SID(), sb.network, sb.trname,
 			cos.ToSizeIEC(int64(sb.extra.Config.Transport.LZ4BlockMaxSize), 0))
 	}
@@ -366,10 +370,22 @@ func (sb *Streams) Resync() {
 		}
 
 		dstURL := si.URL(sb.network) + transport.ObjURLPath(sb.trname) // direct destination URL
-		nrobin := &robin{stsdest: make(stsdest, sb.multiplier)}
-		for k := 0; k < sb.multiplier; k++ {
-			ns := transport.NewObjStream(sb.client, dstURL, id /*dstID*/, &sb.extra)
-			nrobin.stsdest[k] = ns
+		var (
+			nrobin = &robin{stsdest: make(stsdest, sb.multiplier)}
+			err    error
+		)
+		for k := 0; k < sb.multiplier && err == nil; k++ {
+			nrobin.stsdest[k], err = transport.NewObjStream(sb.client, dstURL, id /*dstID*/, &sb.extra)
+		}
+		if err != nil {
+			for _, ns := range nrobin.stsdest {
+				if ns != nil {
+					ns.Stop()
+				}
+			}
+			// (sending to this destination will fail - see Send)
+			nlog.Errorln(sb.String(), "=>", si.StringEx()+":", err)
+			continue
 		}
 		nbundle[id] = nrobin
 	}
@@ -377,7 +393,10 @@ func (sb *Streams) Resync() {
 		if id == core.T.SID() {
 			continue
 		}
-		orobin := nbundle[id]
+		orobin, ok := nbundle[id]
+		if !ok {
+			continue // (failed to open - see above)
+		}
 		for k := 0; k < sb.multiplier; k++ {
 			os := orobin.stsdest[k]
 			if !os.IsTerminated() {
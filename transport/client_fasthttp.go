@@ -68,7 +68,7 @@ func (s *streamBase) do(body io.Reader) (err error) {
 	req.SetRequestURI(s.dstURL)
 	req.SetBodyStream(body, -1)
 	if s.streamer.compressed() {
-		req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+		req.Header.Set(apc.HdrCompress, s.streamer.compression())
 	}
 	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
 	req.Header.Set(cos.HdrUserAgent, ua)
@@ -64,7 +64,7 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		return
 	}
 	if s.streamer.compressed() {
-		request.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+		request.Header.Set(apc.HdrCompress, s.streamer.compression())
 	}
 	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
 	request.Header.Set(cos.HdrUserAgent, ua)
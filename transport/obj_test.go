@@ -129,7 +129,10 @@ func Example_headers() {
 	defer ts.Close()
 
 	httpclient := transport.NewIntraDataClient()
-	stream := transport.NewObjStream(httpclient, ts.URL, cos.GenTie(), nil)
+	stream, err := transport.NewObjStream(httpclient, ts.URL, cos.GenTie(), nil)
+	if err != nil {
+		panic(err)
+	}
 
 	sendText(stream, lorem, duis)
 	stream.Fin()
@@ -210,7 +213,10 @@ func Example_obj() {
 		return
 	}
 	httpclient := transport.NewIntraDataClient()
-	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+	stream, err := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+	if err != nil {
+		panic(err)
+	}
 	sendText(stream, lorem, duis)
 	sendText(stream, et, temporibus)
 	stream.Fin()
@@ -298,7 +304,9 @@ func TestMultipleNetworks(t *testing.T) {
 
 		httpclient := transport.NewIntraDataClient()
 		url := ts.URL + transport.ObjURLPath(trname)
-		streams = append(streams, transport.NewObjStream(httpclient, url, cos.GenTie(), nil))
+		stream, err := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+		tassert.CheckFatal(t, err)
+		streams = append(streams, stream)
 	}
 
 	totalSend := int64(0)
@@ -335,7 +343,8 @@ func TestSendCallback(t *testing.T) {
 	defer transport.Unhandle(trname)
 	httpclient := transport.NewIntraDataClient()
 	url := ts.URL + transport.ObjURLPath(trname)
-	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+	stream, err := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+	tassert.CheckFatal(t, err)
 
 	var (
 		totalSend int64
@@ -411,7 +420,8 @@ func TestObjAttrs(t *testing.T) {
 	defer transport.Unhandle(trname)
 	httpclient := transport.NewIntraDataClient()
 	url := ts.URL + transport.ObjURLPath(trname)
-	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+	stream, err := transport.NewObjStream(httpclient, url, cos.GenTie(), nil)
+	tassert.CheckFatal(t, err)
 
 	random := newRand(mono.NanoTime())
 	for idx, attrs := range testAttrs {
@@ -470,7 +480,8 @@ func TestCompressedOne(t *testing.T) {
 	httpclient := transport.NewIntraDataClient()
 	url := ts.URL + transport.ObjURLPath(trname)
 	t.Setenv("AIS_STREAM_BURST_NUM", "2")
-	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{Compression: apc.CompressAlways})
+	stream, err := transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{Compression: apc.CompressAlways})
+	tassert.CheckFatal(t, err)
 
 	slab, _ := memsys.PageMM().GetSlab(memsys.MaxPageSlabSize)
 	random := newRand(mono.NanoTime())
@@ -517,12 +528,24 @@ func TestCompressedOne(t *testing.T) {
 	printNetworkStats()
 }
 
+func TestZstdWindow(t *testing.T) {
+	for _, size := range []int64{0, 512, 3 * cos.KiB, cos.MiB + 1} {
+		config := &cmn.Config{}
+		config.Transport.LZ4BlockMaxSize = cos.SizeIEC(size)
+		extra := &transport.Extra{Compression: apc.CompressZstd, Config: config}
+		if _, err := transport.NewObjStream(nil, "dummy/null", cos.GenTie(), extra); err == nil {
+			t.Errorf("expected invalid zstd window %d to fail", size)
+		}
+	}
+}
+
 func TestDryRun(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
 	t.Setenv("AIS_STREAM_DRY_RUN", "true")
 
-	stream := transport.NewObjStream(nil, "dummy/null", cos.GenTie(), nil)
+	stream, err := transport.NewObjStream(nil, "dummy/null", cos.GenTie(), nil)
+	tassert.CheckFatal(t, err)
 
 	random := newRand(mono.NanoTime())
 	sgl := memsys.PageMM().NewSGL(cos.MiB)
@@ -590,7 +613,8 @@ func TestCompletionCount(t *testing.T) {
 	httpclient := transport.NewIntraDataClient()
 	url := ts.URL + transport.ObjURLPath(trname)
 	t.Setenv("AIS_STREAM_BURST_NUM", "256")
-	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), nil) // provide for sizeable queue at any point
+	stream, err := transport.NewObjStream(httpclient, url, cos.GenTie(), nil) // provide for sizeable queue at any point
+	tassert.CheckFatal(t, err)
 	random := newRand(mono.NanoTime())
 	rem := int64(0)
 	for idx := 0; idx < 10000; idx++ {
@@ -661,7 +685,8 @@ func streamWriteUntil(t *testing.T, ii int, wg *sync.WaitGroup, ts *httptest.Ser
 			extra.SizePDU = memsys.DefaultBufSize
 		}
 	}
-	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), extra)
+	stream, err := transport.NewObjStream(httpclient, url, cos.GenTie(), extra)
+	tassert.CheckFatal(t, err)
 	trname, sessID := stream.ID()
 	now := time.Now()
 
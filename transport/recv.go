@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/OneOfOne/xxhash"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -86,10 +87,11 @@ var (
 // main Rx objects
 func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	var (
-		reader    io.Reader = r.Body
-		lz4Reader *lz4.Reader
-		trname    = path.Base(r.URL.Path)
-		mm        = memsys.PageMM()
+		reader     io.Reader = r.Body
+		lz4Reader  *lz4.Reader
+		zstdReader *zstd.Decoder
+		trname     = path.Base(r.URL.Path)
+		mm         = memsys.PageMM()
 	)
 	// Rx handler
 	h, err := oget(trname)
@@ -112,10 +114,22 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// compression
-	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
-		debug.Assert(compressionType == apc.LZ4Compression)
+	switch compressionType := r.Header.Get(apc.HdrCompress); compressionType {
+	case "":
+	case apc.LZ4Compression:
 		lz4Reader = lz4.NewReader(r.Body)
 		reader = lz4Reader
+	case apc.ZstdCompression:
+		// NOTE: synchronous (single-threaded) decoding that does not read ahead; see also zStream.initZstd
+		zstdReader, err = zstd.NewReader(r.Body, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			cmn.WriteErr(w, r, err)
+			return
+		}
+		reader = zstdReader
+	default:
+		cmn.WriteErr(w, r, fmt.Errorf("%s: unknown compression %q", trname, compressionType))
+		return
 	}
 
 	stats, uid, loghdr := h.stats(r, trname)
@@ -129,6 +143,9 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	if lz4Reader != nil {
 		lz4Reader.Reset(nil)
 	}
+	if zstdReader != nil {
+		zstdReader.Close()
+	}
 	if it.pdu != nil {
 		it.pdu.free(mm)
 	}
@@ -10,11 +10,13 @@ import (
 	"io"
 	"runtime"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -25,15 +27,22 @@ type (
 		cmplCh   chan cmpl // aka SCQ; note that SQ and SCQ together form a FIFO
 		callback ObjSentCB // to free SGLs, close files, etc.
 		sendoff  sendoff
-		lz4s     lz4Stream
+		zs       zStream
 		streamBase
 	}
-	lz4Stream struct {
+	// compressed stream: lz4 (default) or zstd
+	zStream struct {
 		s             *Stream
-		zw            *lz4.Writer // orig reader => zw
+		zw            compressor  // orig reader => zw
 		sgl           *memsys.SGL // zw => bb => network
-		blockMaxSize  int         // *uncompressed* block max size
-		frameChecksum bool        // true: checksum lz4 frames
+		ctype         string      // apc.LZ4Compression, etc.
+		blockMaxSize  int         // lz4: *uncompressed* block max size
+		frameChecksum bool        // lz4: true - checksum lz4 frames
+	}
+	compressor interface {
+		io.Writer
+		Flush() error
+		Reset(io.Writer)
 	}
 	sendoff struct {
 		obj Obj
@@ -47,7 +56,11 @@ type (
 )
 
 // interface guard
-var _ streamer = (*Stream)(nil)
+var (
+	_ streamer   = (*Stream)(nil)
+	_ compressor = (*lz4.Writer)(nil)
+	_ compressor = (*zstd.Encoder)(nil)
+)
 
 ///////////////////
 // object stream //
@@ -76,32 +89,48 @@ func (s *Stream) terminate(err error, reason string) (actReason string, actErr e
 	gc.remove(&s.streamBase)
 
 	if s.compressed() {
-		s.lz4s.sgl.Free()
-		if s.lz4s.zw != nil {
-			s.lz4s.zw.Reset(nil)
+		s.zs.sgl.Free()
+		if s.zs.zw != nil {
+			s.zs.zw.Reset(nil)
 		}
 	}
 	return
 }
 
-func (s *Stream) initCompression(extra *Extra) {
-	s.lz4s.s = s
-	s.lz4s.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
-	s.lz4s.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
-	if s.lz4s.blockMaxSize >= memsys.MaxPageSlabSize {
-		s.lz4s.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+func (s *Stream) initCompression(extra *Extra) error {
+	s.zs.s = s
+	s.zs.ctype = apc.LZ4Compression
+	if extra.Compression == apc.CompressZstd {
+		s.zs.ctype = apc.ZstdCompression
+	}
+	// (zstd: same configured block size doubles as the compression window)
+	s.zs.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
+	s.zs.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
+	if s.zs.ctype == apc.ZstdCompression {
+		if err := s.zs.initZstd(); err != nil {
+			return fmt.Errorf("%s[%d]: %w", s.trname, s.sessID, err)
+		}
+	}
+	if s.zs.blockMaxSize >= memsys.MaxPageSlabSize {
+		s.zs.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+	} else {
+		s.zs.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
+	}
+	if s.zs.ctype == apc.ZstdCompression {
+		s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, s.zs.ctype)
 	} else {
-		s.lz4s.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
+		s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cos.ToSizeIEC(int64(s.zs.blockMaxSize), 0))
 	}
-	s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cos.ToSizeIEC(int64(s.lz4s.blockMaxSize), 0))
+	return nil
 }
 
-func (s *Stream) compressed() bool { return s.lz4s.s == s }
-func (s *Stream) usePDU() bool     { return s.pdu != nil }
+func (s *Stream) compressed() bool    { return s.zs.s == s }
+func (s *Stream) compression() string { return s.zs.ctype }
+func (s *Stream) usePDU() bool        { return s.pdu != nil }
 
 func (s *Stream) resetCompression() {
-	s.lz4s.sgl.Reset()
-	s.lz4s.zw.Reset(nil)
+	s.zs.sgl.Reset()
+	s.zs.zw.Reset(nil)
 }
 
 func (s *Stream) cmplLoop() {
@@ -161,17 +190,19 @@ func (s *Stream) doRequest() error {
 	if !s.compressed() {
 		return s.do(s)
 	}
-	s.lz4s.sgl.Reset()
-	if s.lz4s.zw == nil {
-		s.lz4s.zw = lz4.NewWriter(s.lz4s.sgl)
+	s.zs.sgl.Reset()
+	if s.zs.zw == nil {
+		s.zs.zw = lz4.NewWriter(s.zs.sgl) // (zstd encoder is created upfront - see initZstd)
 	} else {
-		s.lz4s.zw.Reset(s.lz4s.sgl)
+		s.zs.zw.Reset(s.zs.sgl)
 	}
-	// lz4 framing spec at http://fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
-	s.lz4s.zw.Header.BlockChecksum = false
-	s.lz4s.zw.Header.NoChecksum = !s.lz4s.frameChecksum
-	s.lz4s.zw.Header.BlockMaxSize = s.lz4s.blockMaxSize
-	return s.do(&s.lz4s)
+	if lzw, ok := s.zs.zw.(*lz4.Writer); ok {
+		// lz4 framing spec at http://fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
+		lzw.Header.BlockChecksum = false
+		lzw.Header.NoChecksum = !s.zs.frameChecksum
+		lzw.Header.BlockMaxSize = s.zs.blockMaxSize
+	}
+	return s.do(&s.zs)
 }
 
 // as io.Reader
@@ -385,7 +416,10 @@ func (s *Stream) drain(err error) {
 func (s *Stream) closeAndFree() {
 	close(s.workCh)
 	close(s.cmplCh)
+	s.freeBufs()
+}
 
+func (s *Stream) freeBufs() {
 	g.mm.Free(s.maxhdr)
 	if s.pdu != nil {
 		s.pdu.free(g.mm)
@@ -412,48 +446,66 @@ func (stats *Stats) CompressionRatio() float64 {
 	return float64(bytesRead) / float64(bytesSent)
 }
 
-///////////////
-// lz4Stream //
-///////////////
+/////////////
+// zStream //
+/////////////
+
+// validate the window size and create the encoder (to be reset with each new request)
+func (zs *zStream) initZstd() error {
+	if w := zs.blockMaxSize; w < zstd.MinWindowSize || w > zstd.MaxWindowSize || w&(w-1) != 0 {
+		return fmt.Errorf("invalid zstd window size %d (expecting power of two in the range [%s, %s])", w,
+			cos.ToSizeIEC(zstd.MinWindowSize, 0), cos.ToSizeIEC(zstd.MaxWindowSize, 0))
+	}
+	// NOTE:
+	// - single-threaded (synchronous) encoding; no goroutines to clean up
+	// - bounded window (default 8MiB times multiplier times num-targets adds up on both sides)
+	zw, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithWindowSize(zs.blockMaxSize),
+		zstd.WithLowerEncoderMem(true))
+	if err != nil {
+		return err
+	}
+	zs.zw = zw
+	return nil
+}
 
-func (lz4s *lz4Stream) Read(b []byte) (n int, err error) {
+func (zs *zStream) Read(b []byte) (n int, err error) {
 	var (
-		sendoff = &lz4s.s.sendoff
+		sendoff = &zs.s.sendoff
 		last    = sendoff.obj.Hdr.isFin()
-		retry   = maxInReadRetries // insist on returning n > 0 (note that lz4 compresses /blocks/)
+		retry   = maxInReadRetries // insist on returning n > 0 (note that both lz4 and zstd compress /blocks/)
 	)
-	if lz4s.sgl.Len() > 0 {
-		lz4s.zw.Flush()
-		n, err = lz4s.sgl.Read(b)
+	if zs.sgl.Len() > 0 {
+		zs.zw.Flush()
+		n, err = zs.sgl.Read(b)
 		if err == io.EOF { // reusing/rewinding this buf multiple times
 			err = nil
 		}
 		goto ex
 	}
 re:
-	n, err = lz4s.s.Read(b)
-	_, _ = lz4s.zw.Write(b[:n])
+	n, err = zs.s.Read(b)
+	_, _ = zs.zw.Write(b[:n])
 	if last {
-		lz4s.zw.Flush()
+		zs.zw.Flush()
 		retry = 0
-	} else if lz4s.s.sendoff.ins == inEOB || err != nil {
-		lz4s.zw.Flush()
+	} else if zs.s.sendoff.ins == inEOB || err != nil {
+		zs.zw.Flush()
 		retry = 0
 	}
-	n, _ = lz4s.sgl.Read(b)
+	n, _ = zs.sgl.Read(b)
 	if n == 0 {
 		if retry > 0 {
 			retry--
 			runtime.Gosched()
 			goto re
 		}
-		lz4s.zw.Flush()
-		n, _ = lz4s.sgl.Read(b)
+		zs.zw.Flush()
+		n, _ = zs.sgl.Read(b)
 	}
 ex:
-	lz4s.s.stats.CompressedSize.Add(int64(n))
-	if lz4s.sgl.Len() == 0 {
-		lz4s.sgl.Reset()
+	zs.s.stats.CompressedSize.Add(int64(n))
+	if zs.sgl.Len() == 0 {
+		zs.sgl.Reset()
 	}
 	if last && err == nil {
 		err = io.EOF
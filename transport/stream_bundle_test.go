@@ -90,6 +90,21 @@ func TestBundle(t *testing.T) {
 					"unsized":     "yes",
 				},
 			},
+			{
+				name: "compress-zstd",
+				nvs: cos.StrKVs{
+					"compression": apc.CompressZstd,
+					"block":       "256KiB",
+				},
+			},
+			{
+				name: "compress-zstd-unsized",
+				nvs: cos.StrKVs{
+					"compression": apc.CompressZstd,
+					"block":       "256KiB",
+					"unsized":     "yes",
+				},
+			},
 		}
 		tests = append(tests, testsLong...)
 	}
@@ -133,6 +133,9 @@ var Table = map[string]Descriptor{
 	// single target (node)
 	apc.ActResilver: {Scope: ScopeT, Startable: true, Mountpath: true, Resilver: true},
 
+	// asynchronous (write-back) PUT to remote backends
+	apc.ActWriteBack: {Scope: ScopeT, Startable: false, Idles: true},
+
 	// on-demand EC and n-way replication
 	// (non-startable, triggered by PUT => erasure-coded or mirrored bucket)
 	apc.ActECGet:     {Scope: ScopeB, Startable: false, Idles: true, ExtendedStats: true},
@@ -143,7 +146,8 @@ var Table = map[string]Descriptor{
 	//
 	// on-demand multi-object (consider setting ConflictRebRes = true)
 	//
-	apc.ActArchive: {Scope: ScopeB, Access: apc.AccessRW, Startable: false, RefreshCap: true, Idles: true},
+	apc.ActArchive:  {Scope: ScopeB, Access: apc.AccessRW, Startable: false, RefreshCap: true, Idles: true},
+	apc.ActGetBatch: {Scope: ScopeG, Access: apc.AceGET, Startable: false, Idles: true},
 	apc.ActCopyObjects: {
 		DisplayName: "copy-objects",
 		Scope:       ScopeB,
@@ -221,6 +225,24 @@ var Table = map[string]Descriptor{
 		Mountpath:      true,
 		ConflictRebRes: true,
 	},
+	apc.ActECReencode: {
+		DisplayName:    "ec-reencode",
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      true,
+		Owned:          false,
+		RefreshCap:     true,
+		Mountpath:      true,
+		ConflictRebRes: true,
+	},
+	apc.ActECScrub: {
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      true,
+		Owned:          false,
+		Mountpath:      true,
+		ConflictRebRes: true,
+	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
 		Scope:       ScopeB,
@@ -268,7 +290,24 @@ var Table = map[string]Descriptor{
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Owned: true, Idles: true},
 
 	// cache management, internal usage
-	apc.ActLoadLomCache:   {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true, Mountpath: true},
+	apc.ActLoadLomCache: {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true, Mountpath: true},
+
+	// periodic (and startable) bucket lifecycle enforcement
+	apc.ActLifecycle: {
+		DisplayName: "lifecycle",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   true,
+		RefreshCap:  true,
+		Mountpath:   true,
+	},
+	apc.ActTierMigrate: {
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      true,
+		Mountpath:      true,
+		ConflictRebRes: true,
+	},
 	apc.ActInvalListCache: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false},
 }
 
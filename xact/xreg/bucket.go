@@ -5,6 +5,8 @@
 package xreg
 
 import (
+	"time"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -43,6 +45,11 @@ type (
 		Tag    string
 		Copies int
 	}
+	LcycleArgs struct {
+		// abort multipart uploads (of a given bucket, under a given prefix)
+		// that were initiated prior to `olderThan`; returns the number aborted
+		AbortMpt func(bck *meta.Bck, prefix string, olderThan time.Duration) int
+	}
 )
 
 //////////////
@@ -67,6 +74,14 @@ func RenewECEncode(bck *meta.Bck, uuid, phase string) RenewRes {
 	return RenewBucketXact(apc.ActECEncode, bck, Args{Custom: &ECEncodeArgs{Phase: phase}, UUID: uuid})
 }
 
+func RenewECReencode(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActECReencode, bck, Args{UUID: uuid})
+}
+
+func RenewECScrub(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActECScrub, bck, Args{UUID: uuid})
+}
+
 func RenewMakeNCopies(uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -110,6 +125,14 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+func RenewLifecycle(uuid string, bck *meta.Bck, args *LcycleArgs) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewTierMigrate(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActTierMigrate, bck, Args{UUID: uuid})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
@@ -54,6 +54,16 @@ func RenewETL(msg any, xid string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewWriteBack() RenewRes {
+	e := dreg.nonbckXacts[apc.ActWriteBack].New(Args{}, nil)
+	return dreg.renew(e, nil)
+}
+
+func RenewGetBatch() RenewRes {
+	e := dreg.nonbckXacts[apc.ActGetBatch].New(Args{}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewBckSummary(bck *meta.Bck, msg *apc.BsummCtrlMsg) RenewRes {
 	e := dreg.nonbckXacts[apc.ActSummaryBck].New(Args{UUID: msg.UUID, Custom: msg}, bck)
 	return dreg.renew(e, bck)
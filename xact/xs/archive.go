@@ -431,6 +431,9 @@ func (r *XactArch) Snap() (snap *core.Snap) {
 
 func (wi *archwi) beginAppend() (lmfh *os.File, err error) {
 	msg := wi.msg
+	if wi.archlom.Load(false /*cache it*/, false /*locked*/) == nil && wi.archlom.IsEncrypted() {
+		return nil, cmn.NewErrUnsupp("append to encrypted archive", wi.archlom.Cname())
+	}
 	if msg.Mime == archive.ExtTar {
 		if err = wi.openTarForAppend(); err == nil || err != archive.ErrTarIsEmpty {
 			return
@@ -503,7 +506,7 @@ func (wi *archwi) do(lom *core.LOM, lrit *lriterator) {
 		}
 	}
 
-	fh, err := cos.NewFileHandle(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err != nil {
 		wi.r.AddErr(err, 5, cos.SmoduleXs)
 		return
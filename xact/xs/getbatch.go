@@ -0,0 +1,662 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// GET-batch (apc.ActGetBatch)
+//
+// - proxy redirects the request to a randomly selected (designated) target (DT)
+// - DT registers the request with its x-get-batch (below) and asks all other targets
+//   to start sending the entries they own (HRW-wise)
+// - DT then assembles the output - one entry at a time - by reading local entries
+//   and by waiting for the remote ones
+// - remote entries are received via the (shared, cluster-wide) x-get-batch transport endpoint
+//   and (if need be) buffered until the time to emit them
+// - entries' ownership is determined by DT's Smap; targets that have a different Smap version
+//   (e.g., in the middle of a cluster membership change) refuse the request, and DT fails it
+// - buffering is bounded: when received (and not yet emitted) remote content exceeds
+//   gbMaxBuffered, or memory pressure gets extreme, DT fails the request
+//
+// Each entry may produce zero or more data messages followed by exactly one terminating
+// message: opcodeDone or opcodeAbrt (the latter carries error string)
+
+const (
+	gbSepa        = "|" // opaque: request ID | entry index
+	gbMaxBuffered = cos.GiB
+)
+
+type (
+	gbFactory struct {
+		streamingF
+	}
+	XactGetBatch struct {
+		streamingX
+		pending struct {
+			m map[string]*GetBatchWI
+			sync.RWMutex
+		}
+	}
+	// DT's work item
+	GetBatchWI struct {
+		r      *XactGetBatch
+		msg    *apc.GetBatchMsg
+		defBck *cmn.Bck
+		aw     archive.Writer
+		slots  []gbslot
+		rxCh   chan int // remote entry index: all received
+		stopCh *cos.StopCh
+		errBuf error // why stopped
+		id     string
+		nrem   int // number of remote entries
+		cnt    int
+		size   int64
+		bufd   atomic.Int64 // received and not yet emitted
+		failed atomic.Bool
+	}
+	gbslot struct {
+		tsi   *meta.Snode // (remote) owner; nil when local
+		recvd []gbrecv
+		err   error
+	}
+	gbrecv struct {
+		sgl  *memsys.SGL
+		name string
+		oa   cmn.ObjAttrs
+	}
+
+	// emit entry's content: write it to the output (DT) or send it to DT (all other targets)
+	gbEmitter interface {
+		emit(name string, oah cos.OAH, r io.Reader) error                 // synchronous (consumes `r`)
+		emitObj(name string, lom *core.LOM, roc cos.ReadOpenCloser) error // takes ownership of `roc`
+	}
+	gbsend struct {
+		r   *XactGetBatch
+		tsi *meta.Snode
+		id  string
+		idx int
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactGetBatch)(nil)
+	_ xreg.Renewable = (*gbFactory)(nil)
+	_ gbEmitter      = (*GetBatchWI)(nil)
+	_ gbEmitter      = (*gbsend)(nil)
+)
+
+///////////////
+// gbFactory //
+///////////////
+
+func (*gbFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &gbFactory{streamingF: streamingF{RenewBase: xreg.RenewBase{Args: args}, kind: apc.ActGetBatch}}
+}
+
+func (p *gbFactory) Start() error {
+	r := &XactGetBatch{streamingX: streamingX{p: &p.streamingF, config: cmn.GCO.Get()}}
+	r.pending.m = make(map[string]*GetBatchWI, 16)
+	p.xctn = r
+	r.DemandBase.Init(cos.GenUUID(), p.kind, nil, xact.IdleDefault)
+
+	// NOTE: one x-get-batch per target at any given time - hence, constant trname
+	if err := p.newDM(apc.ActGetBatch /*trname*/, r.recv, r.config, cmn.OwtGet, 0 /*pdu*/); err != nil {
+		return err
+	}
+	if r.p.dm != nil {
+		r.p.dm.SetXact(r)
+		r.p.dm.Open()
+	}
+	xact.GoRunW(r)
+	return nil
+}
+
+//////////////////
+// XactGetBatch //
+//////////////////
+
+func (r *XactGetBatch) Run(wg *sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	wg.Done()
+	select {
+	case <-r.IdleTimer():
+	case <-r.ChanAbort():
+	}
+	r.streamingX.fin(false /*unreg Rx*/)
+	// unregister right away (compare w/ streamingX.wurr) to make room for the next one
+	r.p.dm.UnregRecv()
+
+	r.pending.Lock()
+	clear(r.pending.m)
+	r.pending.Unlock()
+}
+
+// DT: register new work item prior to asking other targets to send
+func (r *XactGetBatch) NewWI(id string, msg *apc.GetBatchMsg, defBck *cmn.Bck, smap *meta.Smap) *GetBatchWI {
+	var (
+		wi = &GetBatchWI{r: r, msg: msg, defBck: defBck, id: id, slots: make([]gbslot, len(msg.Entries))}
+	)
+	for i := range msg.Entries {
+		tsi, err := gbOwner(&msg.Entries[i], defBck, smap)
+		switch {
+		case err != nil:
+			wi.slots[i].err = err
+		case tsi.ID() != core.T.SID():
+			wi.slots[i].tsi = tsi
+			wi.nrem++
+		}
+	}
+	wi.rxCh = make(chan int, wi.nrem)
+	wi.stopCh = cos.NewStopCh()
+
+	r.IncPending()
+	r.pending.Lock()
+	r.pending.m[id] = wi
+	r.wiCnt.Inc()
+	r.pending.Unlock()
+	return wi
+}
+
+// all other targets: send locally owned entries to DT
+// (the caller makes sure that `smap` is the same version DT used - see NewWI)
+func (r *XactGetBatch) Send(id string, msg *apc.GetBatchMsg, defBck *cmn.Bck, smap *meta.Smap, tsi *meta.Snode) {
+	if r.p.dm == nil {
+		debug.Assert(false) // single target
+		return
+	}
+	r.IncPending()
+	go r.send(id, msg, defBck, smap, tsi)
+}
+
+func (r *XactGetBatch) send(id string, msg *apc.GetBatchMsg, defBck *cmn.Bck, smap *meta.Smap, tsi *meta.Snode) {
+	for i := range msg.Entries {
+		if r.IsAborted() {
+			break
+		}
+		e := &msg.Entries[i]
+		owner, err := gbOwner(e, defBck, smap)
+		if err != nil || owner.ID() != core.T.SID() {
+			continue // (DT takes care of init errors)
+		}
+		snd := &gbsend{r: r, tsi: tsi, id: id, idx: i}
+		err = r.do(e, defBck, msg.InclSrcBname, snd)
+		snd.term(err)
+	}
+	r.DecPending()
+}
+
+// read (cold-GET if need be) a single entry and emit its content
+func (r *XactGetBatch) do(e *apc.GetBatchEntry, defBck *cmn.Bck, isbn bool, emitter gbEmitter) error {
+	lom := core.AllocLOM(e.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(gbBck(e, defBck)); err != nil {
+		return err
+	}
+	if err := gbLoad(lom); err != nil {
+		return err
+	}
+	name := gbName(e, lom.Bucket(), "", isbn)
+	if !e.IsArch() {
+		roc, err := lom.NewDeferROC() // keeping rlock until roc is closed (unlocks on fail)
+		if err != nil {
+			return err
+		}
+		return emitter.emitObj(name, lom, roc)
+	}
+
+	// shard (read synchronously, under rlock)
+	defer lom.Unlock(false)
+	fh, err := lom.NewHandle()
+	if err != nil {
+		return err
+	}
+	defer cos.Close(fh)
+	mime, err := archive.MimeFile(fh, core.T.PageMM(), "", lom.ObjName)
+	if err != nil {
+		return err
+	}
+	ar, err := archive.NewReader(mime, fh, lom.SizeBytes())
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", lom.Cname(), err)
+	}
+	if e.ArchPath != "" {
+		csl, err := ar.Range(e.ArchPath, nil)
+		if err != nil {
+			return cmn.NewErrFailedTo(core.T, "extract "+e.ArchPath+" from", lom, err)
+		}
+		if csl == nil {
+			return cos.NewErrNotFound(core.T, e.ArchPath+" in "+lom.Cname())
+		}
+		oa := cmn.ObjAttrs{Size: csl.Size(), Atime: lom.AtimeUnix()}
+		err = emitter.emit(gbName(e, lom.Bucket(), e.ArchPath, isbn), &oa, csl)
+		csl.Close()
+		return err
+	}
+
+	// sample (WebDataset): all archived files that share the same key
+	var n int
+	_, err = ar.Range("", func(filename string, reader cos.ReadCloseSizer, _ any) (bool, error) {
+		if !IsSampleMember(filename, e.SampleKey) {
+			return false, nil
+		}
+		n++
+		oa := cmn.ObjAttrs{Size: reader.Size(), Atime: lom.AtimeUnix()}
+		err := emitter.emit(gbName(e, lom.Bucket(), filename, isbn), &oa, reader)
+		reader.Close()
+		return err != nil, err
+	})
+	if err == nil && n == 0 {
+		err = cos.NewErrNotFound(core.T, "sample "+e.SampleKey+" in "+lom.Cname())
+	}
+	return err
+}
+
+// load (cold-GET if need be) and rlock; on success, the caller must unlock
+func gbLoad(lom *core.LOM) error {
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err == nil {
+		return nil
+	}
+	lom.Unlock(false)
+	if !cos.IsNotExist(err, 0) || !lom.Bck().IsRemote() {
+		return err
+	}
+	if _, err := core.T.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	return nil
+}
+
+func (r *XactGetBatch) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+	if err != nil && !cos.IsEOF(err) {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return err
+	}
+	err = r._recv(hdr, objReader)
+	transport.DrainAndFreeReader(objReader)
+	return err
+}
+
+func (r *XactGetBatch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
+	id, idx, err := gbUnpack(hdr.Opaque)
+	if err != nil {
+		return err
+	}
+	// NOTE: holding rlock to prevent cleanup from racing with buffering (below)
+	r.pending.RLock()
+	defer r.pending.RUnlock()
+	wi, ok := r.pending.m[id]
+	if !ok {
+		return nil // (e.g., failed or timed-out request)
+	}
+	if idx >= len(wi.slots) || wi.slots[idx].tsi == nil {
+		return fmt.Errorf("%s: unexpected entry %d from %s", r, idx, hdr.SID)
+	}
+	slot := &wi.slots[idx]
+	switch hdr.Opcode {
+	case opcodeDone:
+		wi.rxCh <- idx
+	case opcodeAbrt:
+		slot.err = errors.New(hdr.ObjName)
+		wi.rxCh <- idx
+	default:
+		if wi.failed.Load() {
+			return nil
+		}
+		mm := core.T.PageMM()
+		if err := wi.checkBuf(mm, hdr.ObjAttrs.Size); err != nil {
+			wi.stop(err)
+			return nil
+		}
+		sgl := mm.NewSGL(hdr.ObjAttrs.Size)
+		if _, err := io.Copy(sgl, objReader); err != nil {
+			sgl.Free()
+			return err
+		}
+		wi.bufd.Add(sgl.Size())
+		slot.recvd = append(slot.recvd, gbrecv{sgl: sgl, name: hdr.ObjName, oa: hdr.ObjAttrs})
+	}
+	return nil
+}
+
+func (r *XactGetBatch) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+////////////////
+// GetBatchWI //
+////////////////
+
+// assemble and write the output
+// returns the number of bytes written to `w` - the caller may still be able to
+// report (non-nil) error when nothing's been written
+func (wi *GetBatchWI) Do(w io.Writer) (int64, error) {
+	var (
+		wc   = &gbCounter{w: w}
+		mime = archive.ExtTar
+	)
+	if wi.msg.Mime != "" {
+		m, err := archive.Mime(wi.msg.Mime, "")
+		if err != nil {
+			wi.cleanup()
+			return 0, err
+		}
+		mime = m
+	}
+	wi.aw = archive.NewWriter(mime, wc, nil /*checksum*/, nil /*opts*/)
+	err := wi.do()
+	wi.aw.Fini()
+	wi.cleanup()
+	return wc.n, err
+}
+
+func (wi *GetBatchWI) do() error {
+	if wi.msg.Unordered {
+		for i := range wi.slots {
+			if wi.slots[i].tsi == nil {
+				if err := wi.local(i); err != nil {
+					return err
+				}
+			}
+		}
+		for k := 0; k < wi.nrem; k++ {
+			idx, err := wi.wait()
+			if err != nil {
+				return err
+			}
+			if err := wi.remote(idx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// in order
+	rxed := make([]bool, len(wi.slots))
+	for i := range wi.slots {
+		if wi.slots[i].tsi == nil {
+			if err := wi.local(i); err != nil {
+				return err
+			}
+			continue
+		}
+		for !rxed[i] {
+			idx, err := wi.wait()
+			if err != nil {
+				return err
+			}
+			rxed[idx] = true
+		}
+		if err := wi.remote(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wait for the next remote entry to arrive in its entirety
+func (wi *GetBatchWI) wait() (idx int, err error) {
+	timeout := cmn.Rom.MaxKeepalive() + wi.r.config.Timeout.SendFile.D()
+	select {
+	case idx = <-wi.rxCh:
+	case <-wi.stopCh.Listen():
+		err = wi.errBuf
+	case <-wi.r.ChanAbort():
+		err = wi.r.AbortErr()
+	case <-time.After(timeout):
+		err = fmt.Errorf("%s: timed out waiting for remote entries", wi)
+	}
+	return
+}
+
+func (wi *GetBatchWI) local(i int) (err error) {
+	if err = wi.slots[i].err; err == nil {
+		err = wi.r.do(&wi.msg.Entries[i], wi.defBck, wi.msg.InclSrcBname, wi)
+	}
+	return wi.missing(i, err)
+}
+
+func (wi *GetBatchWI) remote(i int) error {
+	slot := &wi.slots[i]
+	for j := range slot.recvd {
+		rx := &slot.recvd[j]
+		if err := wi.emit(rx.name, &rx.oa, rx.sgl); err != nil {
+			return err
+		}
+		wi.bufd.Sub(rx.sgl.Size())
+		rx.sgl.Free()
+		rx.sgl = nil
+	}
+	slot.recvd = nil
+	return wi.missing(i, slot.err)
+}
+
+// limit the memory used to buffer remote entries
+func (wi *GetBatchWI) checkBuf(mm *memsys.MMSA, size int64) error {
+	if bufd := wi.bufd.Load(); bufd+size > gbMaxBuffered {
+		return fmt.Errorf("%s: buffered remote entries exceed %s (buffered %s, next %s)", wi,
+			cos.ToSizeIEC(gbMaxBuffered, 0), cos.ToSizeIEC(bufd, 1), cos.ToSizeIEC(size, 1))
+	}
+	if mm.Pressure() >= memsys.PressureExtreme {
+		return fmt.Errorf("%s: cannot buffer remote entries: %s", wi, mm.String())
+	}
+	return nil
+}
+
+// fail the request (see wi.wait)
+func (wi *GetBatchWI) stop(err error) {
+	if wi.failed.CAS(false, true) {
+		wi.errBuf = err
+		wi.stopCh.Close()
+		nlog.Errorln(err)
+	}
+}
+
+// (not found | failed to read) => placeholder or error, depending on `ContinueOnError`
+func (wi *GetBatchWI) missing(i int, err error) error {
+	if err == nil {
+		return nil
+	}
+	if !wi.msg.ContinueOnError {
+		return err
+	}
+	var (
+		e    = &wi.msg.Entries[i]
+		bck  = gbBck(e, wi.defBck)
+		name = apc.GetBatchMissing + gbName(e, bck, e.ArchPath+e.SampleKey, wi.msg.InclSrcBname)
+	)
+	if cmn.Rom.FastV(4, cos.SmoduleXs) {
+		nlog.Infoln(wi.String(), "missing", name, "err:", err)
+	}
+	return wi.aw.Write(name, &cmn.ObjAttrs{}, strings.NewReader(""))
+}
+
+func (wi *GetBatchWI) emit(name string, oah cos.OAH, r io.Reader) error {
+	if err := wi.aw.Write(name, oah, r); err != nil {
+		return err
+	}
+	wi.cnt++
+	wi.size += oah.SizeBytes()
+	wi.r.ObjsAdd(1, oah.SizeBytes())
+	return nil
+}
+
+func (wi *GetBatchWI) emitObj(name string, lom *core.LOM, roc cos.ReadOpenCloser) error {
+	err := wi.emit(name, lom, roc)
+	cos.Close(roc)
+	return err
+}
+
+// when failing to engage other targets
+func (wi *GetBatchWI) Discard() { wi.cleanup() }
+
+func (wi *GetBatchWI) cleanup() {
+	r := wi.r
+	r.pending.Lock()
+	delete(r.pending.m, wi.id)
+	r.wiCnt.Dec()
+	// free remaining (received but not emitted) content, if any
+	for i := range wi.slots {
+		for _, rx := range wi.slots[i].recvd {
+			if rx.sgl != nil {
+				rx.sgl.Free()
+			}
+		}
+	}
+	r.pending.Unlock()
+	r.DecPending()
+}
+
+func (wi *GetBatchWI) String() string {
+	return fmt.Sprintf("%s[%s, n=%d]", wi.r.Name(), wi.id, len(wi.slots))
+}
+
+////////////
+// gbsend //
+////////////
+
+func (snd *gbsend) emit(name string, oah cos.OAH, r io.Reader) error {
+	sgl := core.T.PageMM().NewSGL(oah.SizeBytes())
+	if _, err := io.Copy(sgl, r); err != nil {
+		sgl.Free()
+		return err
+	}
+	o := transport.AllocSend()
+	o.Hdr.ObjName = name
+	o.Hdr.ObjAttrs.Size = sgl.Size()
+	o.Hdr.ObjAttrs.Atime = oah.AtimeUnix()
+	o.Hdr.Opaque = gbPack(snd.id, snd.idx)
+	o.Callback, o.CmplArg = freeSGL, sgl
+	return snd.r.p.dm.Send(o, memsys.NewReader(sgl), snd.tsi)
+}
+
+func (snd *gbsend) emitObj(name string, lom *core.LOM, roc cos.ReadOpenCloser) error {
+	o := transport.AllocSend()
+	o.Hdr.ObjName = name
+	o.Hdr.ObjAttrs.CopyFrom(lom.ObjAttrs(), false /*skip cksum*/)
+	o.Hdr.Opaque = gbPack(snd.id, snd.idx)
+	return snd.r.p.dm.Send(o, roc, snd.tsi) // (closes roc)
+}
+
+func (snd *gbsend) term(err error) {
+	o := transport.AllocSend()
+	o.Hdr.SID = core.T.SID()
+	o.Hdr.Opaque = gbPack(snd.id, snd.idx)
+	if err == nil {
+		o.Hdr.Opcode = opcodeDone
+	} else {
+		o.Hdr.Opcode = opcodeAbrt
+		o.Hdr.ObjName = err.Error()
+	}
+	snd.r.p.dm.Send(o, nil, snd.tsi)
+}
+
+func freeSGL(_ *transport.ObjHdr, _ io.ReadCloser, arg any, _ error) {
+	arg.(*memsys.SGL).Free()
+}
+
+//
+// misc helpers
+//
+
+type gbCounter struct {
+	w io.Writer
+	n int64
+}
+
+func (wc *gbCounter) Write(p []byte) (n int, err error) {
+	n, err = wc.w.Write(p)
+	wc.n += int64(n)
+	return
+}
+
+func gbBck(e *apc.GetBatchEntry, defBck *cmn.Bck) *cmn.Bck {
+	if e.Bucket == "" {
+		return defBck
+	}
+	provider, _ := cmn.NormalizeProvider(e.Provider) // validated by proxy
+	return &cmn.Bck{Name: e.Bucket, Provider: provider, Ns: cmn.NsGlobal}
+}
+
+func gbOwner(e *apc.GetBatchEntry, defBck *cmn.Bck, smap *meta.Smap) (tsi *meta.Snode, err error) {
+	lom := core.AllocLOM(e.ObjName)
+	if err = lom.InitBck(gbBck(e, defBck)); err == nil {
+		tsi, _, err = lom.HrwTarget(smap)
+	}
+	core.FreeLOM(lom)
+	return
+}
+
+// name in the output: [bucket/]objname[/archived-filename]
+func gbName(e *apc.GetBatchEntry, bck *cmn.Bck, filename string, isbn bool) (name string) {
+	name = e.ObjName
+	if filename != "" {
+		name += "/" + filename
+	}
+	if isbn {
+		name = bck.Name + "/" + name
+	}
+	return
+}
+
+// WebDataset convention: sample key is the archived file's name up to the first dot in its basename
+// (e.g., "a/b/000123.cls" and "a/b/000123.seg.png" both belong to sample "a/b/000123")
+func IsSampleMember(filename, key string) bool {
+	filename = strings.TrimPrefix(filename, "./")
+	key = strings.TrimPrefix(key, "./")
+	if !strings.HasPrefix(filename, key) {
+		return false
+	}
+	ext := filename[len(key):]
+	return ext != "" && ext[0] == '.' && !strings.Contains(ext, "/")
+}
+
+func gbPack(id string, idx int) []byte { return []byte(id + gbSepa + strconv.Itoa(idx)) }
+
+func gbUnpack(opaque []byte) (id string, idx int, err error) {
+	s := string(opaque)
+	i := strings.LastIndexByte(s, gbSepa[0])
+	if i <= 0 {
+		return "", 0, fmt.Errorf("get-batch: invalid opaque %q", s)
+	}
+	id = s[:i]
+	idx, err = strconv.Atoi(s[i+1:])
+	return
+}
@@ -0,0 +1,138 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+const gbNumObjs = 100
+
+func gbInit(t *testing.T) (*cmn.Bck, *meta.Smap) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	bck := meta.NewBck("gb-bck", apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+	core.T = mock.NewTarget(mock.NewBaseBownerMock(bck))
+
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, 3), Pmap: make(meta.NodeMap), Version: 10}
+	for _, tid := range []string{core.T.SID(), "t1", "t2"} {
+		tsi := &meta.Snode{}
+		tsi.Init(tid, apc.Target)
+		smap.Tmap[tid] = tsi
+	}
+	return bck.Bucket(), smap
+}
+
+func gbNewWI(defBck *cmn.Bck, smap *meta.Smap, entries []apc.GetBatchEntry) *GetBatchWI {
+	r := &XactGetBatch{streamingX: streamingX{config: cmn.GCO.Get()}}
+	r.pending.m = make(map[string]*GetBatchWI, 1)
+	return r.NewWI(cos.GenUUID(), &apc.GetBatchMsg{Entries: entries}, defBck, smap)
+}
+
+func TestGetBatchOwner(t *testing.T) {
+	defBck, smap := gbInit(t)
+	entries := make([]apc.GetBatchEntry, gbNumObjs+1)
+	for i := 0; i < gbNumObjs; i++ {
+		entries[i].ObjName = fmt.Sprintf("obj-%d", i)
+	}
+	entries[gbNumObjs] = apc.GetBatchEntry{Bucket: "no-such-bucket", ObjName: "obj"}
+
+	wi := gbNewWI(defBck, smap, entries)
+	defer wi.Discard()
+
+	var nrem int
+	for i := 0; i < gbNumObjs; i++ {
+		owner, err := smap.HrwName2T(defBck.MakeUname(entries[i].ObjName))
+		tassert.CheckFatal(t, err)
+		slot := &wi.slots[i]
+		tassert.Errorf(t, slot.err == nil, "%s: unexpected error %v", entries[i].ObjName, slot.err)
+		if owner.ID() == core.T.SID() {
+			tassert.Errorf(t, slot.tsi == nil, "%s: expected local, got %s", entries[i].ObjName, slot.tsi)
+			continue
+		}
+		nrem++
+		tassert.Errorf(t, slot.tsi != nil && slot.tsi.ID() == owner.ID(),
+			"%s: expected owner %s, got %v", entries[i].ObjName, owner, slot.tsi)
+	}
+	tassert.Errorf(t, nrem > 0 && nrem < gbNumObjs, "expected both local and remote entries, got %d remote", nrem)
+	tassert.Errorf(t, wi.nrem == nrem, "expected %d remote entries, got %d", nrem, wi.nrem)
+	tassert.Errorf(t, wi.slots[gbNumObjs].err != nil, "expected init error (bucket does not exist)")
+}
+
+func TestGetBatchBuffering(t *testing.T) {
+	defBck, smap := gbInit(t)
+	if mm := core.T.PageMM(); mm.Pressure() >= memsys.PressureExtreme {
+		t.Skipf("%s: %s", t.Name(), mm.String()) // (e.g., test MMSA on a small machine - see memsys.PageMM)
+	}
+
+	// find two remote entries
+	var entries []apc.GetBatchEntry
+	for i := 0; len(entries) < 2; i++ {
+		name := fmt.Sprintf("obj-%d", i)
+		if owner, _ := smap.HrwName2T(defBck.MakeUname(name)); owner.ID() != core.T.SID() {
+			entries = append(entries, apc.GetBatchEntry{ObjName: name})
+		}
+	}
+	var (
+		wi   = gbNewWI(defBck, smap, entries)
+		r    = wi.r
+		data = bytes.Repeat([]byte("a"), cos.KiB)
+		out  bytes.Buffer
+	)
+	defer wi.Discard()
+	tassert.Fatalf(t, wi.nrem == 2, "expected 2 remote entries, got %d", wi.nrem)
+
+	recv := func(idx int, opcode int, size int64) {
+		hdr := &transport.ObjHdr{ObjName: entries[idx].ObjName, Opaque: gbPack(wi.id, idx), Opcode: opcode}
+		hdr.ObjAttrs.Size = size
+		tassert.CheckFatal(t, r._recv(hdr, io.LimitReader(bytes.NewReader(data), size)))
+	}
+
+	// receive and buffer the first entry
+	recv(0, 0, cos.KiB)
+	recv(0, opcodeDone, 0)
+	tassert.Errorf(t, wi.bufd.Load() == cos.KiB, "expected %d buffered, got %d", cos.KiB, wi.bufd.Load())
+	idx, err := wi.wait()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, idx == 0, "expected entry 0, got %d", idx)
+
+	// emit it
+	wi.aw = archive.NewWriter(archive.ExtTar, &out, nil /*checksum*/, nil /*opts*/)
+	tassert.CheckFatal(t, wi.remote(idx))
+	wi.aw.Fini()
+	tassert.Errorf(t, wi.bufd.Load() == 0, "expected nothing buffered, got %d", wi.bufd.Load())
+	tassert.Errorf(t, wi.slots[idx].recvd == nil, "expected received content to be released")
+	tassert.Errorf(t, wi.cnt == 1 && out.Len() > cos.KiB, "expected one emitted entry (cnt %d, out %d)", wi.cnt, out.Len())
+
+	// the next entry would exceed the limit: fail the request
+	wi.bufd.Store(gbMaxBuffered - cos.KiB/2)
+	tassert.Errorf(t, wi.checkBuf(core.T.PageMM(), cos.KiB) != nil, "expected buffering limit error")
+	recv(1, 0, cos.KiB)
+	tassert.Errorf(t, wi.failed.Load(), "expected failed request")
+	tassert.Errorf(t, wi.slots[1].recvd == nil, "expected nothing received past the limit")
+	_, err = wi.wait()
+	tassert.Errorf(t, err != nil && errors.Is(err, wi.errBuf), "expected buffering error, got %v", err)
+	wi.bufd.Store(0)
+}
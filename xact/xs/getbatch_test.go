@@ -0,0 +1,33 @@
+// Package xs_test - get-batch unit tests.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+func TestGetBatchSampleMember(t *testing.T) {
+	tests := []struct {
+		filename, key string
+		member        bool
+	}{
+		{"sample-001.jpg", "sample-001", true},
+		{"sample-001.cls", "sample-001", true},
+		{"sample-001.seg.png", "sample-001", true},
+		{"./sample-001.json", "sample-001", true},
+		{"dir/sample-001.jpg", "dir/sample-001", true},
+		{"sample-0011.jpg", "sample-001", false},
+		{"sample-001", "sample-001", false},
+		{"sample-001.d/x.jpg", "sample-001", false},
+		{"dir/sample-001.jpg", "sample-001", false},
+	}
+	for _, test := range tests {
+		if got := xs.IsSampleMember(test.filename, test.key); got != test.member {
+			t.Errorf("IsSampleMember(%q, %q): expected %t, got %t", test.filename, test.key, test.member, got)
+		}
+	}
+}
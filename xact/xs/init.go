@@ -30,6 +30,8 @@ func Xreg(xeleOnly bool) {
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&lcyFactory{})
+	xreg.RegBckXact(&tmgFactory{})
 
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
@@ -37,5 +39,7 @@ func Xreg(xeleOnly bool) {
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActETLObjects}})
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActCopyObjects}})
 	xreg.RegBckXact(&archFactory{streamingF: streamingF{kind: apc.ActArchive}})
+	xreg.RegNonBckXact(&gbFactory{streamingF: streamingF{kind: apc.ActGetBatch}})
+	xreg.RegNonBckXact(&wbFactory{})
 	xreg.RegBckXact(&lsoFactory{streamingF: streamingF{kind: apc.ActList}})
 }
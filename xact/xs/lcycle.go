@@ -0,0 +1,196 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Bucket lifecycle (see cmn.LifecycleConf):
+// - walk the bucket and remove objects that match expiration rules (prefix, tags, age or date)
+// - abort incomplete multipart uploads per `AbortMptDays`
+// Limitations (TODO):
+// - transitions are not supported (rejected by cmn.LifecycleRule validation)
+// - object's last modification time is the file's mtime
+
+type (
+	lcyFactory struct {
+		xreg.RenewBase
+		xctn *XactLcycle
+	}
+	XactLcycle struct {
+		args  *xreg.LcycleArgs
+		rules []cmn.LifecycleRule
+		now   time.Time
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactLcycle)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+////////////////
+// lcyFactory //
+////////////////
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &lcyFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *lcyFactory) Start() error {
+	args, ok := p.Args.Custom.(*xreg.LcycleArgs)
+	if !ok || args == nil {
+		args = &xreg.LcycleArgs{}
+	}
+	p.xctn = newXactLcycle(p.UUID(), p.Bck, args)
+	return nil
+}
+
+func (*lcyFactory) Kind() string     { return apc.ActLifecycle }
+func (p *lcyFactory) Get() core.Xact { return p.xctn }
+
+func (*lcyFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+////////////////
+// XactLcycle //
+////////////////
+
+func newXactLcycle(uuid string, bck *meta.Bck, args *xreg.LcycleArgs) (r *XactLcycle) {
+	r = &XactLcycle{args: args, now: time.Now()}
+	// (a snapshot of the current rules; bucket props may change while we run)
+	r.rules = bck.Props.Lifecycle.Rules
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActLifecycle, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactLcycle) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	if !r.Bck().Props.Lifecycle.IsActive() {
+		nlog.Infoln(r.Name(), "- no active lifecycle rules, nothing to do")
+		r.Finish()
+		return
+	}
+	nlog.Infoln(r.Name())
+
+	r.abortMpt()
+
+	if r.expires() {
+		r.BckJog.Run()
+		if err := r.BckJog.Wait(); err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.Finish()
+}
+
+func (r *XactLcycle) expires() bool {
+	for i := range r.rules {
+		if !r.rules[i].Disabled && r.rules[i].Expires() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *XactLcycle) abortMpt() {
+	if r.args.AbortMpt == nil {
+		return
+	}
+	var n int
+	for i := range r.rules {
+		rule := &r.rules[i]
+		if rule.Disabled || rule.AbortMptDays == 0 {
+			continue
+		}
+		n += r.args.AbortMpt(r.Bck(), rule.Prefix, rule.AbortMptAge())
+	}
+	if n > 0 {
+		nlog.Infoln(r.Name(), "aborted", n, "incomplete multipart upload(s)")
+	}
+}
+
+func (r *XactLcycle) visitObj(lom *core.LOM, _ []byte) error {
+	var (
+		rule   *cmn.LifecycleRule
+		at     time.Time // the earliest expiration
+		mtime  time.Time
+		custom = lom.GetCustomMD()
+	)
+	// all matching rules (not just the first one) - the earliest expiration wins
+	for i := range r.rules {
+		rl := &r.rules[i]
+		if !rl.Expires() || !rl.Match(lom.ObjName, custom) {
+			continue
+		}
+		if mtime.IsZero() {
+			finfo, err := os.Stat(lom.FQN)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					r.AddErr(err, 4, cos.SmoduleXs)
+				}
+				return nil
+			}
+			mtime = finfo.ModTime()
+		}
+		if t := rl.ExpiresAt(mtime); rule == nil || t.Before(at) {
+			rule, at = rl, t
+		}
+	}
+	if rule == nil || r.now.Before(at) {
+		return nil
+	}
+
+	size := lom.SizeBytes()
+	errCode, err := core.T.DeleteObject(lom, false /*evict*/)
+	if err != nil {
+		// (locked objects do not expire)
+		if errCode != http.StatusNotFound && !cmn.IsErrObjNought(err) && !cmn.IsErrObjLocked(err) {
+			r.AddErr(fmt.Errorf("%s: failed to expire %s: %w", r, lom.Cname(), err), 4, cos.SmoduleXs)
+		}
+		return nil
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		nlog.Infoln(r.Name(), "expired", lom.Cname(), "rule", rule.Name())
+	}
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+func (r *XactLcycle) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
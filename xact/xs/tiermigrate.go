@@ -0,0 +1,159 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// tier-migrate: walk the bucket and move objects between hot and cold mountpaths
+// (see cmn.TierConf and core/ltier.go)
+// - runs periodically, upon changing bucket's tiering configuration, and on demand;
+// - the desired tier of a given object is determined by the bucket's preferred tier, object's
+//   access time, and size (cmn.TierConf.Desired);
+// - with tiering disabled, moves objects back to their (non-tiered) HRW locations;
+// - busy (locked) objects are skipped - until the next run.
+
+type (
+	tmgFactory struct {
+		xreg.RenewBase
+		xctn *XactTierMigrate
+	}
+	XactTierMigrate struct {
+		conf cmn.TierConf
+		now  int64
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactTierMigrate)(nil)
+	_ xreg.Renewable = (*tmgFactory)(nil)
+)
+
+////////////////
+// tmgFactory //
+////////////////
+
+func (*tmgFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &tmgFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *tmgFactory) Start() error {
+	slab, err := core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	if err != nil {
+		return err
+	}
+	p.xctn = newXactTierMigrate(p.UUID(), p.Bck, slab)
+	return nil
+}
+
+func (*tmgFactory) Kind() string     { return apc.ActTierMigrate }
+func (p *tmgFactory) Get() core.Xact { return p.xctn }
+
+// keep running unless tiering configuration has changed
+func (p *tmgFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	prev, ok := prevEntry.Get().(*XactTierMigrate)
+	if ok && prev.conf != p.Bck.Props.Tier {
+		return xreg.WprAbort, nil
+	}
+	return xreg.WprUse, nil
+}
+
+/////////////////////
+// XactTierMigrate //
+/////////////////////
+
+func newXactTierMigrate(uuid string, bck *meta.Bck, slab *memsys.Slab) (r *XactTierMigrate) {
+	// (a snapshot of the current configuration; bucket props may change while we run)
+	r = &XactTierMigrate{conf: bck.Props.Tier, now: time.Now().UnixNano()}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActTierMigrate, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactTierMigrate) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	if !fs.Tiered() {
+		nlog.Infoln(r.Name(), "- no cold mountpaths, nothing to do")
+		r.Finish()
+		return
+	}
+	nlog.Infoln(r.Name(), "tier:", r.conf.String())
+
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactTierMigrate) visitObj(lom *core.LOM, buf []byte) error {
+	var tier string // (no tiering)
+	if r.conf.Enabled {
+		tier = r.conf.Desired(lom.SizeBytes(), lom.AtimeUnix(), r.now)
+		if lom.TierPlaced(tier) {
+			return nil
+		}
+	} else if lom.IsHRW() {
+		return nil
+	}
+	if !lom.TryLock(true) {
+		return nil // must be busy
+	}
+	defer lom.Unlock(true)
+	// reload under lock
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		return nil
+	}
+	moved, err := lom.MigrateTier(tier, buf)
+	if err != nil {
+		r.AddErr(fmt.Errorf("%s: failed to move %s to %q tier: %w", r, lom.Cname(), tier, err), 5, cos.SmoduleXs)
+		return nil
+	}
+	if moved {
+		if cmn.Rom.FastV(5, cos.SmoduleXs) {
+			nlog.Infoln(r.Name(), "moved", lom.Cname(), lom.Tier(), "=>", tier)
+		}
+		r.ObjsAdd(1, lom.SizeBytes())
+	}
+	return nil
+}
+
+func (r *XactTierMigrate) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
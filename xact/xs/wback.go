@@ -0,0 +1,254 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-write-back: upload objects that were PUT to remote buckets with write-back enabled
+// (see cmn.WriteBackConf and core/lwback.go)
+// - one per target; runs for as long as the write-back journal is not empty, and idles otherwise;
+// - uploads under the object's read lock (that is, DELETE and overwrite wait for the upload to finish);
+// - upon successful upload, updates the object's metadata with the backend-assigned version, ETag, etc.
+
+const (
+	wbWorkers = 16
+	wbTick    = time.Second // (retry backoff granularity)
+)
+
+// custom metadata set by the backend upon upload (compare with ais/tgtwback.go)
+var wbBackendMD = [...]string{cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD}
+
+type (
+	wbFactory struct {
+		xreg.RenewBase
+		xctn *XactWriteBack
+	}
+	XactWriteBack struct {
+		workCh  chan core.WbEntry
+		wakeCh  chan struct{}
+		wg      sync.WaitGroup
+		backlog bool // (pending while the journal is not empty)
+		xact.DemandBase
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactWriteBack)(nil)
+	_ xreg.Renewable = (*wbFactory)(nil)
+)
+
+///////////////
+// wbFactory //
+///////////////
+
+func (*wbFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &wbFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *wbFactory) Start() error {
+	r := &XactWriteBack{
+		workCh: make(chan core.WbEntry, wbWorkers),
+		wakeCh: make(chan struct{}, 1),
+	}
+	r.DemandBase.Init(cos.GenUUID(), apc.ActWriteBack, nil, xact.IdleDefault)
+	p.xctn = r
+	xact.GoRunW(r)
+	return nil
+}
+
+func (*wbFactory) Kind() string     { return apc.ActWriteBack }
+func (p *wbFactory) Get() core.Xact { return p.xctn }
+
+func (p *wbFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	debug.Assertf(false, "%s vs %s", p.Str(p.Kind()), xprev) // xreg.usePrev() must've returned true
+	return xreg.WprUse, nil
+}
+
+///////////////////
+// XactWriteBack //
+///////////////////
+
+func (r *XactWriteBack) Run(wg *sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	for i := 0; i < wbWorkers; i++ {
+		r.wg.Add(1)
+		go r.work()
+	}
+	wg.Done()
+
+	ticker := time.NewTicker(wbTick)
+loop:
+	for {
+		r.dispatch()
+		select {
+		case <-r.wakeCh:
+		case <-ticker.C:
+		case <-r.IdleTimer():
+			break loop
+		case <-r.ChanAbort():
+			break loop
+		}
+	}
+	ticker.Stop()
+	r.DemandBase.Stop()
+	close(r.workCh)
+	r.wg.Wait()
+	if r.backlog {
+		r.DecPending()
+	}
+	r.Finish()
+}
+
+// new PUT or completed upload
+func (r *XactWriteBack) Wake() {
+	select {
+	case r.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (r *XactWriteBack) dispatch() {
+	cnt, _, _ := core.WbBacklog()
+	switch {
+	case cnt > 0 && !r.backlog:
+		r.IncPending()
+		r.backlog = true
+	case cnt == 0 && r.backlog:
+		r.DecPending()
+		r.backlog = false
+	}
+	if cnt == 0 {
+		return
+	}
+	for _, en := range core.WbNext(cap(r.workCh) - len(r.workCh)) {
+		r.workCh <- en
+	}
+}
+
+func (r *XactWriteBack) work() {
+	defer r.wg.Done()
+	for en := range r.workCh {
+		if r.IsAborted() {
+			core.WbFailed(&en) // (remains pending)
+			continue
+		}
+		r.upload(&en)
+		r.Wake()
+	}
+}
+
+func (r *XactWriteBack) upload(en *core.WbEntry) {
+	bck, objName := cmn.ParseUname(en.Uname)
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&bck); err != nil {
+		if cmn.IsErrBckNotFound(err) || cmn.IsErrRemoteBckNotFound(err) {
+			nlog.Errorln(r.Name(), "dropping", en.Uname, "upload:", err)
+			core.WbDone(en, false)
+			return
+		}
+		r.failed(en, err)
+		return
+	}
+
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if !cos.IsNotExist(err, 0) {
+			r.failed(en, err)
+			return
+		}
+		// deleted or evicted locally: nothing to upload, unless re-PUT in the meantime
+		lom.Lock(true)
+		core.WbDone(en, false)
+		lom.Unlock(true)
+		return
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		lom.Unlock(false)
+		r.failed(en, err)
+		return
+	}
+	// (private copy - to be updated by the backend)
+	custom := maps.Clone(lom.GetCustomMD())
+	if custom == nil {
+		custom = make(cos.StrKVs, 4)
+	}
+	if !lom.Bck().IsRemoteAIS() {
+		for _, k := range wbBackendMD {
+			delete(custom, k)
+		}
+	}
+	lom.SetCustomMD(custom)
+
+	backend := core.T.Backend(lom.Bck())
+	ecode, err := backend.PutObj(fh, lom)
+	lom.Unlock(false)
+	if err != nil {
+		r.failed(en, cmn.NewErrFailedTo(core.T, "write-back", lom.Cname(), err, ecode))
+		return
+	}
+	// backend-assigned attributes only (user metadata may have changed in the meantime)
+	var (
+		attrs   = make(cos.StrKVs, len(wbBackendMD))
+		version = lom.Version()
+	)
+	for _, k := range wbBackendMD {
+		if v, ok := lom.GetCustomKey(k); ok {
+			attrs[k] = v
+		}
+	}
+	if !lom.Bck().IsRemoteAIS() {
+		attrs[cmn.SourceObjMD] = backend.Provider()
+	}
+	r.ObjsAdd(1, en.Size)
+
+	// update metadata unless overwritten (or deleted) in the meantime
+	lom.Lock(true)
+	if core.WbDone(en, true) {
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+			for k, v := range attrs {
+				lom.SetCustomKey(k, v)
+			}
+			if version != "" {
+				lom.SetVersion(version)
+			}
+			if err := lom.Persist(); err != nil {
+				r.AddErr(err, 5, cos.SmoduleXs)
+			}
+		}
+	}
+	lom.Unlock(true)
+}
+
+func (r *XactWriteBack) failed(en *core.WbEntry, err error) {
+	core.WbFailed(en)
+	r.AddErr(err, 4, cos.SmoduleXs)
+}
+
+func (r *XactWriteBack) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
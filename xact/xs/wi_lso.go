@@ -153,7 +153,7 @@ func (wi *walkInfo) cb(lom *core.LOM, fqn string) (*cmn.LsoEntry, error) {
 	}
 
 	// shortcut #1: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) {
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && wi.msg.Tag == "" {
 		if !isOK(status) {
 			return nil, nil
 		}
@@ -166,6 +166,9 @@ func (wi *walkInfo) cb(lom *core.LOM, fqn string) (*cmn.LsoEntry, error) {
 		}
 		return nil, err
 	}
+	if wi.msg.Tag != "" && !cmn.MatchTag(lom.GetCustomMD(), wi.msg.Tag) {
+		return nil, nil
+	}
 	if local && lom.IsCopy() {
 		// still may change below
 		status = apc.LocIsCopy